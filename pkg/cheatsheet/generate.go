@@ -121,6 +121,7 @@ func localisedTitle(tr *i18n.TranslationSet, str string) string {
 		"main":              tr.NormalTitle,
 		"patchBuilding":     tr.PatchBuildingTitle,
 		"mergeConflicts":    tr.MergingTitle,
+		"blame":             tr.BlameTitle,
 		"staging":           tr.StagingTitle,
 		"menu":              tr.MenuTitle,
 		"search":            tr.SearchTitle,
@@ -18,27 +18,32 @@ import (
 
 // GitCommand is our main git interface
 type GitCommand struct {
-	Blame       *git_commands.BlameCommands
-	Branch      *git_commands.BranchCommands
-	Commit      *git_commands.CommitCommands
-	Config      *git_commands.ConfigCommands
-	Custom      *git_commands.CustomCommands
-	Diff        *git_commands.DiffCommands
-	File        *git_commands.FileCommands
-	Flow        *git_commands.FlowCommands
-	Patch       *git_commands.PatchCommands
-	Rebase      *git_commands.RebaseCommands
-	Remote      *git_commands.RemoteCommands
-	Stash       *git_commands.StashCommands
-	Status      *git_commands.StatusCommands
-	Submodule   *git_commands.SubmoduleCommands
-	Sync        *git_commands.SyncCommands
-	Tag         *git_commands.TagCommands
-	WorkingTree *git_commands.WorkingTreeCommands
-	Bisect      *git_commands.BisectCommands
-	Worktree    *git_commands.WorktreeCommands
-	Version     *git_commands.GitVersion
-	RepoPaths   *git_commands.RepoPaths
+	Blame          *git_commands.BlameCommands
+	Branch         *git_commands.BranchCommands
+	Clone          *git_commands.CloneCommands
+	Commit         *git_commands.CommitCommands
+	Config         *git_commands.ConfigCommands
+	Custom         *git_commands.CustomCommands
+	Diff           *git_commands.DiffCommands
+	File           *git_commands.FileCommands
+	Flow           *git_commands.FlowCommands
+	Lfs            *git_commands.LfsCommands
+	Notes          *git_commands.NotesCommands
+	SparseCheckout *git_commands.SparseCheckoutCommands
+	Patch          *git_commands.PatchCommands
+	Rebase         *git_commands.RebaseCommands
+	Remote         *git_commands.RemoteCommands
+	Rerere         *git_commands.RerereCommands
+	Stash          *git_commands.StashCommands
+	Status         *git_commands.StatusCommands
+	Submodule      *git_commands.SubmoduleCommands
+	Sync           *git_commands.SyncCommands
+	Tag            *git_commands.TagCommands
+	WorkingTree    *git_commands.WorkingTreeCommands
+	Bisect         *git_commands.BisectCommands
+	Worktree       *git_commands.WorktreeCommands
+	Version        *git_commands.GitVersion
+	RepoPaths      *git_commands.RepoPaths
 
 	Loaders Loaders
 }
@@ -137,8 +142,13 @@ func NewGitCommandAux(
 	bisectCommands := git_commands.NewBisectCommands(gitCommon)
 	worktreeCommands := git_commands.NewWorktreeCommands(gitCommon)
 	blameCommands := git_commands.NewBlameCommands(gitCommon)
+	lfsCommands := git_commands.NewLfsCommands(gitCommon)
+	notesCommands := git_commands.NewNotesCommands(gitCommon)
+	sparseCheckoutCommands := git_commands.NewSparseCheckoutCommands(gitCommon)
+	rerereCommands := git_commands.NewRerereCommands(gitCommon)
+	cloneCommands := git_commands.NewCloneCommands(gitCommon)
 
-	branchLoader := git_commands.NewBranchLoader(cmn, gitCommon, cmd, branchCommands.CurrentBranchInfo, configCommands)
+	branchLoader := git_commands.NewBranchLoader(cmn, gitCommon, cmd, branchCommands.CurrentBranchInfo, configCommands, branchCommands.PredictMergeConflictsForHashes)
 	commitFileLoader := git_commands.NewCommitFileLoader(cmn, cmd)
 	commitLoader := git_commands.NewCommitLoader(cmn, cmd, statusCommands.WorkingTreeState, gitCommon)
 	reflogCommitLoader := git_commands.NewReflogCommitLoader(cmn, cmd)
@@ -148,26 +158,31 @@ func NewGitCommandAux(
 	tagLoader := git_commands.NewTagLoader(cmn, cmd)
 
 	return &GitCommand{
-		Blame:       blameCommands,
-		Branch:      branchCommands,
-		Commit:      commitCommands,
-		Config:      configCommands,
-		Custom:      customCommands,
-		Diff:        diffCommands,
-		File:        fileCommands,
-		Flow:        flowCommands,
-		Patch:       patchCommands,
-		Rebase:      rebaseCommands,
-		Remote:      remoteCommands,
-		Stash:       stashCommands,
-		Status:      statusCommands,
-		Submodule:   submoduleCommands,
-		Sync:        syncCommands,
-		Tag:         tagCommands,
-		Bisect:      bisectCommands,
-		WorkingTree: workingTreeCommands,
-		Worktree:    worktreeCommands,
-		Version:     version,
+		Blame:          blameCommands,
+		Branch:         branchCommands,
+		Clone:          cloneCommands,
+		Commit:         commitCommands,
+		Config:         configCommands,
+		Custom:         customCommands,
+		Diff:           diffCommands,
+		File:           fileCommands,
+		Flow:           flowCommands,
+		Lfs:            lfsCommands,
+		Notes:          notesCommands,
+		SparseCheckout: sparseCheckoutCommands,
+		Patch:          patchCommands,
+		Rebase:         rebaseCommands,
+		Remote:         remoteCommands,
+		Rerere:         rerereCommands,
+		Stash:          stashCommands,
+		Status:         statusCommands,
+		Submodule:      submoduleCommands,
+		Sync:           syncCommands,
+		Tag:            tagCommands,
+		Bisect:         bisectCommands,
+		WorkingTree:    workingTreeCommands,
+		Worktree:       worktreeCommands,
+		Version:        version,
 		Loaders: Loaders{
 			BranchLoader:       branchLoader,
 			CommitFileLoader:   commitFileLoader,
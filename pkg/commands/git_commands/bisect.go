@@ -1,6 +1,7 @@
 package git_commands
 
 import (
+	"io"
 	"os"
 	"path/filepath"
 	"strings"
@@ -134,6 +135,20 @@ func (self *BisectCommands) StartWithTerms(oldTerm string, newTerm string) error
 	return self.cmd.New(cmdArgs).StreamOutput().Run()
 }
 
+// Lets git mark the remaining commits by running the given shell command on
+// each of them. The output is streamed to the command log as well as to
+// outputWriter, so that callers can follow the progress.
+func (self *BisectCommands) Run(command string, outputWriter io.Writer) error {
+	cmdArgs := NewGitCmd("bisect").
+		Arg("run", self.os.Platform.Shell, self.os.Platform.ShellArg, command).
+		ToArgv()
+
+	return self.cmd.New(cmdArgs).
+		StreamOutput().
+		AlsoStreamOutputTo(outputWriter).
+		Run()
+}
+
 // tells us whether we've found our problem commit(s). We return a string slice of
 // commit hashes if we're done, and that slice may have more that one item if
 // skipped commits are involved.
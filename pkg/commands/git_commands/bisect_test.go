@@ -0,0 +1,18 @@
+package git_commands
+
+import (
+	"io"
+	"testing"
+
+	"github.com/jesseduffield/lazygit/pkg/commands/oscommands"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBisectRun(t *testing.T) {
+	runner := oscommands.NewFakeRunner(t).
+		ExpectGitArgs([]string{"bisect", "run", "bash", "-c", "make test"}, "", nil)
+	instance := buildBisectCommands(commonDeps{runner: runner})
+
+	assert.NoError(t, instance.Run("make test", io.Discard))
+	runner.CheckForMissingCalls()
+}
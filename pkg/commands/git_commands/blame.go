@@ -2,6 +2,11 @@ package git_commands
 
 import (
 	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/jesseduffield/lazygit/pkg/commands/models"
+	"github.com/jesseduffield/lazygit/pkg/utils"
 )
 
 type BlameCommands struct {
@@ -31,3 +36,92 @@ func (self *BlameCommands) BlameLineRange(filename string, commit string, firstL
 
 	return self.cmd.New(cmdArgs.ToArgv()).RunWithOutput()
 }
+
+// Blame a whole file at the given commit. If commit is empty, the file in the
+// working tree is blamed, in which case lines with uncommitted changes are
+// attributed to an all-zeroes hash.
+func (self *BlameCommands) Blame(filename string, commit string) ([]*models.BlameLine, error) {
+	cmdArgs := NewGitCmd("blame").
+		Arg("--porcelain").
+		ArgIf(commit != "", commit).
+		Arg("--").
+		Arg(filename).
+		ToArgv()
+
+	output, err := self.cmd.New(cmdArgs).DontLog().RunWithOutput()
+	if err != nil {
+		return nil, err
+	}
+
+	return parseBlamePorcelain(output), nil
+}
+
+// Parses the output of `git blame --porcelain`. Each line of the file is
+// preceded by a header line of the form
+//
+//	<hash> <original line number> <final line number> [<number of lines in group>]
+//
+// The first time a commit appears, the header is followed by information
+// about the commit (author, summary, etc.); for subsequent lines of the same
+// commit this information is omitted, so we remember it per hash.
+func parseBlamePorcelain(output string) []*models.BlameLine {
+	commitInfos := map[string]*models.BlameLine{}
+	result := []*models.BlameLine{}
+
+	var current *models.BlameLine
+	var currentInfo *models.BlameLine
+	for _, line := range utils.SplitLines(output) {
+		if current == nil {
+			fields := strings.Fields(line)
+			if len(fields) < 3 {
+				continue
+			}
+
+			hash := fields[0]
+			currentInfo = commitInfos[hash]
+			if currentInfo == nil {
+				currentInfo = &models.BlameLine{Hash: hash}
+				commitInfos[hash] = currentInfo
+			}
+
+			current = &models.BlameLine{Hash: hash}
+			current.OriginalLineNumber, _ = strconv.Atoi(fields[1])
+			current.LineNumber, _ = strconv.Atoi(fields[2])
+			continue
+		}
+
+		if content, ok := strings.CutPrefix(line, "\t"); ok {
+			current.AuthorName = currentInfo.AuthorName
+			current.AuthorEmail = currentInfo.AuthorEmail
+			current.UnixTimestamp = currentInfo.UnixTimestamp
+			current.Summary = currentInfo.Summary
+			current.Filename = currentInfo.Filename
+			current.PreviousHash = currentInfo.PreviousHash
+			current.PreviousFilename = currentInfo.PreviousFilename
+			current.Content = content
+			result = append(result, current)
+			current = nil
+			continue
+		}
+
+		key, value, _ := strings.Cut(line, " ")
+		switch key {
+		case "author":
+			currentInfo.AuthorName = value
+		case "author-mail":
+			currentInfo.AuthorEmail = strings.TrimSuffix(strings.TrimPrefix(value, "<"), ">")
+		case "author-time":
+			currentInfo.UnixTimestamp, _ = strconv.ParseInt(value, 10, 64)
+		case "summary":
+			currentInfo.Summary = value
+		case "filename":
+			currentInfo.Filename = value
+		case "previous":
+			previousHash, previousFilename, _ := strings.Cut(value, " ")
+			currentInfo.PreviousHash = previousHash
+			currentInfo.PreviousFilename = previousFilename
+		}
+	}
+
+	return result
+}
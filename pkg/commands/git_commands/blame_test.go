@@ -0,0 +1,131 @@
+package git_commands
+
+import (
+	"testing"
+
+	"github.com/jesseduffield/lazygit/pkg/commands/models"
+	"github.com/jesseduffield/lazygit/pkg/commands/oscommands"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBlame(t *testing.T) {
+	porcelainOutput := `1111111111111111111111111111111111111111 1 1 2
+author Jane Doe
+author-mail <jane@example.com>
+author-time 1700000000
+author-tz +0100
+committer Jane Doe
+committer-mail <jane@example.com>
+committer-time 1700000000
+committer-tz +0100
+summary Add greeting
+previous 3333333333333333333333333333333333333333 old.txt
+filename file.txt
+	hello
+1111111111111111111111111111111111111111 2 2
+	world
+2222222222222222222222222222222222222222 1 3 1
+author John Smith
+author-mail <john@example.com>
+author-time 1600000000
+author-tz +0000
+committer John Smith
+committer-mail <john@example.com>
+committer-time 1600000000
+committer-tz +0000
+summary Initial commit
+boundary
+filename file.txt
+	 indented line
+`
+
+	expectedLines := []*models.BlameLine{
+		{
+			Hash:               "1111111111111111111111111111111111111111",
+			AuthorName:         "Jane Doe",
+			AuthorEmail:        "jane@example.com",
+			UnixTimestamp:      1700000000,
+			Summary:            "Add greeting",
+			LineNumber:         1,
+			OriginalLineNumber: 1,
+			Filename:           "file.txt",
+			PreviousHash:       "3333333333333333333333333333333333333333",
+			PreviousFilename:   "old.txt",
+			Content:            "hello",
+		},
+		{
+			Hash:               "1111111111111111111111111111111111111111",
+			AuthorName:         "Jane Doe",
+			AuthorEmail:        "jane@example.com",
+			UnixTimestamp:      1700000000,
+			Summary:            "Add greeting",
+			LineNumber:         2,
+			OriginalLineNumber: 2,
+			Filename:           "file.txt",
+			PreviousHash:       "3333333333333333333333333333333333333333",
+			PreviousFilename:   "old.txt",
+			Content:            "world",
+		},
+		{
+			Hash:               "2222222222222222222222222222222222222222",
+			AuthorName:         "John Smith",
+			AuthorEmail:        "john@example.com",
+			UnixTimestamp:      1600000000,
+			Summary:            "Initial commit",
+			LineNumber:         3,
+			OriginalLineNumber: 1,
+			Filename:           "file.txt",
+			Content:            " indented line",
+		},
+	}
+
+	type scenario struct {
+		testName      string
+		commit        string
+		expectedArgs  []string
+		output        string
+		expectedLines []*models.BlameLine
+	}
+
+	scenarios := []scenario{
+		{
+			testName:      "working tree",
+			commit:        "",
+			expectedArgs:  []string{"blame", "--porcelain", "--", "file.txt"},
+			output:        porcelainOutput,
+			expectedLines: expectedLines,
+		},
+		{
+			testName:      "at commit",
+			commit:        "abc123",
+			expectedArgs:  []string{"blame", "--porcelain", "abc123", "--", "file.txt"},
+			output:        porcelainOutput,
+			expectedLines: expectedLines,
+		},
+		{
+			testName:      "empty file",
+			commit:        "",
+			expectedArgs:  []string{"blame", "--porcelain", "--", "file.txt"},
+			output:        "",
+			expectedLines: []*models.BlameLine{},
+		},
+	}
+
+	for _, s := range scenarios {
+		t.Run(s.testName, func(t *testing.T) {
+			runner := oscommands.NewFakeRunner(t).
+				ExpectGitArgs(s.expectedArgs, s.output, nil)
+			instance := NewBlameCommands(buildGitCommon(commonDeps{runner: runner}))
+
+			lines, err := instance.Blame("file.txt", s.commit)
+			assert.NoError(t, err)
+			assert.Equal(t, s.expectedLines, lines)
+			runner.CheckForMissingCalls()
+		})
+	}
+}
+
+func TestBlameLineIsCommitted(t *testing.T) {
+	assert.True(t, (&models.BlameLine{Hash: "1230000000000000000000000000000000000000"}).IsCommitted())
+	assert.False(t, (&models.BlameLine{Hash: "0000000000000000000000000000000000000000"}).IsCommitted())
+}
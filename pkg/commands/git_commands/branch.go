@@ -14,11 +14,13 @@ import (
 type BranchCommands struct {
 	*GitCommon
 	allBranchesLogCmdIndex int // keeps track of current all branches log command
+	mergeConflictsCache    *mergeConflictsCache
 }
 
 func NewBranchCommands(gitCommon *GitCommon) *BranchCommands {
 	return &BranchCommands{
-		GitCommon: gitCommon,
+		GitCommon:           gitCommon,
+		mergeConflictsCache: newMergeConflictsCache(),
 	}
 }
 
@@ -295,6 +297,88 @@ func (self *BranchCommands) CanDoFastForwardMerge(refName string) bool {
 	return err == nil
 }
 
+// `git merge-tree --write-tree` was added in git 2.38
+func (self *BranchCommands) CanPredictMergeConflicts() bool {
+	return self.version.IsAtLeast(2, 38, 0)
+}
+
+// Returns the paths that would conflict when merging theirs into ours, without
+// touching the working tree or the index. An empty result means the merge is
+// clean.
+func (self *BranchCommands) PredictMergeConflicts(ours string, theirs string) ([]string, error) {
+	output, err := self.cmd.New(
+		NewGitCmd("rev-parse").Arg(ours+"^{commit}", theirs+"^{commit}").ToArgv(),
+	).DontLog().RunWithOutput()
+	if err != nil {
+		return nil, err
+	}
+	hashes := utils.SplitLines(output)
+	if len(hashes) != 2 {
+		return nil, fmt.Errorf("unexpected output of git rev-parse: %q", output)
+	}
+	oursHash, theirsHash := hashes[0], hashes[1]
+
+	return self.PredictMergeConflictsForHashes(oursHash, theirsHash)
+}
+
+// Like PredictMergeConflicts, but for two commit hashes, which allows us to
+// reuse the result of an earlier call for the same commits
+func (self *BranchCommands) PredictMergeConflictsForHashes(oursHash string, theirsHash string) ([]string, error) {
+	if conflicts, ok := self.mergeConflictsCache.get(oursHash, theirsHash); ok {
+		return conflicts, nil
+	}
+
+	conflicts, err := predictMergeConflicts(self.cmd, oursHash, theirsHash)
+	if err != nil {
+		return nil, err
+	}
+
+	self.mergeConflictsCache.set(oursHash, theirsHash, conflicts)
+	return conflicts, nil
+}
+
+func predictMergeConflicts(cmd oscommands.ICmdObjBuilder, ours string, theirs string) ([]string, error) {
+	cmdArgs := NewGitCmd("merge-tree").
+		Arg("--write-tree", "--name-only", "--no-messages").
+		Arg(ours, theirs).
+		ToArgv()
+
+	// The output starts with the hash of the resulting tree, followed by the
+	// conflicted paths if there are any, in which case git exits with status 1
+	stdout, stderr, err := cmd.New(cmdArgs).DontLog().RunWithOutputs()
+	lines := utils.SplitLines(stdout)
+	if err != nil && (stderr != "" || len(lines) == 0) {
+		return nil, err
+	}
+
+	if err == nil {
+		return []string{}, nil
+	}
+
+	return lo.Uniq(lo.WithoutEmpty(lines[1:])), nil
+}
+
+// Returns the hash of the oldest commit of the branch that isn't reachable from
+// any of excludedRefs, or an empty string if there is no such commit
+func (self *BranchCommands) GetFirstCommitHash(branchName string, excludedRefs []string) (string, error) {
+	cmdArgs := NewGitCmd("rev-list").
+		Arg("--reverse").
+		Arg("refs/heads/" + branchName).
+		Arg(lo.Map(excludedRefs, func(ref string, _ int) string {
+			return "^" + ref
+		})...).
+		Arg("--").
+		ToArgv()
+
+	stdout, err := self.cmd.New(cmdArgs).DontLog().RunWithOutput()
+	if err != nil {
+		return "", err
+	}
+
+	firstLine, _, _ := strings.Cut(stdout, "\n")
+	return strings.TrimSpace(firstLine), nil
+}
+
 // Only choose between non-empty, non-identical commands
 func (self *BranchCommands) allBranchesLogCandidates() []string {
 	return lo.Uniq(lo.WithoutEmpty(self.UserConfig().Git.AllBranchesLogCmds))
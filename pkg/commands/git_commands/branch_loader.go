@@ -3,6 +3,7 @@ package git_commands
 import (
 	"fmt"
 	"regexp"
+	"runtime"
 	"slices"
 	"strconv"
 	"strings"
@@ -46,6 +47,8 @@ type BranchLoader struct {
 	cmd                  oscommands.ICmdObjBuilder
 	getCurrentBranchInfo func() (BranchInfo, error)
 	config               BranchLoaderConfigCommands
+	// cached by the hashes of the two commits, see BranchCommands.PredictMergeConflictsForHashes
+	predictMergeConflicts func(oursHash string, theirsHash string) ([]string, error)
 }
 
 func NewBranchLoader(
@@ -54,13 +57,15 @@ func NewBranchLoader(
 	cmd oscommands.ICmdObjBuilder,
 	getCurrentBranchInfo func() (BranchInfo, error),
 	config BranchLoaderConfigCommands,
+	predictMergeConflicts func(oursHash string, theirsHash string) ([]string, error),
 ) *BranchLoader {
 	return &BranchLoader{
-		Common:               cmn,
-		GitCommon:            gitCommon,
-		cmd:                  cmd,
-		getCurrentBranchInfo: getCurrentBranchInfo,
-		config:               config,
+		Common:                cmn,
+		GitCommon:             gitCommon,
+		cmd:                   cmd,
+		getCurrentBranchInfo:  getCurrentBranchInfo,
+		config:                config,
+		predictMergeConflicts: predictMergeConflicts,
 	}
 }
 
@@ -131,12 +136,13 @@ func (self *BranchLoader) Load(reflogCommits []*models.Commit,
 			branch.UpstreamBranch = match.Merge.Short()
 		}
 
-		// If the branch already existed, take over its BehindBaseBranch value
-		// to reduce flicker
+		// If the branch already existed, take over its BehindBaseBranch and
+		// ConflictsWithBaseBranch values to reduce flicker
 		if oldBranch, found := lo.Find(oldBranches, func(b *models.Branch) bool {
 			return b.Name == branch.Name
 		}); found {
 			branch.BehindBaseBranch.Store(oldBranch.BehindBaseBranch.Load())
+			branch.ConflictsWithBaseBranch.Store(oldBranch.ConflictsWithBaseBranch.Load())
 		}
 	}
 
@@ -146,6 +152,12 @@ func (self *BranchLoader) Load(reflogCommits []*models.Commit,
 		})
 	}
 
+	if loadBehindCounts && self.UserConfig().Gui.ShowConflictsWithBaseBranch && self.version.IsAtLeast(2, 38, 0) {
+		onWorker(func() error {
+			return self.GetConflictsWithBaseBranchForAllBranches(branches, mainBranches, renderFunc)
+		})
+	}
+
 	return branches, nil
 }
 
@@ -199,6 +211,48 @@ func (self *BranchLoader) GetBehindBaseBranchValuesForAllBranches(
 	return err
 }
 
+func (self *BranchLoader) GetConflictsWithBaseBranchForAllBranches(
+	branches []*models.Branch,
+	mainBranches *MainBranches,
+	renderFunc func(),
+) error {
+	mainBranchRefs := mainBranches.Get()
+	if len(mainBranchRefs) == 0 {
+		return nil
+	}
+
+	t := time.Now()
+	errg := errgroup.Group{}
+	// Each of these runs a merge, so we don't want too many of them at once
+	errg.SetLimit(runtime.NumCPU())
+
+	for _, branch := range branches {
+		errg.Go(func() error {
+			// A failure for one branch (e.g. because of a corrupt object)
+			// shouldn't keep us from showing the values of the others, so we
+			// only log errors here
+			conflicts := 0 // prime it in case something below fails
+			baseBranch, baseBranchHash, err := self.getBaseBranchWithHash(branch, mainBranches)
+			if err != nil {
+				self.Log.Errorf("could not determine base branch of %s: %v", branch.Name, err)
+			} else if baseBranch != "" && branch.CommitHash != "" {
+				paths, err := self.predictMergeConflicts(baseBranchHash, branch.CommitHash)
+				if err != nil {
+					self.Log.Errorf("could not predict merge conflicts of %s: %v", branch.Name, err)
+				}
+				conflicts = len(paths)
+			}
+			branch.ConflictsWithBaseBranch.Store(int32(conflicts))
+			return nil
+		})
+	}
+
+	err := errg.Wait()
+	self.Log.Debugf("time to get conflicts with base branch for all branches: %s", time.Since(t))
+	renderFunc()
+	return err
+}
+
 // Find the base branch for the given branch (i.e. the main branch that the
 // given branch was forked off of)
 //
@@ -208,28 +262,36 @@ func (self *BranchLoader) GetBehindBaseBranchValuesForAllBranches(
 // error and whether the returned base branch is empty (and possibly react
 // differently in both cases).
 func (self *BranchLoader) GetBaseBranch(branch *models.Branch, mainBranches *MainBranches) (string, error) {
+	baseBranch, _, err := self.getBaseBranchWithHash(branch, mainBranches)
+	return baseBranch, err
+}
+
+// Like GetBaseBranch, but also returns the hash of the commit that the base
+// branch points to
+func (self *BranchLoader) getBaseBranchWithHash(branch *models.Branch, mainBranches *MainBranches) (string, string, error) {
 	mergeBase := mainBranches.GetMergeBase(branch.FullRefName())
 	if mergeBase == "" {
-		return "", nil
+		return "", "", nil
 	}
 
 	output, err := self.cmd.New(
 		NewGitCmd("for-each-ref").
 			Arg("--contains").
 			Arg(mergeBase).
-			Arg("--format=%(refname)").
+			Arg("--format=%(refname) %(objectname)").
 			Arg(mainBranches.Get()...).
 			ToArgv(),
 	).DontLog().RunWithOutput()
 	if err != nil {
-		return "", err
+		return "", "", err
 	}
 	trimmedOutput := strings.TrimSpace(output)
 	split := strings.Split(trimmedOutput, "\n")
 	if len(split) == 0 || split[0] == "" {
-		return "", nil
+		return "", "", nil
 	}
-	return split[0], nil
+	refName, hash, _ := strings.Cut(split[0], " ")
+	return refName, hash, nil
 }
 
 func (self *BranchLoader) obtainBranches() []*models.Branch {
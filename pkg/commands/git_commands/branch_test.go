@@ -317,3 +317,134 @@ func TestBranchCurrentBranchInfo(t *testing.T) {
 		})
 	}
 }
+
+func TestBranchPredictMergeConflicts(t *testing.T) {
+	type scenario struct {
+		testName          string
+		runner            *oscommands.FakeCmdObjRunner
+		expectedConflicts []string
+		expectedErr       string
+	}
+
+	revParseArgs := []string{"rev-parse", "HEAD^{commit}", "feature^{commit}"}
+	revParseOutput := "1111111111111111111111111111111111111111\n2222222222222222222222222222222222222222\n"
+	mergeTreeArgs := []string{"merge-tree", "--write-tree", "--name-only", "--no-messages", "1111111111111111111111111111111111111111", "2222222222222222222222222222222222222222"}
+
+	scenarios := []scenario{
+		{
+			testName: "clean merge",
+			runner: oscommands.NewFakeRunner(t).
+				ExpectGitArgs(revParseArgs, revParseOutput, nil).
+				ExpectGitArgs(mergeTreeArgs, "2b3d9f3a4e0f1c6d7a8b9c0d1e2f3a4b5c6d7e8f\n", nil),
+			expectedConflicts: []string{},
+			expectedErr:       "",
+		},
+		{
+			testName: "conflicting merge",
+			runner: oscommands.NewFakeRunner(t).
+				ExpectGitArgs(revParseArgs, revParseOutput, nil).
+				ExpectGitArgs(mergeTreeArgs, "2b3d9f3a4e0f1c6d7a8b9c0d1e2f3a4b5c6d7e8f\nfile1\ndir/file2\nfile1\n", errors.New("exit status 1")),
+			expectedConflicts: []string{"file1", "dir/file2"},
+			expectedErr:       "",
+		},
+		{
+			testName: "unknown ref",
+			runner: oscommands.NewFakeRunner(t).
+				ExpectGitArgs(revParseArgs, "", errors.New("fatal: ambiguous argument 'feature^{commit}'")),
+			expectedConflicts: nil,
+			expectedErr:       "fatal: ambiguous argument 'feature^{commit}'",
+		},
+		{
+			testName: "failure",
+			runner: oscommands.NewFakeRunner(t).
+				ExpectGitArgs(revParseArgs, revParseOutput, nil).
+				ExpectGitArgs(mergeTreeArgs, "", errors.New("fatal: something went wrong")),
+			expectedConflicts: nil,
+			expectedErr:       "fatal: something went wrong",
+		},
+	}
+
+	for _, s := range scenarios {
+		t.Run(s.testName, func(t *testing.T) {
+			instance := buildBranchCommands(commonDeps{runner: s.runner})
+			conflicts, err := instance.PredictMergeConflicts("HEAD", "feature")
+			assert.Equal(t, s.expectedConflicts, conflicts)
+			if s.expectedErr == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.EqualError(t, err, s.expectedErr)
+			}
+			s.runner.CheckForMissingCalls()
+		})
+	}
+}
+
+func TestBranchPredictMergeConflictsIsCached(t *testing.T) {
+	runner := oscommands.NewFakeRunner(t).
+		ExpectGitArgs([]string{"merge-tree", "--write-tree", "--name-only", "--no-messages", "1111", "2222"},
+			"2b3d9f3a4e0f1c6d7a8b9c0d1e2f3a4b5c6d7e8f\nfile1\n", errors.New("exit status 1"))
+	instance := buildBranchCommands(commonDeps{runner: runner})
+
+	conflicts, err := instance.PredictMergeConflictsForHashes("1111", "2222")
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"file1"}, conflicts)
+
+	// Neither asking again nor asking the other way round runs merge-tree again
+	conflicts, err = instance.PredictMergeConflictsForHashes("1111", "2222")
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"file1"}, conflicts)
+	conflicts, err = instance.PredictMergeConflictsForHashes("2222", "1111")
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"file1"}, conflicts)
+
+	runner.CheckForMissingCalls()
+}
+
+func TestBranchGetFirstCommitHash(t *testing.T) {
+	type scenario struct {
+		testName     string
+		runner       *oscommands.FakeCmdObjRunner
+		expectedHash string
+		expectedErr  string
+	}
+
+	expectedArgs := []string{"rev-list", "--reverse", "refs/heads/feature", "^refs/remotes/origin/master", "--"}
+
+	scenarios := []scenario{
+		{
+			testName: "several commits",
+			runner: oscommands.NewFakeRunner(t).
+				ExpectGitArgs(expectedArgs, "1111111111111111111111111111111111111111\n2222222222222222222222222222222222222222\n", nil),
+			expectedHash: "1111111111111111111111111111111111111111",
+			expectedErr:  "",
+		},
+		{
+			testName: "no commits",
+			runner: oscommands.NewFakeRunner(t).
+				ExpectGitArgs(expectedArgs, "", nil),
+			expectedHash: "",
+			expectedErr:  "",
+		},
+		{
+			testName: "failure",
+			runner: oscommands.NewFakeRunner(t).
+				ExpectGitArgs(expectedArgs, "", errors.New("fatal: bad revision 'refs/heads/feature'")),
+			expectedHash: "",
+			expectedErr:  "fatal: bad revision 'refs/heads/feature'",
+		},
+	}
+
+	for _, s := range scenarios {
+		t.Run(s.testName, func(t *testing.T) {
+			instance := buildBranchCommands(commonDeps{runner: s.runner})
+			hash, err := instance.GetFirstCommitHash("feature", []string{"refs/remotes/origin/master"})
+			assert.Equal(t, s.expectedHash, hash)
+			if s.expectedErr == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.EqualError(t, err, s.expectedErr)
+			}
+			s.runner.CheckForMissingCalls()
+		})
+	}
+}
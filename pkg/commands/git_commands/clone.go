@@ -0,0 +1,57 @@
+package git_commands
+
+import (
+	"io"
+	"strings"
+
+	"github.com/jesseduffield/gocui"
+)
+
+// Commands for getting a new repository. These don't operate on the current
+// repo, so any relative directories are relative to its worktree.
+type CloneCommands struct {
+	*GitCommon
+}
+
+func NewCloneCommands(gitCommon *GitCommon) *CloneCommands {
+	return &CloneCommands{
+		GitCommon: gitCommon,
+	}
+}
+
+// Clones the repository at the given URL into the given directory, writing the
+// progress of the clone to progressWriter
+func (self *CloneCommands) Clone(task gocui.Task, url string, directory string, progressWriter io.Writer) error {
+	cmdArgs := NewGitCmd("clone").
+		// Progress is only reported by default if stderr is a terminal, which
+		// it isn't for us
+		Arg("--progress").
+		Arg("--", url, directory).
+		ToArgv()
+
+	return self.cmd.New(cmdArgs).
+		PromptOnCredentialRequest(task).
+		AlsoStreamOutputTo(progressWriter).
+		Run()
+}
+
+// Creates a new, empty repository in the given directory
+func (self *CloneCommands) Init(directory string) error {
+	cmdArgs := NewGitCmd("init").Arg("--", directory).ToArgv()
+
+	return self.cmd.New(cmdArgs).Run()
+}
+
+// Returns the directory name that `git clone` would use for the given URL,
+// e.g. "lazygit" for "git@github.com:jesseduffield/lazygit.git"
+func RepoNameFromCloneURL(url string) string {
+	name := strings.TrimRight(strings.TrimSpace(url), "/")
+	name = strings.TrimSuffix(name, "/.git")
+	name = strings.TrimSuffix(name, ".git")
+
+	if index := strings.LastIndexAny(name, "/:"); index != -1 {
+		name = name[index+1:]
+	}
+
+	return name
+}
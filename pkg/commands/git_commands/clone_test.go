@@ -0,0 +1,49 @@
+package git_commands
+
+import (
+	"io"
+	"testing"
+
+	"github.com/jesseduffield/gocui"
+	"github.com/jesseduffield/lazygit/pkg/commands/oscommands"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCloneClone(t *testing.T) {
+	runner := oscommands.NewFakeRunner(t).
+		ExpectGitArgs([]string{"clone", "--progress", "--", "https://github.com/jesseduffield/lazygit.git", "../lazygit"}, "", nil)
+	instance := buildCloneCommands(commonDeps{runner: runner})
+
+	assert.NoError(t, instance.Clone(gocui.NewFakeTask(), "https://github.com/jesseduffield/lazygit.git", "../lazygit", io.Discard))
+	runner.CheckForMissingCalls()
+}
+
+func TestCloneInit(t *testing.T) {
+	runner := oscommands.NewFakeRunner(t).
+		ExpectGitArgs([]string{"init", "--", "../new-repo"}, "", nil)
+	instance := buildCloneCommands(commonDeps{runner: runner})
+
+	assert.NoError(t, instance.Init("../new-repo"))
+	runner.CheckForMissingCalls()
+}
+
+func TestRepoNameFromCloneURL(t *testing.T) {
+	scenarios := []struct {
+		url      string
+		expected string
+	}{
+		{url: "https://github.com/jesseduffield/lazygit.git", expected: "lazygit"},
+		{url: "https://github.com/jesseduffield/lazygit", expected: "lazygit"},
+		{url: "https://github.com/jesseduffield/lazygit/", expected: "lazygit"},
+		{url: "git@github.com:jesseduffield/lazygit.git", expected: "lazygit"},
+		{url: "host:repo.git", expected: "repo"},
+		{url: "/srv/git/project/.git", expected: "project"},
+		{url: "../other-repo", expected: "other-repo"},
+	}
+
+	for _, s := range scenarios {
+		t.Run(s.url, func(t *testing.T) {
+			assert.Equal(t, s.expected, RepoNameFromCloneURL(s.url))
+		})
+	}
+}
@@ -6,6 +6,7 @@ import (
 
 	"github.com/go-errors/errors"
 	"github.com/jesseduffield/lazygit/pkg/commands/oscommands"
+	"github.com/samber/lo"
 )
 
 var ErrInvalidCommitIndex = errors.New("invalid commit index")
@@ -267,10 +268,15 @@ func (self *CommitCommands) ShowCmdObj(hash string, filterPaths []string) *oscom
 		Arg(fmt.Sprintf("--unified=%d", contextSize)).
 		Arg("--stat").
 		Arg("--decorate").
+		ArgIf(self.UserConfig().Git.NotesRef != "", "--notes="+self.UserConfig().Git.NotesRef).
 		Arg("-p").
 		Arg(hash).
 		ArgIf(self.UserConfig().Git.IgnoreWhitespaceInDiffView, "--ignore-all-space").
+		ArgIf(self.UserConfig().Git.WordDiffInDiffView, "--word-diff=color").
+		ArgIf(self.UserConfig().Git.ColorMovedInDiffView, "--color-moved").
+		ArgIf(self.UserConfig().Git.DiffAlgorithm != "default", "--diff-algorithm="+self.UserConfig().Git.DiffAlgorithm).
 		Arg(fmt.Sprintf("--find-renames=%d%%", self.UserConfig().Git.RenameSimilarityThreshold)).
+		ArgIf(self.UserConfig().Git.FindCopiesInDiffView, fmt.Sprintf("--find-copies=%d%%", self.UserConfig().Git.RenameSimilarityThreshold)).
 		Arg("--").
 		Arg(filterPaths...).
 		Dir(self.repoPaths.worktreePath).
@@ -299,6 +305,32 @@ func (self *CommitCommands) Revert(hashes []string, isMerge bool) error {
 	return self.cmd.New(cmdArgs).Run()
 }
 
+// Writes the given commits (newest first, like in the commits view) as a
+// series of patch files into outputDir, oldest first. Returns the paths of the
+// files written. We pass the commits explicitly rather than as a range,
+// because that would also pick up commits of branches that were merged in
+// between. Merge commits themselves are skipped by git format-patch.
+func (self *CommitCommands) FormatPatch(hashes []string, outputDir string, coverLetter bool) ([]string, error) {
+	cmdArgs := NewGitCmd("format-patch").
+		// A single commit would be taken as the start of a range up to HEAD.
+		// With several, --no-walk=unsorted takes them as they are, and git
+		// format-patch reverses their order.
+		ArgIfElse(len(hashes) == 1, "-1", "--no-walk=unsorted").
+		ArgIf(coverLetter, "--cover-letter").
+		Arg("--output-directory", outputDir).
+		Arg(hashes...).
+		ToArgv()
+
+	output, err := self.cmd.New(cmdArgs).RunWithOutput()
+	if err != nil {
+		return nil, err
+	}
+
+	return lo.Filter(strings.Split(output, "\n"), func(line string, _ int) bool {
+		return line != ""
+	}), nil
+}
+
 // CreateFixupCommit creates a commit that fixes up a previous commit
 func (self *CommitCommands) CreateFixupCommit(hash string) error {
 	cmdArgs := NewGitCmd("commit").Arg("--fixup=" + hash).ToArgv()
@@ -1,12 +1,12 @@
 package git_commands
 
 import (
+	"fmt"
 	"strings"
 
 	"github.com/jesseduffield/lazygit/pkg/commands/models"
 	"github.com/jesseduffield/lazygit/pkg/commands/oscommands"
 	"github.com/jesseduffield/lazygit/pkg/common"
-	"github.com/samber/lo"
 )
 
 type CommitFileLoader struct {
@@ -29,7 +29,11 @@ func (self *CommitFileLoader) GetFilesInDiff(from string, to string, reverse boo
 		Arg("--no-ext-diff").
 		Arg("--name-status").
 		Arg("-z").
-		Arg("--no-renames").
+		ArgIfElse(
+			self.UserConfig().Git.FindCopiesInDiffView,
+			fmt.Sprintf("--find-copies=%d%%", self.UserConfig().Git.RenameSimilarityThreshold),
+			"--no-renames",
+		).
 		ArgIf(reverse, "-R").
 		Arg(from).
 		Arg(to).
@@ -44,7 +48,9 @@ func (self *CommitFileLoader) GetFilesInDiff(from string, to string, reverse boo
 }
 
 // filenames string is something like "MM\x00file1\x00MU\x00file2\x00AA\x00file3\x00"
-// so we need to split it by the null character and then map each status-name pair to a commit file
+// so we need to split it by the null character and then map each status-name pair to a commit file.
+// When detecting copies, copied and renamed files are followed by two paths instead,
+// e.g. "C75\x00source\x00copy\x00"
 func getCommitFilesFromFilenames(filenames string) []*models.CommitFile {
 	lines := strings.Split(strings.TrimRight(filenames, "\x00"), "\x00")
 	if len(lines) == 1 {
@@ -52,10 +58,29 @@ func getCommitFilesFromFilenames(filenames string) []*models.CommitFile {
 	}
 
 	// typical result looks like 'A my_file' meaning my_file was added
-	return lo.Map(lo.Chunk(lines, 2), func(chunk []string, _ int) *models.CommitFile {
-		return &models.CommitFile{
-			ChangeStatus: chunk[0],
-			Path:         chunk[1],
+	result := []*models.CommitFile{}
+	for i := 0; i+1 < len(lines); i += 2 {
+		status := lines[i]
+		if (strings.HasPrefix(status, "C") || strings.HasPrefix(status, "R")) && i+2 < len(lines) {
+			previousPath, path := lines[i+1], lines[i+2]
+			i++
+
+			if strings.HasPrefix(status, "C") {
+				result = append(result, &models.CommitFile{ChangeStatus: "C", Path: path})
+			} else {
+				// We don't show renames in the commit files view, so that each side
+				// of them can be added to a custom patch separately; list them the
+				// same way as we would without copy detection.
+				result = append(result,
+					&models.CommitFile{ChangeStatus: "D", Path: previousPath},
+					&models.CommitFile{ChangeStatus: "A", Path: path},
+				)
+			}
+			continue
 		}
-	})
+
+		result = append(result, &models.CommitFile{ChangeStatus: status, Path: lines[i+1]})
+	}
+
+	return result
 }
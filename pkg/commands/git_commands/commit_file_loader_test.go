@@ -60,6 +60,28 @@ func TestGetCommitFilesFromFilenames(t *testing.T) {
 				},
 			},
 		},
+		{
+			testName: "copied and renamed files",
+			input:    "C75\x00Myfile\x00MyCopy\x00M\x00Myfile\x00R100\x00OldName\x00NewName\x00",
+			output: []*models.CommitFile{
+				{
+					Path:         "MyCopy",
+					ChangeStatus: "C",
+				},
+				{
+					Path:         "Myfile",
+					ChangeStatus: "M",
+				},
+				{
+					Path:         "OldName",
+					ChangeStatus: "D",
+				},
+				{
+					Path:         "NewName",
+					ChangeStatus: "A",
+				},
+			},
+		},
 	}
 
 	for _, test := range tests {
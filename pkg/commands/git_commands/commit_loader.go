@@ -68,13 +68,34 @@ type GetCommitsOptions struct {
 	RefToShowDivergenceFrom string
 	MainBranches            *MainBranches
 	HashPool                *utils.StringPool
+	// If non-empty, only show commits that touched this range of lines, in the
+	// "<start>,<end>:<file>" format of git log -L. Takes precedence over FilterPath.
+	FilterLineRange string
+	// If non-empty, only show commits that change the number of occurrences of
+	// this string (git log -S)
+	FilterPickaxe string
+	// If non-empty, only show commits whose diff has added or removed lines
+	// matching this regex (git log -G)
+	FilterPickaxeRegex string
+	// If non-empty, only show commits whose message matches this pattern (git
+	// log --grep)
+	FilterMessage string
 }
 
 // GetCommits obtains the commits of the current branch
 func (self *CommitLoader) GetCommits(opts GetCommitsOptions) ([]*models.Commit, error) {
 	commits := []*models.Commit{}
 
-	if opts.IncludeRebaseCommits && opts.FilterPath == "" {
+	if opts.FilterLineRange != "" {
+		// git log -L can't be combined with a pathspec
+		opts.FilterPath = ""
+	}
+
+	// The rebase todos can't be filtered by path or content, so we leave them
+	// out when filtering by any of those
+	omitRebaseTodos := opts.FilterPath != "" || opts.FilterLineRange != "" ||
+		opts.FilterPickaxe != "" || opts.FilterPickaxeRegex != "" || opts.FilterMessage != ""
+	if opts.IncludeRebaseCommits && !omitRebaseTodos {
 		var err error
 		commits, err = self.MergeRebasingCommits(opts.HashPool, commits)
 		if err != nil {
@@ -592,8 +613,12 @@ func (self *CommitLoader) getLogCmd(opts GetCommitsOptions) *oscommands.CmdObj {
 		Arg(prettyFormat).
 		Arg("--abbrev=40").
 		ArgIf(opts.FilterAuthor != "", "--author="+opts.FilterAuthor).
+		ArgIf(opts.FilterPickaxe != "", "-S"+opts.FilterPickaxe).
+		ArgIf(opts.FilterPickaxeRegex != "", "-G"+opts.FilterPickaxeRegex).
+		ArgIf(opts.FilterMessage != "", "--grep="+opts.FilterMessage).
 		ArgIf(opts.Limit, "-300").
 		ArgIf(opts.FilterPath != "", "--follow", "--name-status").
+		ArgIf(opts.FilterLineRange != "", "-L"+opts.FilterLineRange, "--no-patch").
 		Arg("--no-show-signature").
 		ArgIf(opts.RefToShowDivergenceFrom != "", "--left-right").
 		Arg("--").
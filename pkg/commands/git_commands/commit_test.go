@@ -198,6 +198,46 @@ func TestCommitCreateFixupCommit(t *testing.T) {
 	}
 }
 
+func TestCommitFormatPatch(t *testing.T) {
+	type scenario struct {
+		testName      string
+		hashes        []string
+		coverLetter   bool
+		runner        *oscommands.FakeCmdObjRunner
+		expectedFiles []string
+	}
+
+	scenarios := []scenario{
+		{
+			testName: "single commit",
+			hashes:   []string{"12345"},
+			runner: oscommands.NewFakeRunner(t).
+				ExpectGitArgs([]string{"format-patch", "-1", "--output-directory", "patches", "12345"},
+					"patches/0001-first.patch\n", nil),
+			expectedFiles: []string{"patches/0001-first.patch"},
+		},
+		{
+			testName:    "several commits with cover letter",
+			hashes:      []string{"67890", "12345"},
+			coverLetter: true,
+			runner: oscommands.NewFakeRunner(t).
+				ExpectGitArgs([]string{"format-patch", "--no-walk=unsorted", "--cover-letter", "--output-directory", "patches", "67890", "12345"},
+					"patches/0000-cover-letter.patch\npatches/0001-first.patch\npatches/0002-second.patch\n", nil),
+			expectedFiles: []string{"patches/0000-cover-letter.patch", "patches/0001-first.patch", "patches/0002-second.patch"},
+		},
+	}
+
+	for _, s := range scenarios {
+		t.Run(s.testName, func(t *testing.T) {
+			instance := buildCommitCommands(commonDeps{runner: s.runner})
+			files, err := instance.FormatPatch(s.hashes, "patches", s.coverLetter)
+			assert.NoError(t, err)
+			assert.Equal(t, s.expectedFiles, files)
+			s.runner.CheckForMissingCalls()
+		})
+	}
+}
+
 func TestCommitCreateAmendCommit(t *testing.T) {
 	type scenario struct {
 		testName           string
@@ -255,7 +295,12 @@ func TestCommitShowCmdObj(t *testing.T) {
 		contextSize         uint64
 		similarityThreshold int
 		ignoreWhitespace    bool
+		wordDiff            bool
+		colorMoved          bool
+		diffAlgorithm       string
+		findCopies          bool
 		pagerConfig         *config.PagingConfig
+		notesRef            string
 		expected            []string
 	}
 
@@ -305,6 +350,26 @@ func TestCommitShowCmdObj(t *testing.T) {
 			pagerConfig:         nil,
 			expected:            []string{"-C", "/path/to/worktree", "-c", "diff.noprefix=false", "show", "--no-ext-diff", "--submodule", "--color=always", "--unified=77", "--stat", "--decorate", "-p", "1234567890", "--ignore-all-space", "--find-renames=50%", "--"},
 		},
+		{
+			testName:            "Show diff with word diff and moved lines",
+			filterPaths:         []string{},
+			contextSize:         3,
+			similarityThreshold: 50,
+			wordDiff:            true,
+			colorMoved:          true,
+			pagerConfig:         nil,
+			expected:            []string{"-C", "/path/to/worktree", "-c", "diff.noprefix=false", "show", "--no-ext-diff", "--submodule", "--color=always", "--unified=3", "--stat", "--decorate", "-p", "1234567890", "--word-diff=color", "--color-moved", "--find-renames=50%", "--"},
+		},
+		{
+			testName:            "Show diff with patience algorithm and copy detection",
+			filterPaths:         []string{},
+			contextSize:         3,
+			similarityThreshold: 50,
+			diffAlgorithm:       "patience",
+			findCopies:          true,
+			pagerConfig:         nil,
+			expected:            []string{"-C", "/path/to/worktree", "-c", "diff.noprefix=false", "show", "--no-ext-diff", "--submodule", "--color=always", "--unified=3", "--stat", "--decorate", "-p", "1234567890", "--diff-algorithm=patience", "--find-renames=50%", "--find-copies=50%", "--"},
+		},
 		{
 			testName:            "Show diff with external diff command",
 			filterPaths:         []string{},
@@ -323,6 +388,16 @@ func TestCommitShowCmdObj(t *testing.T) {
 			pagerConfig:         &config.PagingConfig{UseExternalDiffGitConfig: true},
 			expected:            []string{"-C", "/path/to/worktree", "-c", "diff.noprefix=false", "show", "--ext-diff", "--submodule", "--color=always", "--unified=3", "--stat", "--decorate", "-p", "1234567890", "--find-renames=50%", "--"},
 		},
+		{
+			testName:            "Show diff with custom notes ref",
+			filterPaths:         []string{},
+			contextSize:         3,
+			similarityThreshold: 50,
+			ignoreWhitespace:    false,
+			pagerConfig:         nil,
+			notesRef:            "refs/notes/review",
+			expected:            []string{"-C", "/path/to/worktree", "-c", "diff.noprefix=false", "show", "--no-ext-diff", "--submodule", "--color=always", "--unified=3", "--stat", "--decorate", "--notes=refs/notes/review", "-p", "1234567890", "--find-renames=50%", "--"},
+		},
 	}
 
 	for _, s := range scenarios {
@@ -332,8 +407,15 @@ func TestCommitShowCmdObj(t *testing.T) {
 				userConfig.Git.Pagers = []config.PagingConfig{*s.pagerConfig}
 			}
 			userConfig.Git.IgnoreWhitespaceInDiffView = s.ignoreWhitespace
+			userConfig.Git.WordDiffInDiffView = s.wordDiff
+			userConfig.Git.ColorMovedInDiffView = s.colorMoved
+			if s.diffAlgorithm != "" {
+				userConfig.Git.DiffAlgorithm = s.diffAlgorithm
+			}
+			userConfig.Git.FindCopiesInDiffView = s.findCopies
 			userConfig.Git.DiffContextSize = s.contextSize
 			userConfig.Git.RenameSimilarityThreshold = s.similarityThreshold
+			userConfig.Git.NotesRef = s.notesRef
 
 			runner := oscommands.NewFakeRunner(t).ExpectGitArgs(s.expected, "", nil)
 			repoPaths := RepoPaths{
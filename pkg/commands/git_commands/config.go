@@ -101,6 +101,19 @@ func (self *ConfigCommands) GetMergeFF() string {
 	return self.gitConfig.Get("merge.ff")
 }
 
+func (self *ConfigCommands) GetSparseCheckout() bool {
+	return self.gitConfig.GetBool("core.sparseCheckout")
+}
+
+func (self *ConfigCommands) GetSparseCheckoutCone() bool {
+	return self.gitConfig.GetBool("core.sparseCheckoutCone")
+}
+
+// Returns whether rerere.enabled is true, and whether it is set at all
+func (self *ConfigCommands) GetRerereEnabled() (bool, bool) {
+	return self.gitConfig.GetBool("rerere.enabled"), self.gitConfig.Get("rerere.enabled") != ""
+}
+
 func (self *ConfigCommands) DropConfigCache() {
 	self.gitConfig.DropCache()
 }
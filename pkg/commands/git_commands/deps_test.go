@@ -167,3 +167,39 @@ func buildFlowCommands(deps commonDeps) *FlowCommands {
 
 	return NewFlowCommands(gitCommon)
 }
+
+func buildLfsCommands(deps commonDeps) *LfsCommands {
+	gitCommon := buildGitCommon(deps)
+
+	return NewLfsCommands(gitCommon)
+}
+
+func buildBisectCommands(deps commonDeps) *BisectCommands {
+	gitCommon := buildGitCommon(deps)
+
+	return NewBisectCommands(gitCommon)
+}
+
+func buildCloneCommands(deps commonDeps) *CloneCommands {
+	gitCommon := buildGitCommon(deps)
+
+	return NewCloneCommands(gitCommon)
+}
+
+func buildRerereCommands(deps commonDeps) *RerereCommands {
+	gitCommon := buildGitCommon(deps)
+
+	return NewRerereCommands(gitCommon)
+}
+
+func buildNotesCommands(deps commonDeps) *NotesCommands {
+	gitCommon := buildGitCommon(deps)
+
+	return NewNotesCommands(gitCommon)
+}
+
+func buildSparseCheckoutCommands(deps commonDeps) *SparseCheckoutCommands {
+	gitCommon := buildGitCommon(deps)
+
+	return NewSparseCheckoutCommands(gitCommon)
+}
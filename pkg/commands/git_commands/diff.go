@@ -2,8 +2,10 @@ package git_commands
 
 import (
 	"fmt"
+	"regexp"
 
 	"github.com/jesseduffield/lazygit/pkg/commands/oscommands"
+	"github.com/jesseduffield/lazygit/pkg/utils"
 )
 
 type DiffCommands struct {
@@ -32,6 +34,9 @@ func (self *DiffCommands) DiffCmdObj(diffArgs []string) *oscommands.CmdObj {
 			Arg("--submodule").
 			Arg(fmt.Sprintf("--color=%s", self.pagerConfig.GetColorArg())).
 			ArgIf(ignoreWhitespace, "--ignore-all-space").
+			ArgIf(self.UserConfig().Git.WordDiffInDiffView, "--word-diff=color").
+			ArgIf(self.UserConfig().Git.ColorMovedInDiffView, "--color-moved").
+			ArgIf(self.UserConfig().Git.DiffAlgorithm != "default", "--diff-algorithm="+self.UserConfig().Git.DiffAlgorithm).
 			Arg(fmt.Sprintf("--unified=%d", self.UserConfig().Git.DiffContextSize)).
 			Arg(diffArgs...).
 			Dir(self.repoPaths.worktreePath).
@@ -39,6 +44,36 @@ func (self *DiffCommands) DiffCmdObj(diffArgs []string) *oscommands.CmdObj {
 	)
 }
 
+// Renders a range-diff between the commits that are only reachable from
+// `from` and the ones that are only reachable from `to`, e.g. the old and the
+// new version of a branch that was rebased.
+func (self *DiffCommands) RangeDiffCmdObj(from string, to string) *oscommands.CmdObj {
+	return self.cmd.New(
+		NewGitCmd("range-diff").
+			Arg(fmt.Sprintf("--color=%s", self.pagerConfig.GetColorArg())).
+			Arg(from + "..." + to).
+			Dir(self.repoPaths.worktreePath).
+			ToArgv(),
+	)
+}
+
+// Matches the header line of a commit pair in the output of git range-diff,
+// e.g. "1:  0ddba11 ! 1:  f00dfee Commit subject" or "-:  ------- > 2:  c0ffee1 Commit subject"
+var rangeDiffPairHeaderRegexp = regexp.MustCompile(`^\s*(\d+|-):\s+[0-9a-f-]+ [=!<>] \s*(\d+|-):\s+[0-9a-f-]+ `)
+
+// Returns the indices of the lines that start a new commit pair in the output
+// of git range-diff. The lines may contain color escape sequences.
+func RangeDiffPairHeaderLineIndices(lines []string) []int {
+	indices := []int{}
+	for i, line := range lines {
+		if rangeDiffPairHeaderRegexp.MatchString(utils.Decolorise(line)) {
+			indices = append(indices, i)
+		}
+	}
+
+	return indices
+}
+
 // This is a basic generic diff command that can be used for any diff operation
 // (e.g. copying a diff to the clipboard). It will not use a custom pager, and
 // does not use user configs such as ignore whitespace.
@@ -0,0 +1,36 @@
+package git_commands
+
+import (
+	"testing"
+
+	"github.com/jesseduffield/lazygit/pkg/commands/oscommands"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDiffRangeDiffCmdObj(t *testing.T) {
+	runner := oscommands.NewFakeRunner(t).
+		ExpectGitArgs([]string{"-C", "/path/to/worktree", "range-diff", "--color=always", "old-feature...feature"}, "", nil)
+	repoPaths := RepoPaths{
+		worktreePath: "/path/to/worktree",
+	}
+	instance := NewDiffCommands(buildGitCommon(commonDeps{runner: runner, repoPaths: &repoPaths}))
+
+	assert.NoError(t, instance.RangeDiffCmdObj("old-feature", "feature").Run())
+	runner.CheckForMissingCalls()
+}
+
+func TestRangeDiffPairHeaderLineIndices(t *testing.T) {
+	lines := []string{
+		"-:  ------- > 1:  9ec1c2f main2",
+		"1:  9208f1f = 2:  ad30080 one",
+		"2:  2716d3c ! 3:  2e6eccb two",
+		"    @@ Metadata",
+		"     Author: a <a@b>",
+		"    -    two",
+		"    +    two v2",
+		"\x1b[33m 3:  2716d3c\x1b[m \x1b[31m<\x1b[m \x1b[33m -:  -------\x1b[m gone",
+		"-:  ------- > 4:  36d148b three",
+	}
+
+	assert.Equal(t, []int{0, 1, 2, 7, 8}, RangeDiffPairHeaderLineIndices(lines))
+}
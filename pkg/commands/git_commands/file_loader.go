@@ -2,12 +2,16 @@ package git_commands
 
 import (
 	"fmt"
+	"os"
 	"path/filepath"
 	"strconv"
 	"strings"
+	"sync"
+	"time"
 
 	"github.com/jesseduffield/lazygit/pkg/commands/models"
 	"github.com/jesseduffield/lazygit/pkg/commands/oscommands"
+	"github.com/samber/lo"
 )
 
 type FileLoaderConfig interface {
@@ -19,6 +23,19 @@ type FileLoader struct {
 	cmd         oscommands.ICmdObjBuilder
 	config      FileLoaderConfig
 	getFileType func(string) string
+	lfsPaths    lfsPathsCache
+}
+
+// Remembers which paths are tracked by LFS, so that we don't need to ask git
+// about all files on every refresh. This can only change when a .gitattributes
+// file changes, which either happens in the working tree (and then it shows up
+// among the files) or through a checkout, which updates the index; so we start
+// over whenever one of these happens. Changes to .git/info/attributes are only
+// picked up once one of them happens too.
+type lfsPathsCache struct {
+	mutex        sync.Mutex
+	indexModTime time.Time
+	isLfs        map[string]bool
 }
 
 func NewFileLoader(gitCommon *GitCommon, cmd oscommands.ICmdObjBuilder, config FileLoaderConfig) *FileLoader {
@@ -102,9 +119,74 @@ func (self *FileLoader) GetStatusFiles(opts GetStatusFileOptions) []*models.File
 		}
 	}
 
+	self.markLfsFiles(files)
+	self.markRerereResolvedFiles(files)
+
 	return files
 }
 
+func (self *FileLoader) markLfsFiles(files []*models.File) {
+	if len(files) == 0 {
+		return
+	}
+
+	var indexModTime time.Time
+	if info, err := os.Stat(filepath.Join(self.repoPaths.WorktreeGitDirPath(), "index")); err == nil {
+		indexModTime = info.ModTime()
+	}
+	attributesChanged := lo.SomeBy(files, func(file *models.File) bool {
+		return filepath.Base(file.Path) == ".gitattributes"
+	})
+
+	cache := &self.lfsPaths
+	cache.mutex.Lock()
+	defer cache.mutex.Unlock()
+
+	if cache.isLfs == nil || attributesChanged || !indexModTime.Equal(cache.indexModTime) {
+		cache.isLfs = map[string]bool{}
+		cache.indexModTime = indexModTime
+	}
+
+	paths := lo.FilterMap(files, func(file *models.File, _ int) (string, bool) {
+		_, known := cache.isLfs[file.Path]
+		return file.Path, !known
+	})
+	if len(paths) > 0 {
+		lfsPaths, err := lfsTrackedPaths(self.cmd, paths)
+		if err != nil {
+			self.Log.Error(err)
+			return
+		}
+
+		for _, path := range paths {
+			cache.isLfs[path] = lfsPaths.Includes(path)
+		}
+	}
+
+	for _, file := range files {
+		file.IsLfs = cache.isLfs[file.Path]
+	}
+}
+
+func (self *FileLoader) markRerereResolvedFiles(files []*models.File) {
+	hasInlineMergeConflicts := func(file *models.File) bool { return file.HasInlineMergeConflicts }
+	if !lo.SomeBy(files, hasInlineMergeConflicts) || !isRerereEnabled(self.GitCommon) {
+		return
+	}
+
+	remainingPaths, err := rerereRemainingPaths(self.cmd)
+	if err != nil {
+		self.Log.Error(err)
+		return
+	}
+
+	// Git keeps reporting a file as conflicted after rerere has resolved it,
+	// until the file is staged; only `rerere remaining` tells the two apart
+	for _, file := range files {
+		file.IsRerereResolved = file.HasInlineMergeConflicts && !remainingPaths.Includes(file.Path)
+	}
+}
+
 type FileDiff struct {
 	LinesAdded   int
 	LinesDeleted int
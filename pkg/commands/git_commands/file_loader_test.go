@@ -3,9 +3,11 @@ package git_commands
 import (
 	"testing"
 
+	"github.com/jesseduffield/lazygit/pkg/commands/git_config"
 	"github.com/jesseduffield/lazygit/pkg/commands/models"
 	"github.com/jesseduffield/lazygit/pkg/commands/oscommands"
 	"github.com/jesseduffield/lazygit/pkg/config"
+	"github.com/samber/lo"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -15,6 +17,7 @@ func TestFileGetStatusFiles(t *testing.T) {
 		similarityThreshold    int
 		runner                 oscommands.ICmdObjRunner
 		showNumstatInFilesView bool
+		gitConfig              map[string]string
 		expectedFiles          []*models.File
 	}
 
@@ -37,7 +40,8 @@ func TestFileGetStatusFiles(t *testing.T) {
 				ExpectGitArgs([]string{"diff", "--numstat", "-z", "HEAD"},
 					"4\t1\tfile1.txt\x001\t0\tfile2.txt\x002\t2\tfile3.txt\x000\t2\tfile4.txt\x002\t2\tfile5.txt",
 					nil,
-				),
+				).
+				ExpectGitArgs([]string{"check-attr", "-z", "--stdin", "filter"}, "", nil),
 			showNumstatInFilesView: true,
 			expectedFiles: []*models.File{
 				{
@@ -116,7 +120,8 @@ func TestFileGetStatusFiles(t *testing.T) {
 			testName:            "File with new line char",
 			similarityThreshold: 50,
 			runner: oscommands.NewFakeRunner(t).
-				ExpectGitArgs([]string{"status", "--untracked-files=yes", "--porcelain", "-z", "--find-renames=50%"}, "MM a\nb.txt", nil),
+				ExpectGitArgs([]string{"status", "--untracked-files=yes", "--porcelain", "-z", "--find-renames=50%"}, "MM a\nb.txt", nil).
+				ExpectGitArgs([]string{"check-attr", "-z", "--stdin", "filter"}, "", nil),
 			expectedFiles: []*models.File{
 				{
 					Path:                    "a\nb.txt",
@@ -139,7 +144,8 @@ func TestFileGetStatusFiles(t *testing.T) {
 				ExpectGitArgs([]string{"status", "--untracked-files=yes", "--porcelain", "-z", "--find-renames=50%"},
 					"R  after1.txt\x00before1.txt\x00RM after2.txt\x00before2.txt",
 					nil,
-				),
+				).
+				ExpectGitArgs([]string{"check-attr", "-z", "--stdin", "filter"}, "", nil),
 			expectedFiles: []*models.File{
 				{
 					Path:                    "after1.txt",
@@ -176,7 +182,8 @@ func TestFileGetStatusFiles(t *testing.T) {
 				ExpectGitArgs([]string{"status", "--untracked-files=yes", "--porcelain", "-z", "--find-renames=50%"},
 					`?? a -> b.txt`,
 					nil,
-				),
+				).
+				ExpectGitArgs([]string{"check-attr", "-z", "--stdin", "filter"}, "", nil),
 			expectedFiles: []*models.File{
 				{
 					Path:                    "a -> b.txt",
@@ -192,6 +199,69 @@ func TestFileGetStatusFiles(t *testing.T) {
 				},
 			},
 		},
+		{
+			testName:            "Files tracked by LFS",
+			similarityThreshold: 50,
+			runner: oscommands.NewFakeRunner(t).
+				ExpectGitArgs([]string{"status", "--untracked-files=yes", "--porcelain", "-z", "--find-renames=50%"},
+					" M image.psd\x00 M notes.txt",
+					nil,
+				).
+				ExpectGitArgs([]string{"check-attr", "-z", "--stdin", "filter"},
+					"image.psd\x00filter\x00lfs\x00notes.txt\x00filter\x00unspecified\x00",
+					nil,
+				),
+			expectedFiles: []*models.File{
+				{
+					Path:               "image.psd",
+					HasUnstagedChanges: true,
+					Tracked:            true,
+					DisplayString:      " M image.psd",
+					ShortStatus:        " M",
+					IsLfs:              true,
+				},
+				{
+					Path:               "notes.txt",
+					HasUnstagedChanges: true,
+					Tracked:            true,
+					DisplayString:      " M notes.txt",
+					ShortStatus:        " M",
+				},
+			},
+		},
+		{
+			testName:            "Files resolved by rerere",
+			similarityThreshold: 50,
+			gitConfig:           map[string]string{"rerere.enabled": "true"},
+			runner: oscommands.NewFakeRunner(t).
+				ExpectGitArgs([]string{"status", "--untracked-files=yes", "--porcelain", "-z", "--find-renames=50%"},
+					"UU resolved.txt\x00UU unresolved.txt",
+					nil,
+				).
+				ExpectGitArgs([]string{"rerere", "remaining"}, "unresolved.txt\n", nil).
+				ExpectGitArgs([]string{"check-attr", "-z", "--stdin", "filter"}, "", nil),
+			expectedFiles: []*models.File{
+				{
+					Path:                    "resolved.txt",
+					HasUnstagedChanges:      true,
+					Tracked:                 true,
+					HasMergeConflicts:       true,
+					HasInlineMergeConflicts: true,
+					DisplayString:           "UU resolved.txt",
+					ShortStatus:             "UU",
+					IsRerereResolved:        true,
+				},
+				{
+					Path:                    "unresolved.txt",
+					HasUnstagedChanges:      true,
+					Tracked:                 true,
+					HasMergeConflicts:       true,
+					HasInlineMergeConflicts: true,
+					DisplayString:           "UU unresolved.txt",
+					ShortStatus:             "UU",
+				},
+			},
+		},
 		{
 			testName:            "Copied files",
 			similarityThreshold: 50,
@@ -199,7 +269,8 @@ func TestFileGetStatusFiles(t *testing.T) {
 				ExpectGitArgs([]string{"status", "--untracked-files=yes", "--porcelain", "-z", "--find-renames=50%"},
 					"C  copy1.txt\x00original.txt\x00CM copy2.txt\x00original.txt",
 					nil,
-				),
+				).
+				ExpectGitArgs([]string{"check-attr", "-z", "--stdin", "filter"}, "", nil),
 			expectedFiles: []*models.File{
 				{
 					Path:                    "copy1.txt",
@@ -240,7 +311,7 @@ func TestFileGetStatusFiles(t *testing.T) {
 			userConfig.Git.RenameSimilarityThreshold = s.similarityThreshold
 
 			loader := &FileLoader{
-				GitCommon:   buildGitCommon(commonDeps{appState: &config.AppState{}, userConfig: userConfig}),
+				GitCommon:   buildGitCommon(commonDeps{appState: &config.AppState{}, userConfig: userConfig, gitConfig: git_config.NewFakeGitConfig(s.gitConfig)}),
 				cmd:         cmd,
 				config:      &FakeFileLoaderConfig{showUntrackedFiles: "yes"},
 				getFileType: func(string) string { return "file" },
@@ -251,6 +322,41 @@ func TestFileGetStatusFiles(t *testing.T) {
 	}
 }
 
+func TestFileLoaderCachesLfsPaths(t *testing.T) {
+	statusArgs := []string{"status", "--untracked-files=yes", "--porcelain", "-z", "--find-renames=50%"}
+	checkAttrArgs := []string{"check-attr", "-z", "--stdin", "filter"}
+	runner := oscommands.NewFakeRunner(t).
+		ExpectGitArgs(statusArgs, " M image.psd", nil).
+		ExpectGitArgs(checkAttrArgs, "image.psd\x00filter\x00lfs\x00", nil).
+		// Only the new file is looked up
+		ExpectGitArgs(statusArgs, " M image.psd\x00 M notes.txt", nil).
+		ExpectGitArgs(checkAttrArgs, "notes.txt\x00filter\x00unspecified\x00", nil).
+		// Nothing needs to be looked up
+		ExpectGitArgs(statusArgs, " M image.psd\x00 M notes.txt", nil).
+		// A changed .gitattributes file makes us start over
+		ExpectGitArgs(statusArgs, " M .gitattributes\x00 M image.psd", nil).
+		ExpectGitArgs(checkAttrArgs, ".gitattributes\x00filter\x00unspecified\x00image.psd\x00filter\x00unspecified\x00", nil)
+
+	userConfig := &config.UserConfig{}
+	userConfig.Git.RenameSimilarityThreshold = 50
+	loader := &FileLoader{
+		GitCommon:   buildGitCommon(commonDeps{appState: &config.AppState{}, userConfig: userConfig, gitConfig: git_config.NewFakeGitConfig(nil)}),
+		cmd:         oscommands.NewDummyCmdObjBuilder(runner),
+		config:      &FakeFileLoaderConfig{showUntrackedFiles: "yes"},
+		getFileType: func(string) string { return "file" },
+	}
+
+	isLfs := func() []bool {
+		return lo.Map(loader.GetStatusFiles(GetStatusFileOptions{}), func(file *models.File, _ int) bool { return file.IsLfs })
+	}
+
+	assert.Equal(t, []bool{true}, isLfs())
+	assert.Equal(t, []bool{true, false}, isLfs())
+	assert.Equal(t, []bool{true, false}, isLfs())
+	assert.Equal(t, []bool{false, false}, isLfs())
+	runner.CheckForMissingCalls()
+}
+
 type FakeFileLoaderConfig struct {
 	showUntrackedFiles string
 }
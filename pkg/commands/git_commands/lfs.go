@@ -0,0 +1,129 @@
+package git_commands
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/jesseduffield/generics/set"
+	"github.com/jesseduffield/gocui"
+	"github.com/jesseduffield/lazygit/pkg/commands/oscommands"
+)
+
+type LfsCommands struct {
+	*GitCommon
+}
+
+func NewLfsCommands(gitCommon *GitCommon) *LfsCommands {
+	return &LfsCommands{
+		GitCommon: gitCommon,
+	}
+}
+
+// An LFS pointer file, which is what git stores in place of the actual content
+// of a file that is tracked by LFS
+type LfsPointer struct {
+	// e.g. "sha256:4d7a2146..."
+	Oid  string
+	Size int64
+}
+
+// Pointer files are tiny, so anything bigger than this can't be one and we
+// don't need to load it
+const maxLfsPointerSize = 1024
+
+// Returns the pointer of the given file at the given ref. Pass an empty ref to
+// get the pointer from the index. Returns nil if the file doesn't exist at that
+// ref or isn't stored as an LFS pointer there.
+func (self *LfsCommands) Pointer(ref string, path string) *LfsPointer {
+	object := ref + ":" + path
+
+	sizeOutput, err := self.cmd.New(NewGitCmd("cat-file").Arg("-s", object).ToArgv()).DontLog().RunWithOutput()
+	if err != nil {
+		return nil
+	}
+
+	size, err := strconv.Atoi(strings.TrimSpace(sizeOutput))
+	if err != nil || size > maxLfsPointerSize {
+		return nil
+	}
+
+	content, err := self.cmd.New(NewGitCmd("cat-file").Arg("blob", object).ToArgv()).DontLog().RunWithOutput()
+	if err != nil {
+		return nil
+	}
+
+	return parseLfsPointer(content)
+}
+
+// Shows the lock of the given file, including its owner
+func (self *LfsCommands) LocksCmdObj(path string) *oscommands.CmdObj {
+	cmdArgs := NewGitCmd("lfs").Arg("locks", "--path="+path).ToArgv()
+
+	return self.cmd.New(cmdArgs).DontLog()
+}
+
+func (self *LfsCommands) Lock(task gocui.Task, path string) error {
+	cmdArgs := NewGitCmd("lfs").Arg("lock", path).ToArgv()
+
+	return self.cmd.New(cmdArgs).PromptOnCredentialRequest(task).Run()
+}
+
+// Force-unlocking is needed to remove a lock owned by somebody else
+func (self *LfsCommands) Unlock(task gocui.Task, path string, force bool) error {
+	cmdArgs := NewGitCmd("lfs").Arg("unlock").ArgIf(force, "--force").Arg(path).ToArgv()
+
+	return self.cmd.New(cmdArgs).PromptOnCredentialRequest(task).Run()
+}
+
+// Returns the subset of the given paths whose filter attribute is "lfs". Asking
+// git rather than reading .gitattributes ourselves means that nested
+// .gitattributes files and .git/info/attributes are taken into account too.
+func lfsTrackedPaths(cmd oscommands.ICmdObjBuilder, paths []string) (*set.Set[string], error) {
+	// The paths are passed on stdin so that we can't hit the command line
+	// length limit when there are many changed files
+	cmdArgs := NewGitCmd("check-attr").Arg("-z", "--stdin", "filter").ToArgv()
+
+	output, err := cmd.New(cmdArgs).SetStdin(strings.Join(paths, "\x00")).DontLog().RunWithOutput()
+	if err != nil {
+		return nil, err
+	}
+
+	// The output consists of "<path>\0filter\0<value>\0" triples
+	result := set.New[string]()
+	fields := strings.Split(output, "\x00")
+	for i := 0; i+2 < len(fields); i += 3 {
+		if fields[i+2] == "lfs" {
+			result.Add(fields[i])
+		}
+	}
+
+	return result, nil
+}
+
+func parseLfsPointer(content string) *LfsPointer {
+	lines := strings.Split(strings.TrimSpace(content), "\n")
+	if len(lines) == 0 || !strings.HasPrefix(lines[0], "version https://git-lfs.github.com/spec/") {
+		return nil
+	}
+
+	pointer := &LfsPointer{}
+	for _, line := range lines[1:] {
+		key, value, _ := strings.Cut(line, " ")
+		switch key {
+		case "oid":
+			pointer.Oid = value
+		case "size":
+			size, err := strconv.ParseInt(value, 10, 64)
+			if err != nil {
+				return nil
+			}
+			pointer.Size = size
+		}
+	}
+
+	if pointer.Oid == "" {
+		return nil
+	}
+
+	return pointer
+}
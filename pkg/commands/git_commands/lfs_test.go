@@ -0,0 +1,91 @@
+package git_commands
+
+import (
+	"testing"
+
+	"github.com/jesseduffield/gocui"
+	"github.com/jesseduffield/lazygit/pkg/commands/oscommands"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLfsPointer(t *testing.T) {
+	type scenario struct {
+		testName        string
+		runner          *oscommands.FakeCmdObjRunner
+		expectedPointer *LfsPointer
+	}
+
+	scenarios := []scenario{
+		{
+			testName: "pointer file",
+			runner: oscommands.NewFakeRunner(t).
+				ExpectGitArgs([]string{"cat-file", "-s", "HEAD:image.psd"}, "130\n", nil).
+				ExpectGitArgs([]string{"cat-file", "blob", "HEAD:image.psd"},
+					"version https://git-lfs.github.com/spec/v1\noid sha256:4d7a214614ab2935c943f9e0ff69d22eadbb8f32b1258daaa5e2ca24d17e2393\nsize 12345\n", nil),
+			expectedPointer: &LfsPointer{
+				Oid:  "sha256:4d7a214614ab2935c943f9e0ff69d22eadbb8f32b1258daaa5e2ca24d17e2393",
+				Size: 12345,
+			},
+		},
+		{
+			testName: "file that doesn't exist at the ref",
+			runner: oscommands.NewFakeRunner(t).
+				ExpectGitArgs([]string{"cat-file", "-s", "HEAD:image.psd"}, "", assert.AnError),
+			expectedPointer: nil,
+		},
+		{
+			testName: "file too big to be a pointer",
+			runner: oscommands.NewFakeRunner(t).
+				ExpectGitArgs([]string{"cat-file", "-s", "HEAD:image.psd"}, "5000000\n", nil),
+			expectedPointer: nil,
+		},
+		{
+			testName: "small file that isn't a pointer",
+			runner: oscommands.NewFakeRunner(t).
+				ExpectGitArgs([]string{"cat-file", "-s", "HEAD:image.psd"}, "6\n", nil).
+				ExpectGitArgs([]string{"cat-file", "blob", "HEAD:image.psd"}, "hello\n", nil),
+			expectedPointer: nil,
+		},
+	}
+
+	for _, s := range scenarios {
+		t.Run(s.testName, func(t *testing.T) {
+			instance := buildLfsCommands(commonDeps{runner: s.runner})
+
+			assert.Equal(t, s.expectedPointer, instance.Pointer("HEAD", "image.psd"))
+			s.runner.CheckForMissingCalls()
+		})
+	}
+}
+
+func TestLfsUnlock(t *testing.T) {
+	type scenario struct {
+		testName string
+		force    bool
+		runner   *oscommands.FakeCmdObjRunner
+	}
+
+	scenarios := []scenario{
+		{
+			testName: "unlock own lock",
+			force:    false,
+			runner: oscommands.NewFakeRunner(t).
+				ExpectGitArgs([]string{"lfs", "unlock", "image.psd"}, "", nil),
+		},
+		{
+			testName: "unlock somebody else's lock",
+			force:    true,
+			runner: oscommands.NewFakeRunner(t).
+				ExpectGitArgs([]string{"lfs", "unlock", "--force", "image.psd"}, "", nil),
+		},
+	}
+
+	for _, s := range scenarios {
+		t.Run(s.testName, func(t *testing.T) {
+			instance := buildLfsCommands(commonDeps{runner: s.runner})
+
+			assert.NoError(t, instance.Unlock(gocui.NewFakeTask(), "image.psd", s.force))
+			s.runner.CheckForMissingCalls()
+		})
+	}
+}
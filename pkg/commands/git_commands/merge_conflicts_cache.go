@@ -0,0 +1,51 @@
+package git_commands
+
+import (
+	"sync"
+)
+
+// Running git merge-tree for every branch on every refresh would be expensive,
+// but its result only depends on the two commits being merged, so we can cache
+// it by their hashes. This way we only run it again when one of the branches
+// has moved, e.g. after a fetch or a new commit.
+type mergeConflictsCache struct {
+	mutex     sync.Mutex
+	conflicts map[string][]string
+}
+
+// Every time a branch moves we get a new entry, so to keep the cache from
+// growing forever we start over when it gets this big
+const maxMergeConflictsCacheSize = 1000
+
+func newMergeConflictsCache() *mergeConflictsCache {
+	return &mergeConflictsCache{
+		conflicts: map[string][]string{},
+	}
+}
+
+// The set of conflicting paths is the same no matter which side is merged into
+// which, so the key doesn't depend on the order of the hashes
+func mergeConflictsCacheKey(hash1 string, hash2 string) string {
+	if hash1 > hash2 {
+		hash1, hash2 = hash2, hash1
+	}
+	return hash1 + " " + hash2
+}
+
+func (self *mergeConflictsCache) get(hash1 string, hash2 string) ([]string, bool) {
+	self.mutex.Lock()
+	defer self.mutex.Unlock()
+
+	conflicts, ok := self.conflicts[mergeConflictsCacheKey(hash1, hash2)]
+	return conflicts, ok
+}
+
+func (self *mergeConflictsCache) set(hash1 string, hash2 string, conflicts []string) {
+	self.mutex.Lock()
+	defer self.mutex.Unlock()
+
+	if len(self.conflicts) >= maxMergeConflictsCacheSize {
+		self.conflicts = map[string][]string{}
+	}
+	self.conflicts[mergeConflictsCacheKey(hash1, hash2)] = conflicts
+}
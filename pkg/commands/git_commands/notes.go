@@ -0,0 +1,97 @@
+package git_commands
+
+import (
+	"strings"
+
+	"github.com/jesseduffield/generics/set"
+)
+
+type NotesCommands struct {
+	*GitCommon
+}
+
+func NewNotesCommands(gitCommon *GitCommon) *NotesCommands {
+	return &NotesCommands{
+		GitCommon: gitCommon,
+	}
+}
+
+// Returns the note attached to the given commit
+func (self *NotesCommands) Show(hash string) (string, error) {
+	cmdArgs := self.notesCmd().
+		Arg("show", hash).
+		ToArgv()
+
+	output, err := self.cmd.New(cmdArgs).DontLog().RunWithOutput()
+	return strings.TrimRight(output, "\n"), err
+}
+
+// Attaches a note to a commit which doesn't have one yet
+func (self *NotesCommands) Add(hash string, summary string, description string) error {
+	cmdArgs := self.notesCmd().
+		Arg("add").
+		Arg(self.messageArgs(summary, description)...).
+		Arg(hash).
+		ToArgv()
+
+	return self.cmd.New(cmdArgs).Run()
+}
+
+// Replaces the note that is attached to a commit
+func (self *NotesCommands) Edit(hash string, summary string, description string) error {
+	cmdArgs := self.notesCmd().
+		Arg("add", "--force").
+		Arg(self.messageArgs(summary, description)...).
+		Arg(hash).
+		ToArgv()
+
+	return self.cmd.New(cmdArgs).Run()
+}
+
+func (self *NotesCommands) Remove(hash string) error {
+	cmdArgs := self.notesCmd().
+		Arg("remove", hash).
+		ToArgv()
+
+	return self.cmd.New(cmdArgs).Run()
+}
+
+// Returns the hashes of all commits that have a note attached
+func (self *NotesCommands) NotedCommitHashes() (*set.Set[string], error) {
+	cmdArgs := self.notesCmd().
+		Arg("list").
+		ToArgv()
+
+	output, err := self.cmd.New(cmdArgs).DontLog().RunWithOutput()
+	if err != nil {
+		return nil, err
+	}
+
+	// Each line has the form "<note object hash> <commit hash>"
+	hashes := set.New[string]()
+	for _, line := range strings.Split(strings.TrimSpace(output), "\n") {
+		_, commitHash, found := strings.Cut(line, " ")
+		if found {
+			hashes.Add(commitHash)
+		}
+	}
+
+	return hashes, nil
+}
+
+func (self *NotesCommands) notesCmd() *GitCommandBuilder {
+	notesRef := self.UserConfig().Git.NotesRef
+
+	return NewGitCmd("notes").
+		ArgIf(notesRef != "", "--ref="+notesRef)
+}
+
+func (self *NotesCommands) messageArgs(summary string, description string) []string {
+	args := []string{"-m", summary}
+
+	if description != "" {
+		args = append(args, "-m", description)
+	}
+
+	return args
+}
@@ -0,0 +1,87 @@
+package git_commands
+
+import (
+	"testing"
+
+	"github.com/jesseduffield/lazygit/pkg/commands/oscommands"
+	"github.com/jesseduffield/lazygit/pkg/config"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNotesNotedCommitHashes(t *testing.T) {
+	type scenario struct {
+		testName       string
+		notesRef       string
+		runner         *oscommands.FakeCmdObjRunner
+		expectedHashes []string
+	}
+
+	scenarios := []scenario{
+		{
+			testName: "no notes",
+			runner: oscommands.NewFakeRunner(t).
+				ExpectGitArgs([]string{"notes", "list"}, "", nil),
+			expectedHashes: []string{},
+		},
+		{
+			testName: "some notes in a custom ref",
+			notesRef: "refs/notes/review",
+			runner: oscommands.NewFakeRunner(t).
+				ExpectGitArgs([]string{"notes", "--ref=refs/notes/review", "list"},
+					"e69de29bb2d1d6434b8b29ae775ad8c2e48c5391 a1b2c3d4\n5716ca5987cbf97d6bb54920bea6adde242d87e6 e5f6a7b8\n", nil),
+			expectedHashes: []string{"a1b2c3d4", "e5f6a7b8"},
+		},
+	}
+
+	for _, s := range scenarios {
+		t.Run(s.testName, func(t *testing.T) {
+			userConfig := config.GetDefaultConfig()
+			userConfig.Git.NotesRef = s.notesRef
+			instance := buildNotesCommands(commonDeps{runner: s.runner, userConfig: userConfig})
+
+			hashes, err := instance.NotedCommitHashes()
+			assert.NoError(t, err)
+			assert.ElementsMatch(t, s.expectedHashes, hashes.ToSlice())
+			s.runner.CheckForMissingCalls()
+		})
+	}
+}
+
+func TestNotesAddAndEdit(t *testing.T) {
+	type scenario struct {
+		testName    string
+		edit        bool
+		summary     string
+		description string
+		expected    []string
+	}
+
+	scenarios := []scenario{
+		{
+			testName: "add a single-line note",
+			summary:  "Reviewed-by: Alice",
+			expected: []string{"notes", "add", "-m", "Reviewed-by: Alice", "abc123"},
+		},
+		{
+			testName:    "replace a note with one that has a description",
+			edit:        true,
+			summary:     "Reviewed-by: Alice",
+			description: "CI: https://ci.example.com/42",
+			expected:    []string{"notes", "add", "--force", "-m", "Reviewed-by: Alice", "-m", "CI: https://ci.example.com/42", "abc123"},
+		},
+	}
+
+	for _, s := range scenarios {
+		t.Run(s.testName, func(t *testing.T) {
+			runner := oscommands.NewFakeRunner(t).ExpectGitArgs(s.expected, "", nil)
+			instance := buildNotesCommands(commonDeps{runner: runner})
+
+			if s.edit {
+				assert.NoError(t, instance.Edit("abc123", s.summary, s.description))
+			} else {
+				assert.NoError(t, instance.Add("abc123", s.summary, s.description))
+			}
+			runner.CheckForMissingCalls()
+		})
+	}
+}
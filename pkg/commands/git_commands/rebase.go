@@ -566,6 +566,14 @@ func (self *RebaseCommands) CherryPickCommits(commits []*models.Commit) error {
 	return self.cmd.New(cmdArgs).Run()
 }
 
+// Applies the patches of a mailbox or patch file as new commits. Falls back to
+// a three-way merge so that conflicts can be resolved like those of a rebase.
+func (self *RebaseCommands) ApplyMailbox(path string) error {
+	cmdArgs := NewGitCmd("am").Arg("--3way", path).ToArgv()
+
+	return self.cmd.New(cmdArgs).Run()
+}
+
 func (self *RebaseCommands) DropMergeCommit(commits []*models.Commit, commitIndex int) error {
 	return self.PrepareInteractiveRebaseCommand(PrepareInteractiveRebaseCommandOpts{
 		baseHashOrRoot: getBaseHashOrRoot(commits, commitIndex+1),
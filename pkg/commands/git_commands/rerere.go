@@ -0,0 +1,82 @@
+package git_commands
+
+import (
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	"github.com/jesseduffield/generics/set"
+	"github.com/jesseduffield/lazygit/pkg/commands/oscommands"
+)
+
+type RerereCommands struct {
+	*GitCommon
+}
+
+func NewRerereCommands(gitCommon *GitCommon) *RerereCommands {
+	return &RerereCommands{
+		GitCommon: gitCommon,
+	}
+}
+
+// Matches the lines git prints when rerere applies a recorded resolution. The
+// "Staged" variant is used when rerere.autoUpdate is enabled.
+var reusedResolutionRegex = regexp.MustCompile(`(?m)^(?:Resolved|Staged) '(.+)' using previous resolution\.$`)
+
+func (self *RerereCommands) IsEnabled() bool {
+	return isRerereEnabled(self.GitCommon)
+}
+
+// Forgets the recorded resolution of the given file and recreates its conflict
+// markers, so that the conflict can be resolved again
+func (self *RerereCommands) Forget(path string) error {
+	forgetCmdArgs := NewGitCmd("rerere").Arg("forget", "--", path).ToArgv()
+	if err := self.cmd.New(forgetCmdArgs).Run(); err != nil {
+		return err
+	}
+
+	checkoutCmdArgs := NewGitCmd("checkout").Arg("--merge", "--", path).ToArgv()
+	return self.cmd.New(checkoutCmdArgs).Run()
+}
+
+// Returns the paths that rerere resolved using a recorded resolution, according
+// to the output of a merge, rebase, cherry-pick etc.
+func ReusedRerereResolutions(output string) []string {
+	matches := reusedResolutionRegex.FindAllStringSubmatch(output, -1)
+
+	paths := make([]string, 0, len(matches))
+	for _, match := range matches {
+		paths = append(paths, match[1])
+	}
+
+	return paths
+}
+
+func isRerereEnabled(gitCommon *GitCommon) bool {
+	if enabled, isSet := gitCommon.config.GetRerereEnabled(); isSet {
+		return enabled
+	}
+
+	// If the config isn't set, git enables rerere if the rr-cache directory exists
+	_, err := gitCommon.Fs.Stat(filepath.Join(gitCommon.repoPaths.RepoGitDirPath(), "rr-cache"))
+	return err == nil
+}
+
+// Returns the conflicted paths that rerere hasn't resolved
+func rerereRemainingPaths(cmd oscommands.ICmdObjBuilder) (*set.Set[string], error) {
+	cmdArgs := NewGitCmd("rerere").Arg("remaining").ToArgv()
+
+	output, err := cmd.New(cmdArgs).DontLog().RunWithOutput()
+	if err != nil {
+		return nil, err
+	}
+
+	result := set.New[string]()
+	for _, line := range strings.Split(output, "\n") {
+		if line != "" {
+			result.Add(line)
+		}
+	}
+
+	return result, nil
+}
@@ -0,0 +1,84 @@
+package git_commands
+
+import (
+	"testing"
+
+	"github.com/jesseduffield/lazygit/pkg/commands/git_config"
+	"github.com/jesseduffield/lazygit/pkg/commands/oscommands"
+	"github.com/spf13/afero"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRerereIsEnabled(t *testing.T) {
+	type scenario struct {
+		testName        string
+		gitConfig       map[string]string
+		rrCacheExists   bool
+		expectedEnabled bool
+	}
+
+	scenarios := []scenario{
+		{
+			testName:        "enabled in config",
+			gitConfig:       map[string]string{"rerere.enabled": "true"},
+			expectedEnabled: true,
+		},
+		{
+			testName:        "disabled in config even though rr-cache exists",
+			gitConfig:       map[string]string{"rerere.enabled": "false"},
+			rrCacheExists:   true,
+			expectedEnabled: false,
+		},
+		{
+			testName:        "not configured, rr-cache exists",
+			rrCacheExists:   true,
+			expectedEnabled: true,
+		},
+		{
+			testName:        "not configured, no rr-cache",
+			expectedEnabled: false,
+		},
+	}
+
+	for _, s := range scenarios {
+		t.Run(s.testName, func(t *testing.T) {
+			fs := afero.NewMemMapFs()
+			if s.rrCacheExists {
+				assert.NoError(t, fs.MkdirAll(".git/.git/rr-cache", 0o755))
+			}
+
+			instance := buildRerereCommands(commonDeps{
+				gitConfig: git_config.NewFakeGitConfig(s.gitConfig),
+				fs:        fs,
+			})
+
+			assert.Equal(t, s.expectedEnabled, instance.IsEnabled())
+		})
+	}
+}
+
+func TestRerereForget(t *testing.T) {
+	runner := oscommands.NewFakeRunner(t).
+		ExpectGitArgs([]string{"rerere", "forget", "--", "file.txt"}, "", nil).
+		ExpectGitArgs([]string{"checkout", "--merge", "--", "file.txt"}, "", nil)
+	instance := buildRerereCommands(commonDeps{runner: runner})
+
+	assert.NoError(t, instance.Forget("file.txt"))
+	runner.CheckForMissingCalls()
+}
+
+func TestReusedRerereResolutions(t *testing.T) {
+	output := `Auto-merging a.txt
+CONFLICT (content): Merge conflict in a.txt
+Auto-merging dir/b c.txt
+CONFLICT (content): Merge conflict in dir/b c.txt
+Auto-merging d.txt
+CONFLICT (content): Merge conflict in d.txt
+Resolved 'a.txt' using previous resolution.
+Staged 'dir/b c.txt' using previous resolution.
+Recorded preimage for 'd.txt'
+error: could not apply 1234567... commit message`
+
+	assert.Equal(t, []string{"a.txt", "dir/b c.txt"}, ReusedRerereResolutions(output))
+	assert.Equal(t, []string{}, ReusedRerereResolutions("CONFLICT (content): Merge conflict in d.txt"))
+}
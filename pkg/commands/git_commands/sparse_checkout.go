@@ -0,0 +1,77 @@
+package git_commands
+
+import (
+	"strings"
+
+	"github.com/samber/lo"
+)
+
+type SparseCheckoutCommands struct {
+	*GitCommon
+}
+
+func NewSparseCheckoutCommands(gitCommon *GitCommon) *SparseCheckoutCommands {
+	return &SparseCheckoutCommands{
+		GitCommon: gitCommon,
+	}
+}
+
+// Returns true if the working tree is a sparse checkout in cone mode. Sparse
+// checkouts that use the older non-cone patterns are not supported.
+func (self *SparseCheckoutCommands) IsConeModeEnabled() bool {
+	return self.config.GetSparseCheckout() && self.config.GetSparseCheckoutCone()
+}
+
+// Returns the directories that are included in the sparse checkout. Files in
+// the root directory are always included, so they are not listed.
+func (self *SparseCheckoutCommands) List() ([]string, error) {
+	cmdArgs := NewGitCmd("sparse-checkout").Arg("list").ToArgv()
+
+	output, err := self.cmd.New(cmdArgs).DontLog().RunWithOutput()
+	if err != nil {
+		return nil, err
+	}
+
+	return lo.Filter(strings.Split(strings.TrimSpace(output), "\n"), func(dir string, _ int) bool {
+		return dir != ""
+	}), nil
+}
+
+// Adds directories to an existing sparse checkout
+func (self *SparseCheckoutCommands) Add(dirs []string) error {
+	cmdArgs := NewGitCmd("sparse-checkout").Arg("add").Arg(dirs...).ToArgv()
+
+	return self.run(cmdArgs)
+}
+
+// Replaces the directories of the sparse checkout, enabling cone mode sparse
+// checkout if it isn't enabled yet
+func (self *SparseCheckoutCommands) Set(dirs []string) error {
+	cmdArgs := NewGitCmd("sparse-checkout").Arg("set", "--cone").Arg(dirs...).ToArgv()
+
+	return self.run(cmdArgs)
+}
+
+// Turns the sparse checkout back into a full checkout
+func (self *SparseCheckoutCommands) Disable() error {
+	cmdArgs := NewGitCmd("sparse-checkout").Arg("disable").ToArgv()
+
+	return self.run(cmdArgs)
+}
+
+// Updates the working tree to match the sparse checkout patterns again, e.g.
+// after a merge or rebase checked out files outside of the sparse cone
+func (self *SparseCheckoutCommands) Reapply() error {
+	cmdArgs := NewGitCmd("sparse-checkout").Arg("reapply").ToArgv()
+
+	return self.run(cmdArgs)
+}
+
+func (self *SparseCheckoutCommands) run(cmdArgs []string) error {
+	err := self.cmd.New(cmdArgs).Run()
+
+	// These commands change core.sparseCheckout and friends
+	self.config.DropConfigCache()
+
+	return err
+}
@@ -0,0 +1,114 @@
+package git_commands
+
+import (
+	"testing"
+
+	"github.com/jesseduffield/lazygit/pkg/commands/git_config"
+	"github.com/jesseduffield/lazygit/pkg/commands/oscommands"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSparseCheckoutIsConeModeEnabled(t *testing.T) {
+	type scenario struct {
+		testName       string
+		gitConfig      map[string]string
+		expectedResult bool
+	}
+
+	scenarios := []scenario{
+		{
+			testName:       "not a sparse checkout",
+			gitConfig:      map[string]string{},
+			expectedResult: false,
+		},
+		{
+			testName:       "sparse checkout with non-cone patterns",
+			gitConfig:      map[string]string{"core.sparseCheckout": "true"},
+			expectedResult: false,
+		},
+		{
+			testName:       "sparse checkout in cone mode",
+			gitConfig:      map[string]string{"core.sparseCheckout": "true", "core.sparseCheckoutCone": "true"},
+			expectedResult: true,
+		},
+	}
+
+	for _, s := range scenarios {
+		t.Run(s.testName, func(t *testing.T) {
+			instance := buildSparseCheckoutCommands(commonDeps{gitConfig: git_config.NewFakeGitConfig(s.gitConfig)})
+
+			assert.Equal(t, s.expectedResult, instance.IsConeModeEnabled())
+		})
+	}
+}
+
+func TestSparseCheckoutList(t *testing.T) {
+	runner := oscommands.NewFakeRunner(t).
+		ExpectGitArgs([]string{"sparse-checkout", "list"}, "services/api\nlibs/shared utils\n", nil)
+	instance := buildSparseCheckoutCommands(commonDeps{runner: runner})
+
+	dirs, err := instance.List()
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"services/api", "libs/shared utils"}, dirs)
+	runner.CheckForMissingCalls()
+}
+
+func TestSparseCheckoutCommands(t *testing.T) {
+	type scenario struct {
+		testName string
+		runner   *oscommands.FakeCmdObjRunner
+		test     func(*SparseCheckoutCommands) error
+	}
+
+	scenarios := []scenario{
+		{
+			testName: "add",
+			runner: oscommands.NewFakeRunner(t).
+				ExpectGitArgs([]string{"sparse-checkout", "add", "services/api", "libs"}, "", nil),
+			test: func(instance *SparseCheckoutCommands) error {
+				return instance.Add([]string{"services/api", "libs"})
+			},
+		},
+		{
+			testName: "set",
+			runner: oscommands.NewFakeRunner(t).
+				ExpectGitArgs([]string{"sparse-checkout", "set", "--cone", "libs"}, "", nil),
+			test: func(instance *SparseCheckoutCommands) error {
+				return instance.Set([]string{"libs"})
+			},
+		},
+		{
+			testName: "set to root files only",
+			runner: oscommands.NewFakeRunner(t).
+				ExpectGitArgs([]string{"sparse-checkout", "set", "--cone"}, "", nil),
+			test: func(instance *SparseCheckoutCommands) error {
+				return instance.Set(nil)
+			},
+		},
+		{
+			testName: "disable",
+			runner: oscommands.NewFakeRunner(t).
+				ExpectGitArgs([]string{"sparse-checkout", "disable"}, "", nil),
+			test: func(instance *SparseCheckoutCommands) error {
+				return instance.Disable()
+			},
+		},
+		{
+			testName: "reapply",
+			runner: oscommands.NewFakeRunner(t).
+				ExpectGitArgs([]string{"sparse-checkout", "reapply"}, "", nil),
+			test: func(instance *SparseCheckoutCommands) error {
+				return instance.Reapply()
+			},
+		},
+	}
+
+	for _, s := range scenarios {
+		t.Run(s.testName, func(t *testing.T) {
+			instance := buildSparseCheckoutCommands(commonDeps{runner: s.runner})
+
+			assert.NoError(t, s.test(instance))
+			s.runner.CheckForMissingCalls()
+		})
+	}
+}
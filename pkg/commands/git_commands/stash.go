@@ -60,6 +60,20 @@ func (self *StashCommands) Push(message string) error {
 	return self.cmd.New(cmdArgs).Run()
 }
 
+// Stashes only the changes to the given paths. Untracked files are only
+// included if includeUntracked is true; git refuses pathspecs that match
+// nothing it knows about otherwise.
+func (self *StashCommands) PushPaths(message string, paths []string, includeUntracked bool) error {
+	cmdArgs := NewGitCmd("stash").Arg("push").
+		ArgIf(includeUntracked, "--include-untracked").
+		Arg("-m", message).
+		Arg("--").
+		Arg(paths...).
+		ToArgv()
+
+	return self.cmd.New(cmdArgs).Run()
+}
+
 func (self *StashCommands) Store(hash string, message string) error {
 	trimmedMessage := strings.Trim(message, " \t")
 
@@ -94,7 +108,11 @@ func (self *StashCommands) ShowStashEntryCmdObj(index int) *oscommands.CmdObj {
 		Arg(fmt.Sprintf("--color=%s", self.pagerConfig.GetColorArg())).
 		Arg(fmt.Sprintf("--unified=%d", self.UserConfig().Git.DiffContextSize)).
 		ArgIf(self.UserConfig().Git.IgnoreWhitespaceInDiffView, "--ignore-all-space").
+		ArgIf(self.UserConfig().Git.WordDiffInDiffView, "--word-diff=color").
+		ArgIf(self.UserConfig().Git.ColorMovedInDiffView, "--color-moved").
+		ArgIf(self.UserConfig().Git.DiffAlgorithm != "default", "--diff-algorithm="+self.UserConfig().Git.DiffAlgorithm).
 		Arg(fmt.Sprintf("--find-renames=%d%%", self.UserConfig().Git.RenameSimilarityThreshold)).
+		ArgIf(self.UserConfig().Git.FindCopiesInDiffView, fmt.Sprintf("--find-copies=%d%%", self.UserConfig().Git.RenameSimilarityThreshold)).
 		Arg(fmt.Sprintf("refs/stash@{%d}", index)).
 		Dir(self.repoPaths.worktreePath).
 		ToArgv()
@@ -199,6 +217,18 @@ func (self *StashCommands) StashIncludeUntrackedChanges(message string) error {
 	).Run()
 }
 
+// Restores the given file in the working tree to its state in the stash entry,
+// without applying the rest of the entry and without touching the index
+func (self *StashCommands) RestoreFile(index int, path string) error {
+	cmdArgs := NewGitCmd("restore").
+		Arg("--source", fmt.Sprintf("refs/stash@{%d}", index)).
+		Arg("--worktree").
+		Arg("--", path).
+		ToArgv()
+
+	return self.cmd.New(cmdArgs).Run()
+}
+
 func (self *StashCommands) Rename(index int, message string) error {
 	hash, err := self.Hash(index)
 	if err != nil {
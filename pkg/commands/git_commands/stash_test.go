@@ -44,6 +44,50 @@ func TestStashSave(t *testing.T) {
 	runner.CheckForMissingCalls()
 }
 
+func TestStashPushPaths(t *testing.T) {
+	type scenario struct {
+		testName         string
+		paths            []string
+		includeUntracked bool
+		expected         []string
+	}
+
+	scenarios := []scenario{
+		{
+			testName:         "Tracked paths",
+			paths:            []string{"file1", "dir/file2"},
+			includeUntracked: false,
+			expected:         []string{"stash", "push", "-m", "A stash message", "--", "file1", "dir/file2"},
+		},
+		{
+			testName:         "Including untracked files",
+			paths:            []string{"dir"},
+			includeUntracked: true,
+			expected:         []string{"stash", "push", "--include-untracked", "-m", "A stash message", "--", "dir"},
+		},
+	}
+
+	for _, s := range scenarios {
+		t.Run(s.testName, func(t *testing.T) {
+			runner := oscommands.NewFakeRunner(t).
+				ExpectGitArgs(s.expected, "", nil)
+			instance := buildStashCommands(commonDeps{runner: runner})
+
+			assert.NoError(t, instance.PushPaths("A stash message", s.paths, s.includeUntracked))
+			runner.CheckForMissingCalls()
+		})
+	}
+}
+
+func TestStashRestoreFile(t *testing.T) {
+	runner := oscommands.NewFakeRunner(t).
+		ExpectGitArgs([]string{"restore", "--source", "refs/stash@{1}", "--worktree", "--", "dir/file"}, "", nil)
+	instance := buildStashCommands(commonDeps{runner: runner})
+
+	assert.NoError(t, instance.RestoreFile(1, "dir/file"))
+	runner.CheckForMissingCalls()
+}
+
 func TestStashStore(t *testing.T) {
 	type scenario struct {
 		testName string
@@ -103,6 +147,8 @@ func TestStashStashEntryCmdObj(t *testing.T) {
 		contextSize         uint64
 		similarityThreshold int
 		ignoreWhitespace    bool
+		diffAlgorithm       string
+		findCopies          bool
 		pagerConfig         *config.PagingConfig
 		expected            []string
 	}
@@ -132,6 +178,15 @@ func TestStashStashEntryCmdObj(t *testing.T) {
 			ignoreWhitespace:    false,
 			expected:            []string{"git", "-C", "/path/to/worktree", "stash", "show", "-p", "--stat", "-u", "--no-ext-diff", "--color=always", "--unified=3", "--find-renames=33%", "refs/stash@{5}"},
 		},
+		{
+			testName:            "Show diff with histogram algorithm and copy detection",
+			index:               5,
+			contextSize:         3,
+			similarityThreshold: 50,
+			diffAlgorithm:       "histogram",
+			findCopies:          true,
+			expected:            []string{"git", "-C", "/path/to/worktree", "stash", "show", "-p", "--stat", "-u", "--no-ext-diff", "--color=always", "--unified=3", "--diff-algorithm=histogram", "--find-renames=50%", "--find-copies=50%", "refs/stash@{5}"},
+		},
 		{
 			testName:            "Show diff with external diff command",
 			index:               5,
@@ -166,6 +221,10 @@ func TestStashStashEntryCmdObj(t *testing.T) {
 			userConfig.Git.IgnoreWhitespaceInDiffView = s.ignoreWhitespace
 			userConfig.Git.DiffContextSize = s.contextSize
 			userConfig.Git.RenameSimilarityThreshold = s.similarityThreshold
+			if s.diffAlgorithm != "" {
+				userConfig.Git.DiffAlgorithm = s.diffAlgorithm
+			}
+			userConfig.Git.FindCopiesInDiffView = s.findCopies
 			if s.pagerConfig != nil {
 				userConfig.Git.Pagers = []config.PagingConfig{*s.pagerConfig}
 			}
@@ -41,7 +41,13 @@ func (self *SyncCommands) PushCmdObj(task gocui.Task, opts PushOpts) (*oscommand
 		ArgIf(opts.UpstreamBranch != "", fmt.Sprintf("refs/heads/%s:%s", opts.CurrentBranch, opts.UpstreamBranch)).
 		ToArgv()
 
-	cmdObj := self.cmd.New(cmdArgs).PromptOnCredentialRequest(task)
+	// The LFS pre-push hook only reports its upload progress if stderr is a
+	// terminal, which it isn't for us. Without this, the command log would show
+	// nothing while potentially large files are being uploaded. The variable
+	// has no effect in repos that don't use LFS.
+	cmdObj := self.cmd.New(cmdArgs).PromptOnCredentialRequest(task).
+		AddEnvVars("GIT_LFS_FORCE_PROGRESS=1")
+
 	return cmdObj, nil
 }
 
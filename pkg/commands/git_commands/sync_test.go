@@ -105,6 +105,15 @@ func TestSyncPush(t *testing.T) {
 	}
 }
 
+func TestSyncPushForcesLfsProgress(t *testing.T) {
+	instance := buildSyncCommands(commonDeps{})
+	cmdObj, err := instance.PushCmdObj(gocui.NewFakeTask(), PushOpts{})
+
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"git", "push"}, cmdObj.Args())
+	assert.Contains(t, cmdObj.GetEnvVars(), "GIT_LFS_FORCE_PROGRESS=1")
+}
+
 func TestSyncFetch(t *testing.T) {
 	type scenario struct {
 		testName       string
@@ -258,6 +258,17 @@ func (self *WorkingTreeCommands) WorktreeFileDiff(file *models.File, plain bool,
 }
 
 func (self *WorkingTreeCommands) WorktreeFileDiffCmdObj(node models.IFile, plain bool, cached bool) *oscommands.CmdObj {
+	return self.worktreeFileDiffCmdObj(node, plain, cached, !plain && self.UserConfig().Git.IgnoreWhitespaceInDiffView)
+}
+
+// Returns a plain diff of the file for the side-by-side view. Unlike the plain
+// diffs used for staging, it is only displayed and never applied, so it can
+// honor the ignore-whitespace setting.
+func (self *WorkingTreeCommands) SideBySideWorktreeFileDiffCmdObj(node models.IFile, cached bool) *oscommands.CmdObj {
+	return self.worktreeFileDiffCmdObj(node, true, cached, self.UserConfig().Git.IgnoreWhitespaceInDiffView)
+}
+
+func (self *WorkingTreeCommands) worktreeFileDiffCmdObj(node models.IFile, plain bool, cached bool, ignoreWhitespace bool) *oscommands.CmdObj {
 	colorArg := self.pagerConfig.GetColorArg()
 	if plain {
 		colorArg = "never"
@@ -276,8 +287,12 @@ func (self *WorkingTreeCommands) WorktreeFileDiffCmdObj(node models.IFile, plain
 		Arg("--submodule").
 		Arg(fmt.Sprintf("--unified=%d", contextSize)).
 		Arg(fmt.Sprintf("--color=%s", colorArg)).
-		ArgIf(!plain && self.UserConfig().Git.IgnoreWhitespaceInDiffView, "--ignore-all-space").
+		ArgIf(ignoreWhitespace, "--ignore-all-space").
+		ArgIf(!plain && self.UserConfig().Git.WordDiffInDiffView, "--word-diff=color").
+		ArgIf(!plain && self.UserConfig().Git.ColorMovedInDiffView, "--color-moved").
+		ArgIf(self.UserConfig().Git.DiffAlgorithm != "default", "--diff-algorithm="+self.UserConfig().Git.DiffAlgorithm).
 		Arg(fmt.Sprintf("--find-renames=%d%%", self.UserConfig().Git.RenameSimilarityThreshold)).
+		ArgIf(self.UserConfig().Git.FindCopiesInDiffView, fmt.Sprintf("--find-copies=%d%%", self.UserConfig().Git.RenameSimilarityThreshold)).
 		ArgIf(cached, "--cached").
 		ArgIf(noIndex, "--no-index").
 		Arg("--").
@@ -297,6 +312,15 @@ func (self *WorkingTreeCommands) ShowFileDiff(from string, to string, reverse bo
 }
 
 func (self *WorkingTreeCommands) ShowFileDiffCmdObj(from string, to string, reverse bool, fileName string, plain bool) *oscommands.CmdObj {
+	return self.showFileDiffCmdObj(from, to, reverse, fileName, plain, !plain && self.UserConfig().Git.IgnoreWhitespaceInDiffView)
+}
+
+// Like SideBySideWorktreeFileDiffCmdObj, but for ShowFileDiffCmdObj
+func (self *WorkingTreeCommands) SideBySideShowFileDiffCmdObj(from string, to string, reverse bool, fileName string) *oscommands.CmdObj {
+	return self.showFileDiffCmdObj(from, to, reverse, fileName, true, self.UserConfig().Git.IgnoreWhitespaceInDiffView)
+}
+
+func (self *WorkingTreeCommands) showFileDiffCmdObj(from string, to string, reverse bool, fileName string, plain bool, ignoreWhitespace bool) *oscommands.CmdObj {
 	contextSize := self.UserConfig().Git.DiffContextSize
 
 	colorArg := self.pagerConfig.GetColorArg()
@@ -319,7 +343,10 @@ func (self *WorkingTreeCommands) ShowFileDiffCmdObj(from string, to string, reve
 		Arg(from).
 		Arg(to).
 		ArgIf(reverse, "-R").
-		ArgIf(!plain && self.UserConfig().Git.IgnoreWhitespaceInDiffView, "--ignore-all-space").
+		ArgIf(ignoreWhitespace, "--ignore-all-space").
+		ArgIf(!plain && self.UserConfig().Git.WordDiffInDiffView, "--word-diff=color").
+		ArgIf(!plain && self.UserConfig().Git.ColorMovedInDiffView, "--color-moved").
+		ArgIf(self.UserConfig().Git.DiffAlgorithm != "default", "--diff-algorithm="+self.UserConfig().Git.DiffAlgorithm).
 		Arg("--").
 		Arg(fileName).
 		Dir(self.repoPaths.worktreePath).
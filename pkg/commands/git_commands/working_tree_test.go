@@ -207,10 +207,13 @@ func TestWorkingTreeDiff(t *testing.T) {
 		testName            string
 		file                *models.File
 		plain               bool
+		sideBySide          bool
 		cached              bool
 		ignoreWhitespace    bool
 		contextSize         uint64
 		similarityThreshold int
+		diffAlgorithm       string
+		findCopies          bool
 		runner              *oscommands.FakeCmdObjRunner
 	}
 
@@ -292,6 +295,36 @@ func TestWorkingTreeDiff(t *testing.T) {
 			runner: oscommands.NewFakeRunner(t).
 				ExpectGitArgs([]string{"-C", "/path/to/worktree", "diff", "--no-ext-diff", "--submodule", "--unified=3", "--color=always", "--ignore-all-space", "--find-renames=50%", "--", "test.txt"}, expectedResult, nil),
 		},
+		{
+			testName: "plain (ignore whitespace)",
+			file: &models.File{
+				Path:             "test.txt",
+				HasStagedChanges: false,
+				Tracked:          true,
+			},
+			plain:               true,
+			cached:              false,
+			ignoreWhitespace:    true,
+			contextSize:         3,
+			similarityThreshold: 50,
+			runner: oscommands.NewFakeRunner(t).
+				ExpectGitArgs([]string{"-C", "/path/to/worktree", "diff", "--no-ext-diff", "--submodule", "--unified=3", "--color=never", "--find-renames=50%", "--", "test.txt"}, expectedResult, nil),
+		},
+		{
+			testName: "side-by-side (ignore whitespace)",
+			file: &models.File{
+				Path:             "test.txt",
+				HasStagedChanges: false,
+				Tracked:          true,
+			},
+			sideBySide:          true,
+			cached:              false,
+			ignoreWhitespace:    true,
+			contextSize:         3,
+			similarityThreshold: 50,
+			runner: oscommands.NewFakeRunner(t).
+				ExpectGitArgs([]string{"-C", "/path/to/worktree", "diff", "--no-ext-diff", "--submodule", "--unified=3", "--color=never", "--ignore-all-space", "--find-renames=50%", "--", "test.txt"}, expectedResult, nil),
+		},
 		{
 			testName: "Show diff with custom context size",
 			file: &models.File{
@@ -322,6 +355,23 @@ func TestWorkingTreeDiff(t *testing.T) {
 			runner: oscommands.NewFakeRunner(t).
 				ExpectGitArgs([]string{"-C", "/path/to/worktree", "diff", "--no-ext-diff", "--submodule", "--unified=3", "--color=always", "--find-renames=33%", "--", "test.txt"}, expectedResult, nil),
 		},
+		{
+			testName: "Show diff with minimal algorithm and copy detection",
+			file: &models.File{
+				Path:             "test.txt",
+				HasStagedChanges: false,
+				Tracked:          true,
+			},
+			plain:               false,
+			cached:              false,
+			ignoreWhitespace:    false,
+			contextSize:         3,
+			similarityThreshold: 50,
+			diffAlgorithm:       "minimal",
+			findCopies:          true,
+			runner: oscommands.NewFakeRunner(t).
+				ExpectGitArgs([]string{"-C", "/path/to/worktree", "diff", "--no-ext-diff", "--submodule", "--unified=3", "--color=always", "--diff-algorithm=minimal", "--find-renames=50%", "--find-copies=50%", "--", "test.txt"}, expectedResult, nil),
+		},
 	}
 
 	for _, s := range scenarios {
@@ -330,12 +380,21 @@ func TestWorkingTreeDiff(t *testing.T) {
 			userConfig.Git.IgnoreWhitespaceInDiffView = s.ignoreWhitespace
 			userConfig.Git.DiffContextSize = s.contextSize
 			userConfig.Git.RenameSimilarityThreshold = s.similarityThreshold
+			if s.diffAlgorithm != "" {
+				userConfig.Git.DiffAlgorithm = s.diffAlgorithm
+			}
+			userConfig.Git.FindCopiesInDiffView = s.findCopies
 			repoPaths := RepoPaths{
 				worktreePath: "/path/to/worktree",
 			}
 
 			instance := buildWorkingTreeCommands(commonDeps{runner: s.runner, userConfig: userConfig, appState: &config.AppState{}, repoPaths: &repoPaths})
-			result := instance.WorktreeFileDiff(s.file, s.plain, s.cached)
+			var result string
+			if s.sideBySide {
+				result, _ = instance.SideBySideWorktreeFileDiffCmdObj(s.file, s.cached).RunWithOutput()
+			} else {
+				result = instance.WorktreeFileDiff(s.file, s.plain, s.cached)
+			}
 			assert.Equal(t, expectedResult, result)
 			s.runner.CheckForMissingCalls()
 		})
@@ -349,6 +408,7 @@ func TestWorkingTreeShowFileDiff(t *testing.T) {
 		to               string
 		reverse          bool
 		plain            bool
+		sideBySide       bool
 		ignoreWhitespace bool
 		contextSize      uint64
 		runner           *oscommands.FakeCmdObjRunner
@@ -390,6 +450,17 @@ func TestWorkingTreeShowFileDiff(t *testing.T) {
 			runner: oscommands.NewFakeRunner(t).
 				ExpectGitArgs([]string{"-C", "/path/to/worktree", "-c", "diff.noprefix=false", "diff", "--no-ext-diff", "--submodule", "--unified=3", "--no-renames", "--color=always", "1234567890", "0987654321", "--ignore-all-space", "--", "test.txt"}, expectedResult, nil),
 		},
+		{
+			testName:         "side-by-side (ignore whitespace)",
+			from:             "1234567890",
+			to:               "0987654321",
+			reverse:          false,
+			sideBySide:       true,
+			ignoreWhitespace: true,
+			contextSize:      3,
+			runner: oscommands.NewFakeRunner(t).
+				ExpectGitArgs([]string{"-C", "/path/to/worktree", "-c", "diff.noprefix=false", "diff", "--no-ext-diff", "--submodule", "--unified=3", "--no-renames", "--color=never", "1234567890", "0987654321", "--ignore-all-space", "--", "test.txt"}, expectedResult, nil),
+		},
 	}
 
 	for _, s := range scenarios {
@@ -403,7 +474,13 @@ func TestWorkingTreeShowFileDiff(t *testing.T) {
 
 			instance := buildWorkingTreeCommands(commonDeps{runner: s.runner, userConfig: userConfig, appState: &config.AppState{}, repoPaths: &repoPaths})
 
-			result, err := instance.ShowFileDiff(s.from, s.to, s.reverse, "test.txt", s.plain)
+			var result string
+			var err error
+			if s.sideBySide {
+				result, err = instance.SideBySideShowFileDiffCmdObj(s.from, s.to, s.reverse, "test.txt").RunWithOutput()
+			} else {
+				result, err = instance.ShowFileDiff(s.from, s.to, s.reverse, "test.txt", s.plain)
+			}
 			assert.NoError(t, err)
 			assert.Equal(t, expectedResult, result)
 			s.runner.CheckForMissingCalls()
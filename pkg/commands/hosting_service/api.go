@@ -0,0 +1,259 @@
+package hosting_service
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/go-errors/errors"
+	"github.com/jesseduffield/lazygit/pkg/commands/models"
+	"github.com/jesseduffield/lazygit/pkg/config"
+	"github.com/jesseduffield/lazygit/pkg/utils"
+	"github.com/samber/lo"
+)
+
+// The REST API of a hosting service. Each supported provider has its own
+// implementation, in api_<provider>.go.
+type serviceAPI interface {
+	// The environment variable that we take the access token from if none is
+	// configured for the domain
+	tokenEnvVar() string
+	setAuthHeader(req *http.Request, token string)
+	// Returns the most recently updated pull request for each of the given
+	// branches of the remote, keyed by branch name. Branches without a pull
+	// request are omitted.
+	getPullRequests(client *apiClient, headBranches []string) (map[string]*models.PullRequest, error)
+	createPullRequest(client *apiClient, opts CreatePullRequestOpts) (*models.PullRequest, error)
+	// Returns the CI checks of the given commit, which must exist on the remote
+	getCheckStatus(client *apiClient, hash string) (*models.CheckStatus, error)
+}
+
+type CreatePullRequestOpts struct {
+	Title string
+	Body  string
+	// The branch on the remote to merge from
+	HeadBranch string
+	// The branch on the remote to merge into
+	BaseBranch string
+	Draft      bool
+}
+
+type apiClient struct {
+	baseURL  string
+	token    string
+	repoInfo map[string]string // e.g. 'owner' and 'repo', as matched by the service's regexStrings
+	api      serviceAPI
+
+	httpClient *http.Client
+}
+
+func (self *HostingServiceMgr) GetPullRequests(apiConfig config.HostingServiceAPIConfig, headBranches []string) (map[string]*models.PullRequest, error) {
+	client, err := self.getAPIClient(apiConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	return client.api.getPullRequests(client, headBranches)
+}
+
+func (self *HostingServiceMgr) CreatePullRequest(apiConfig config.HostingServiceAPIConfig, opts CreatePullRequestOpts) (*models.PullRequest, error) {
+	client, err := self.getAPIClient(apiConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	return client.api.createPullRequest(client, opts)
+}
+
+func (self *HostingServiceMgr) GetCheckStatus(apiConfig config.HostingServiceAPIConfig, hash string) (*models.CheckStatus, error) {
+	client, err := self.getAPIClient(apiConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	return client.api.getCheckStatus(client, hash)
+}
+
+// Returns true if the error means that we couldn't reach the hosting service
+// at all, as opposed to it responding with an error
+func IsNetworkError(err error) bool {
+	var urlErr *url.Error
+	return errors.As(err, &urlErr)
+}
+
+// Returns true if we support the API of the service and have a token for it.
+// Some of the API calls work without a token for public repos, but anything
+// that changes something doesn't.
+func (self *HostingServiceMgr) HasAPIToken(apiConfig config.HostingServiceAPIConfig) bool {
+	client, err := self.getAPIClient(apiConfig)
+	return err == nil && client.token != ""
+}
+
+func (self *HostingServiceMgr) getAPIClient(apiConfig config.HostingServiceAPIConfig) (*apiClient, error) {
+	service, err := self.getService()
+	if err != nil {
+		return nil, err
+	}
+
+	if service.api == nil {
+		return nil, errors.New(self.tr.UnsupportedHostingServiceAPI)
+	}
+
+	baseURL, ok := apiConfig.BaseURLs[service.webDomain]
+	if !ok {
+		baseURL = utils.ResolvePlaceholderString(service.apiBaseURLTemplate, service.repoInfo)
+	}
+
+	token, ok := apiConfig.Tokens[service.webDomain]
+	if !ok {
+		token = os.Getenv(service.api.tokenEnvVar())
+	}
+
+	return &apiClient{
+		baseURL:    strings.TrimSuffix(baseURL, "/"),
+		token:      token,
+		repoInfo:   service.repoInfo,
+		api:        service.api,
+		httpClient: &http.Client{Timeout: 10 * time.Second},
+	}, nil
+}
+
+func (self *apiClient) get(path string, result any) error {
+	return self.request(http.MethodGet, path, nil, result)
+}
+
+// Sends body (if not nil) as JSON and decodes the JSON response into result
+// (if not nil)
+func (self *apiClient) request(method string, path string, body any, result any) error {
+	return self.requestURL(method, self.baseURL+path, body, result)
+}
+
+type graphqlError struct {
+	Message string `json:"message"`
+}
+
+// Sends a GraphQL query to the given endpoint and decodes the data of the
+// response into result. GraphQL APIs report errors in the response body rather
+// than through the status code.
+func (self *apiClient) graphql(endpoint string, query string, variables map[string]any, result any) error {
+	var response struct {
+		Data   json.RawMessage `json:"data"`
+		Errors []graphqlError  `json:"errors"`
+	}
+	body := map[string]any{"query": query, "variables": variables}
+	if err := self.requestURL(http.MethodPost, endpoint, body, &response); err != nil {
+		return err
+	}
+
+	if len(response.Errors) > 0 {
+		return errors.New(strings.Join(lo.Map(response.Errors, func(e graphqlError, _ int) string {
+			return e.Message
+		}), "; "))
+	}
+	return json.Unmarshal(response.Data, result)
+}
+
+func (self *apiClient) requestURL(method string, endpoint string, body any, result any) error {
+	var bodyReader io.Reader
+	if body != nil {
+		encoded, err := json.Marshal(body)
+		if err != nil {
+			return err
+		}
+		bodyReader = bytes.NewReader(encoded)
+	}
+
+	req, err := http.NewRequest(method, endpoint, bodyReader)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Accept", "application/json")
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	if self.token != "" {
+		self.api.setAuthHeader(req, self.token)
+	}
+
+	resp, err := self.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return apiError(req, resp)
+	}
+
+	if result == nil {
+		return nil
+	}
+	return json.NewDecoder(resp.Body).Decode(result)
+}
+
+func apiError(req *http.Request, resp *http.Response) error {
+	// All the supported services put a human-readable description of what went
+	// wrong into a 'message' field; for GitLab it can be a list of messages.
+	// GitHub puts the details of validation errors (e.g. that a pull request
+	// already exists) into an 'errors' list.
+	var errorBody struct {
+		Message any `json:"message"`
+		Errors  []struct {
+			Message string `json:"message"`
+		} `json:"errors"`
+	}
+	_ = json.NewDecoder(io.LimitReader(resp.Body, 64*1024)).Decode(&errorBody)
+
+	messages := []string{}
+	switch message := errorBody.Message.(type) {
+	case string:
+		messages = append(messages, message)
+	case []any:
+		for _, m := range message {
+			messages = append(messages, fmt.Sprint(m))
+		}
+	}
+	for _, e := range errorBody.Errors {
+		if e.Message != "" {
+			messages = append(messages, e.Message)
+		}
+	}
+
+	err := fmt.Sprintf("%s %s: %s", req.Method, req.URL.Path, resp.Status)
+	if len(messages) > 0 {
+		err += ": " + strings.Join(messages, "; ")
+	}
+	return errors.New(err)
+}
+
+// Of all the reviews of a pull request (in chronological order), only the
+// latest approval or change request of each reviewer counts
+func reviewStatusFromReviews[T any](
+	reviews []T,
+	getReviewer func(T) string,
+	getStatus func(T) (models.PullRequestReviewStatus, bool),
+) models.PullRequestReviewStatus {
+	latestByReviewer := map[string]models.PullRequestReviewStatus{}
+	for _, review := range reviews {
+		if status, ok := getStatus(review); ok {
+			latestByReviewer[getReviewer(review)] = status
+		}
+	}
+
+	result := models.PullRequestReviewStatusNone
+	for _, status := range latestByReviewer {
+		if status == models.PullRequestReviewStatusChangesRequested {
+			return status
+		}
+		if status == models.PullRequestReviewStatusApproved {
+			result = status
+		}
+	}
+	return result
+}
@@ -0,0 +1,199 @@
+package hosting_service
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/jesseduffield/lazygit/pkg/commands/models"
+	"github.com/samber/lo"
+)
+
+// See https://docs.gitea.com/api/1.22/#tag/repository
+type giteaAPI struct{}
+
+var _ serviceAPI = giteaAPI{}
+
+type giteaPullRequest struct {
+	Number  int    `json:"number"`
+	State   string `json:"state"` // 'open' or 'closed'
+	Draft   bool   `json:"draft"`
+	Merged  bool   `json:"merged"`
+	HTMLURL string `json:"html_url"`
+	Head    struct {
+		Ref  string `json:"ref"`
+		Repo *struct {
+			FullName string `json:"full_name"`
+		} `json:"repo"`
+	} `json:"head"`
+}
+
+type giteaReview struct {
+	State     string `json:"state"`
+	Dismissed bool   `json:"dismissed"`
+	User      struct {
+		Login string `json:"login"`
+	} `json:"user"`
+}
+
+type giteaCombinedStatus struct {
+	Statuses []struct {
+		Status    string `json:"status"` // 'pending', 'success', 'error', 'failure' or 'warning'
+		Context   string `json:"context"`
+		TargetURL string `json:"target_url"`
+	} `json:"statuses"`
+}
+
+func (self giteaAPI) tokenEnvVar() string {
+	return "GITEA_TOKEN"
+}
+
+func (self giteaAPI) setAuthHeader(req *http.Request, token string) {
+	req.Header.Set("Authorization", "token "+token)
+}
+
+// Gitea can't filter pull requests by head branch, so we go through the most
+// recently updated ones page by page until we have found all branches. To keep
+// this from taking forever for repos with lots of pull requests (when most
+// branches have none, which is common), we give up after a number of pages;
+// branches whose pull requests are older than that are unlikely to be of
+// interest.
+const (
+	giteaPullRequestsPerPage  = 50
+	giteaMaxPullRequestsPages = 10
+)
+
+func (self giteaAPI) getPullRequests(client *apiClient, headBranches []string) (map[string]*models.PullRequest, error) {
+	repoPath := self.repoPath(client)
+	fullName := client.repoInfo["owner"] + "/" + client.repoInfo["repo"]
+	branchCount := len(lo.Uniq(headBranches))
+	result := map[string]*models.PullRequest{}
+	for page := 1; page <= giteaMaxPullRequestsPages && len(result) < branchCount; page++ {
+		var pullRequests []giteaPullRequest
+		path := fmt.Sprintf("%s/pulls?state=all&sort=recentupdate&limit=%d&page=%d", repoPath, giteaPullRequestsPerPage, page)
+		if err := client.get(path, &pullRequests); err != nil {
+			return nil, err
+		}
+
+		for _, pr := range pullRequests {
+			// Pull requests from forks can have the same branch names as ours
+			if pr.Head.Repo == nil || !strings.EqualFold(pr.Head.Repo.FullName, fullName) {
+				continue
+			}
+			if !lo.Contains(headBranches, pr.Head.Ref) {
+				continue
+			}
+			if _, ok := result[pr.Head.Ref]; ok {
+				continue
+			}
+
+			pullRequest := self.convertPullRequest(pr)
+			// Gitea can't give us the reviews of several pull requests at
+			// once, but we only need them for the open ones
+			if pullRequest.IsOpen() {
+				reviewStatus, err := self.getReviewStatus(client, pr.Number)
+				if err != nil {
+					return nil, err
+				}
+				pullRequest.ReviewStatus = reviewStatus
+			}
+			result[pr.Head.Ref] = pullRequest
+		}
+
+		if len(pullRequests) < giteaPullRequestsPerPage {
+			break
+		}
+	}
+
+	return result, nil
+}
+
+func (self giteaAPI) createPullRequest(client *apiClient, opts CreatePullRequestOpts) (*models.PullRequest, error) {
+	// Gitea treats pull requests whose title starts with "WIP:" as drafts
+	title := opts.Title
+	if opts.Draft {
+		title = "WIP: " + title
+	}
+
+	body := map[string]any{
+		"title": title,
+		"body":  opts.Body,
+		"head":  opts.HeadBranch,
+		"base":  opts.BaseBranch,
+	}
+
+	var pr giteaPullRequest
+	if err := client.request(http.MethodPost, self.repoPath(client)+"/pulls", body, &pr); err != nil {
+		return nil, err
+	}
+
+	return self.convertPullRequest(pr), nil
+}
+
+func (self giteaAPI) getCheckStatus(client *apiClient, hash string) (*models.CheckStatus, error) {
+	var combinedStatus giteaCombinedStatus
+	if err := client.get(self.repoPath(client)+"/commits/"+url.PathEscape(hash)+"/status", &combinedStatus); err != nil {
+		return nil, err
+	}
+
+	result := &models.CheckStatus{}
+	for _, status := range combinedStatus.Statuses {
+		state := models.CheckStatePending
+		switch status.Status {
+		case "success", "warning":
+			state = models.CheckStateSuccess
+		case "error", "failure":
+			state = models.CheckStateFailure
+		}
+		result.Checks = append(result.Checks, &models.Check{Name: status.Context, State: state, URL: status.TargetURL})
+	}
+
+	return result, nil
+}
+
+func (self giteaAPI) getReviewStatus(client *apiClient, number int) (models.PullRequestReviewStatus, error) {
+	var reviews []giteaReview
+	if err := client.get(fmt.Sprintf("%s/pulls/%d/reviews", self.repoPath(client), number), &reviews); err != nil {
+		return models.PullRequestReviewStatusNone, err
+	}
+
+	return reviewStatusFromReviews(reviews,
+		func(review giteaReview) string { return review.User.Login },
+		func(review giteaReview) (models.PullRequestReviewStatus, bool) {
+			if review.Dismissed {
+				return models.PullRequestReviewStatusNone, true
+			}
+			switch review.State {
+			case "APPROVED":
+				return models.PullRequestReviewStatusApproved, true
+			case "REQUEST_CHANGES":
+				return models.PullRequestReviewStatusChangesRequested, true
+			}
+			return models.PullRequestReviewStatusNone, false
+		},
+	), nil
+}
+
+func (self giteaAPI) convertPullRequest(pr giteaPullRequest) *models.PullRequest {
+	state := models.PullRequestStateOpen
+	switch {
+	case pr.Merged:
+		state = models.PullRequestStateMerged
+	case pr.State == "closed":
+		state = models.PullRequestStateClosed
+	case pr.Draft:
+		state = models.PullRequestStateDraft
+	}
+
+	return &models.PullRequest{
+		Number:     pr.Number,
+		State:      state,
+		URL:        pr.HTMLURL,
+		HeadBranch: pr.Head.Ref,
+	}
+}
+
+func (self giteaAPI) repoPath(client *apiClient) string {
+	return "/repos/" + url.PathEscape(client.repoInfo["owner"]) + "/" + url.PathEscape(client.repoInfo["repo"])
+}
@@ -0,0 +1,320 @@
+package hosting_service
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/jesseduffield/lazygit/pkg/commands/models"
+	"github.com/samber/lo"
+)
+
+// See https://docs.github.com/en/rest/pulls, and
+// https://docs.github.com/en/graphql for the GraphQL API
+type githubAPI struct{}
+
+var _ serviceAPI = githubAPI{}
+
+type githubPullRequest struct {
+	Number   int     `json:"number"`
+	State    string  `json:"state"` // 'open' or 'closed'
+	Draft    bool    `json:"draft"`
+	MergedAt *string `json:"merged_at"`
+	HTMLURL  string  `json:"html_url"`
+	Head     struct {
+		Ref  string `json:"ref"`
+		Repo *struct {
+			FullName string `json:"full_name"`
+		} `json:"repo"` // nil if the fork was deleted
+	} `json:"head"`
+}
+
+// A pull request as returned by the GraphQL API
+type githubGraphQLPullRequest struct {
+	Number         int    `json:"number"`
+	State          string `json:"state"` // 'OPEN', 'CLOSED' or 'MERGED'
+	IsDraft        bool   `json:"isDraft"`
+	URL            string `json:"url"`
+	HeadRefName    string `json:"headRefName"`
+	HeadRepository *struct {
+		NameWithOwner string `json:"nameWithOwner"`
+	} `json:"headRepository"`
+	// The latest approval or change request of each reviewer
+	LatestOpinionatedReviews struct {
+		Nodes []githubReview `json:"nodes"`
+	} `json:"latestOpinionatedReviews"`
+}
+
+type githubReview struct {
+	State  string `json:"state"`
+	Author *struct {
+		Login string `json:"login"`
+	} `json:"author"` // nil if the account was deleted
+}
+
+// Commit statuses are the older of GitHub's two ways of reporting CI results;
+// check runs (as used by GitHub Actions) are the newer one
+type githubCombinedStatus struct {
+	Statuses []struct {
+		State     string `json:"state"` // 'error', 'failure', 'pending' or 'success'
+		Context   string `json:"context"`
+		TargetURL string `json:"target_url"`
+	} `json:"statuses"`
+}
+
+type githubCheckRuns struct {
+	CheckRuns []struct {
+		Name       string `json:"name"`
+		Status     string `json:"status"` // 'completed', or one of several states of not being done yet
+		Conclusion string `json:"conclusion"`
+		HTMLURL    string `json:"html_url"`
+	} `json:"check_runs"`
+}
+
+func (self githubAPI) tokenEnvVar() string {
+	return "GITHUB_TOKEN"
+}
+
+func (self githubAPI) setAuthHeader(req *http.Request, token string) {
+	req.Header.Set("Authorization", "Bearer "+token)
+}
+
+// Number of branches whose pull requests we look up in a single query, to
+// stay well within GitHub's limits on the complexity of queries
+const githubBranchesPerQuery = 50
+
+// Without a token, GitHub only allows a few REST requests per hour, so we
+// only look at the most recently updated pull requests then
+const githubPullRequestsPerPageWithoutToken = 100
+
+// We use the GraphQL API for this, because it lets us look up the pull
+// requests of many branches, together with their reviews, in a single request;
+// with the REST API we'd need one request per branch, plus one per pull
+// request for its reviews. Unlike the REST API, it always needs a token, so
+// without one we fall back to getPullRequestsWithoutToken.
+func (self githubAPI) getPullRequests(client *apiClient, headBranches []string) (map[string]*models.PullRequest, error) {
+	if client.token == "" {
+		return self.getPullRequestsWithoutToken(client, headBranches)
+	}
+
+	fullName := client.repoInfo["owner"] + "/" + client.repoInfo["repo"]
+	result := map[string]*models.PullRequest{}
+	for _, branches := range lo.Chunk(headBranches, githubBranchesPerQuery) {
+		query, variables := self.pullRequestsQuery(client, branches)
+		var data struct {
+			Repository map[string]struct {
+				Nodes []githubGraphQLPullRequest `json:"nodes"`
+			} `json:"repository"`
+		}
+		if err := client.graphql(self.graphqlURL(client), query, variables, &data); err != nil {
+			return nil, err
+		}
+
+		for i, branch := range branches {
+			// Pull requests from forks can have the same branch names as
+			// ours; the first of the others is the most recently updated one
+			pr, ok := lo.Find(data.Repository[fmt.Sprintf("b%d", i)].Nodes, func(pr githubGraphQLPullRequest) bool {
+				return pr.HeadRepository != nil && strings.EqualFold(pr.HeadRepository.NameWithOwner, fullName)
+			})
+			if ok {
+				result[branch] = self.convertGraphQLPullRequest(pr)
+			}
+		}
+	}
+
+	return result, nil
+}
+
+// Looks for the branches' pull requests among the most recently updated ones
+// of the repo, using a single REST request. Pull requests that haven't been
+// updated in a while are missed, and we don't get the review status, which
+// would need another request per pull request.
+func (self githubAPI) getPullRequestsWithoutToken(client *apiClient, headBranches []string) (map[string]*models.PullRequest, error) {
+	fullName := client.repoInfo["owner"] + "/" + client.repoInfo["repo"]
+	var pullRequests []githubPullRequest
+	path := fmt.Sprintf("%s/pulls?state=all&sort=updated&direction=desc&per_page=%d", self.repoPath(client), githubPullRequestsPerPageWithoutToken)
+	if err := client.get(path, &pullRequests); err != nil {
+		return nil, err
+	}
+
+	result := map[string]*models.PullRequest{}
+	for _, pr := range pullRequests {
+		// Pull requests from forks can have the same branch names as ours
+		if pr.Head.Repo == nil || !strings.EqualFold(pr.Head.Repo.FullName, fullName) {
+			continue
+		}
+		if _, ok := result[pr.Head.Ref]; ok || !lo.Contains(headBranches, pr.Head.Ref) {
+			continue
+		}
+		result[pr.Head.Ref] = self.convertPullRequest(pr)
+	}
+
+	return result, nil
+}
+
+// Builds a query that gets the pull requests of each of the given branches,
+// using aliases b0, b1, etc. for them
+func (self githubAPI) pullRequestsQuery(client *apiClient, branches []string) (string, map[string]any) {
+	variables := map[string]any{"owner": client.repoInfo["owner"], "repo": client.repoInfo["repo"]}
+	params := []string{"$owner: String!", "$repo: String!"}
+	fields := []string{}
+	for i, branch := range branches {
+		variables[fmt.Sprintf("b%d", i)] = branch
+		params = append(params, fmt.Sprintf("$b%d: String!", i))
+		fields = append(fields, fmt.Sprintf("b%d: pullRequests(headRefName: $b%d, first: 10, orderBy: {field: UPDATED_AT, direction: DESC}) { ...pullRequests }", i, i))
+	}
+
+	query := fmt.Sprintf(`query(%s) {
+  repository(owner: $owner, name: $repo) {
+    %s
+  }
+}
+
+fragment pullRequests on PullRequestConnection {
+  nodes {
+    number
+    state
+    isDraft
+    url
+    headRefName
+    headRepository { nameWithOwner }
+    latestOpinionatedReviews(first: 100) { nodes { state author { login } } }
+  }
+}`, strings.Join(params, ", "), strings.Join(fields, "\n    "))
+
+	return query, variables
+}
+
+func (self githubAPI) createPullRequest(client *apiClient, opts CreatePullRequestOpts) (*models.PullRequest, error) {
+	body := map[string]any{
+		"title": opts.Title,
+		"body":  opts.Body,
+		"head":  opts.HeadBranch,
+		"base":  opts.BaseBranch,
+		"draft": opts.Draft,
+	}
+
+	var pr githubPullRequest
+	if err := client.request(http.MethodPost, self.repoPath(client)+"/pulls", body, &pr); err != nil {
+		return nil, err
+	}
+
+	return self.convertPullRequest(pr), nil
+}
+
+func (self githubAPI) getCheckStatus(client *apiClient, hash string) (*models.CheckStatus, error) {
+	commitPath := self.repoPath(client) + "/commits/" + url.PathEscape(hash)
+
+	var combinedStatus githubCombinedStatus
+	if err := client.get(commitPath+"/status?per_page=100", &combinedStatus); err != nil {
+		return nil, err
+	}
+
+	var checkRuns githubCheckRuns
+	if err := client.get(commitPath+"/check-runs?per_page=100", &checkRuns); err != nil {
+		return nil, err
+	}
+
+	result := &models.CheckStatus{}
+	for _, status := range combinedStatus.Statuses {
+		state := models.CheckStatePending
+		switch status.State {
+		case "success":
+			state = models.CheckStateSuccess
+		case "error", "failure":
+			state = models.CheckStateFailure
+		}
+		result.Checks = append(result.Checks, &models.Check{Name: status.Context, State: state, URL: status.TargetURL})
+	}
+	for _, run := range checkRuns.CheckRuns {
+		state := models.CheckStatePending
+		if run.Status == "completed" {
+			switch run.Conclusion {
+			case "success":
+				state = models.CheckStateSuccess
+			case "neutral", "skipped":
+				state = models.CheckStateNone
+			default:
+				state = models.CheckStateFailure
+			}
+		}
+		result.Checks = append(result.Checks, &models.Check{Name: run.Name, State: state, URL: run.HTMLURL})
+	}
+
+	return result, nil
+}
+
+func (self githubAPI) convertPullRequest(pr githubPullRequest) *models.PullRequest {
+	state := models.PullRequestStateOpen
+	switch {
+	case pr.MergedAt != nil:
+		state = models.PullRequestStateMerged
+	case pr.State == "closed":
+		state = models.PullRequestStateClosed
+	case pr.Draft:
+		state = models.PullRequestStateDraft
+	}
+
+	return &models.PullRequest{
+		Number:     pr.Number,
+		State:      state,
+		URL:        pr.HTMLURL,
+		HeadBranch: pr.Head.Ref,
+	}
+}
+
+func (self githubAPI) convertGraphQLPullRequest(pr githubGraphQLPullRequest) *models.PullRequest {
+	state := models.PullRequestStateOpen
+	switch {
+	case pr.State == "MERGED":
+		state = models.PullRequestStateMerged
+	case pr.State == "CLOSED":
+		state = models.PullRequestStateClosed
+	case pr.IsDraft:
+		state = models.PullRequestStateDraft
+	}
+
+	pullRequest := &models.PullRequest{
+		Number:     pr.Number,
+		State:      state,
+		URL:        pr.URL,
+		HeadBranch: pr.HeadRefName,
+	}
+
+	if pullRequest.IsOpen() {
+		pullRequest.ReviewStatus = reviewStatusFromReviews(pr.LatestOpinionatedReviews.Nodes,
+			func(review githubReview) string {
+				if review.Author == nil {
+					return ""
+				}
+				return review.Author.Login
+			},
+			func(review githubReview) (models.PullRequestReviewStatus, bool) {
+				switch review.State {
+				case "APPROVED":
+					return models.PullRequestReviewStatusApproved, true
+				case "CHANGES_REQUESTED":
+					return models.PullRequestReviewStatusChangesRequested, true
+				}
+				return models.PullRequestReviewStatusNone, false
+			},
+		)
+	}
+
+	return pullRequest
+}
+
+// GitHub Enterprise serves the REST API at <host>/api/v3 and the GraphQL API
+// at <host>/api/graphql; github.com serves them at api.github.com and
+// api.github.com/graphql
+func (self githubAPI) graphqlURL(client *apiClient) string {
+	if host, ok := strings.CutSuffix(client.baseURL, "/api/v3"); ok {
+		return host + "/api/graphql"
+	}
+	return client.baseURL + "/graphql"
+}
+
+func (self githubAPI) repoPath(client *apiClient) string {
+	return "/repos/" + url.PathEscape(client.repoInfo["owner"]) + "/" + url.PathEscape(client.repoInfo["repo"])
+}
@@ -0,0 +1,223 @@
+package hosting_service
+
+import (
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/go-errors/errors"
+	"github.com/jesseduffield/lazygit/pkg/commands/models"
+)
+
+// See https://docs.gitlab.com/ee/api/merge_requests.html, and
+// https://docs.gitlab.com/ee/api/graphql/reference/ for the GraphQL API
+type gitlabAPI struct{}
+
+var _ serviceAPI = gitlabAPI{}
+
+type gitlabMergeRequest struct {
+	IID          int    `json:"iid"`
+	State        string `json:"state"` // 'opened', 'closed', 'locked' or 'merged'
+	Draft        bool   `json:"draft"`
+	WebURL       string `json:"web_url"`
+	SourceBranch string `json:"source_branch"`
+}
+
+// A merge request as returned by the GraphQL API
+type gitlabGraphQLMergeRequest struct {
+	IID             string `json:"iid"`
+	State           string `json:"state"` // 'opened', 'closed', 'locked' or 'merged'
+	Draft           bool   `json:"draft"`
+	WebURL          string `json:"webUrl"`
+	SourceBranch    string `json:"sourceBranch"`
+	SourceProjectID int    `json:"sourceProjectId"`
+	TargetProjectID int    `json:"targetProjectId"`
+	Approved        bool   `json:"approved"`
+	ApprovedBy      struct {
+		Nodes []any `json:"nodes"`
+	} `json:"approvedBy"`
+}
+
+type gitlabCommitStatus struct {
+	Name      string `json:"name"`
+	Status    string `json:"status"` // 'success', 'failed', 'canceled', 'skipped', 'manual', or one of several states of not being done yet
+	TargetURL string `json:"target_url"`
+}
+
+func (self gitlabAPI) tokenEnvVar() string {
+	return "GITLAB_TOKEN"
+}
+
+func (self gitlabAPI) setAuthHeader(req *http.Request, token string) {
+	req.Header.Set("PRIVATE-TOKEN", token)
+}
+
+const gitlabMergeRequestsQuery = `query($project: ID!, $branches: [String!], $after: String) {
+  project(fullPath: $project) {
+    mergeRequests(sourceBranches: $branches, sort: UPDATED_DESC, first: 100, after: $after) {
+      nodes {
+        iid
+        state
+        draft
+        webUrl
+        sourceBranch
+        sourceProjectId
+        targetProjectId
+        approved
+        approvedBy { nodes { id } }
+      }
+      pageInfo { hasNextPage endCursor }
+    }
+  }
+}`
+
+// We use the GraphQL API for this, because it lets us look up the merge
+// requests of all branches, together with their approvals, in one go; with the
+// REST API we'd need one request per branch, plus one per merge request for
+// its approvals. We page through the results, but since we only get the
+// merge requests of the given branches, there is rarely more than one page.
+func (self gitlabAPI) getPullRequests(client *apiClient, headBranches []string) (map[string]*models.PullRequest, error) {
+	result := map[string]*models.PullRequest{}
+	// An empty list of branches would get us the merge requests of all
+	// branches
+	if len(headBranches) == 0 {
+		return result, nil
+	}
+
+	project := client.repoInfo["owner"] + "/" + client.repoInfo["repo"]
+	variables := map[string]any{"project": project, "branches": headBranches}
+	for {
+		var data struct {
+			Project *struct {
+				MergeRequests struct {
+					Nodes    []gitlabGraphQLMergeRequest `json:"nodes"`
+					PageInfo struct {
+						HasNextPage bool   `json:"hasNextPage"`
+						EndCursor   string `json:"endCursor"`
+					} `json:"pageInfo"`
+				} `json:"mergeRequests"`
+			} `json:"project"`
+		}
+		if err := client.graphql(self.graphqlURL(client), gitlabMergeRequestsQuery, variables, &data); err != nil {
+			return nil, err
+		}
+		if data.Project == nil {
+			return nil, errors.New("GitLab project not found: " + project)
+		}
+
+		for _, mr := range data.Project.MergeRequests.Nodes {
+			// Merge requests from forks can have the same branch names as ours
+			if mr.SourceProjectID != mr.TargetProjectID {
+				continue
+			}
+			// We get them most recently updated first
+			if _, ok := result[mr.SourceBranch]; ok {
+				continue
+			}
+
+			result[mr.SourceBranch] = self.convertGraphQLMergeRequest(mr)
+		}
+
+		pageInfo := data.Project.MergeRequests.PageInfo
+		if !pageInfo.HasNextPage {
+			return result, nil
+		}
+		variables["after"] = pageInfo.EndCursor
+	}
+}
+
+func (self gitlabAPI) createPullRequest(client *apiClient, opts CreatePullRequestOpts) (*models.PullRequest, error) {
+	// GitLab treats merge requests whose title starts with "Draft:" as drafts
+	title := opts.Title
+	if opts.Draft {
+		title = "Draft: " + title
+	}
+
+	body := map[string]any{
+		"title":         title,
+		"description":   opts.Body,
+		"source_branch": opts.HeadBranch,
+		"target_branch": opts.BaseBranch,
+	}
+
+	var mr gitlabMergeRequest
+	if err := client.request(http.MethodPost, self.projectPath(client)+"/merge_requests", body, &mr); err != nil {
+		return nil, err
+	}
+
+	return self.convertMergeRequest(mr), nil
+}
+
+func (self gitlabAPI) getCheckStatus(client *apiClient, hash string) (*models.CheckStatus, error) {
+	var statuses []gitlabCommitStatus
+	if err := client.get(self.projectPath(client)+"/repository/commits/"+url.PathEscape(hash)+"/statuses?per_page=100", &statuses); err != nil {
+		return nil, err
+	}
+
+	result := &models.CheckStatus{}
+	for _, status := range statuses {
+		state := models.CheckStatePending
+		switch status.Status {
+		case "success":
+			state = models.CheckStateSuccess
+		case "failed", "canceled":
+			state = models.CheckStateFailure
+		case "skipped", "manual":
+			state = models.CheckStateNone
+		}
+		result.Checks = append(result.Checks, &models.Check{Name: status.Name, State: state, URL: status.TargetURL})
+	}
+
+	return result, nil
+}
+
+func (self gitlabAPI) convertMergeRequest(mr gitlabMergeRequest) *models.PullRequest {
+	state := models.PullRequestStateOpen
+	switch {
+	case mr.State == "merged":
+		state = models.PullRequestStateMerged
+	case mr.State == "closed" || mr.State == "locked":
+		state = models.PullRequestStateClosed
+	case mr.Draft:
+		state = models.PullRequestStateDraft
+	}
+
+	return &models.PullRequest{
+		Number:     mr.IID,
+		State:      state,
+		URL:        mr.WebURL,
+		HeadBranch: mr.SourceBranch,
+	}
+}
+
+func (self gitlabAPI) convertGraphQLMergeRequest(mr gitlabGraphQLMergeRequest) *models.PullRequest {
+	number, _ := strconv.Atoi(mr.IID)
+	pullRequest := self.convertMergeRequest(gitlabMergeRequest{
+		IID:          number,
+		State:        mr.State,
+		Draft:        mr.Draft,
+		WebURL:       mr.WebURL,
+		SourceBranch: mr.SourceBranch,
+	})
+
+	// GitLab has no notion of requesting changes, so all we can tell is
+	// whether the merge request is approved
+	if pullRequest.IsOpen() && mr.Approved && len(mr.ApprovedBy.Nodes) > 0 {
+		pullRequest.ReviewStatus = models.PullRequestReviewStatusApproved
+	}
+
+	return pullRequest
+}
+
+// The GraphQL API is at <host>/api/graphql, next to the REST API at
+// <host>/api/v4
+func (self gitlabAPI) graphqlURL(client *apiClient) string {
+	return strings.TrimSuffix(client.baseURL, "/api/v4") + "/api/graphql"
+}
+
+// GitLab identifies projects by their URL-encoded full path, which can include
+// subgroups
+func (self gitlabAPI) projectPath(client *apiClient) string {
+	return "/projects/" + url.PathEscape(client.repoInfo["owner"]+"/"+client.repoInfo["repo"])
+}
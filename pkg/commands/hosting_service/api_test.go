@@ -0,0 +1,451 @@
+package hosting_service
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/jesseduffield/lazygit/pkg/commands/models"
+	"github.com/jesseduffield/lazygit/pkg/config"
+	"github.com/jesseduffield/lazygit/pkg/fakes"
+	"github.com/jesseduffield/lazygit/pkg/i18n"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetPullRequests(t *testing.T) {
+	type scenario struct {
+		testName             string
+		remoteUrl            string
+		configServiceDomains map[string]string
+		webDomain            string
+		token                string
+		// appended to the URL of the test server to get the base URL
+		baseURLPath string
+		// request path (including query) -> response body; for GraphQL
+		// queries, the path is followed by a space and the JSON of the variables
+		responses     map[string]string
+		expectedAuth  [2]string // header name and value
+		headBranches  []string
+		expected      map[string]*models.PullRequest
+		expectedError string
+	}
+
+	scenarios := []scenario{
+		{
+			testName:  "GitHub",
+			remoteUrl: "git@github.com:peter/calculator.git",
+			webDomain: "github.com",
+			token:     "secret",
+			responses: map[string]string{
+				`/graphql {"b0":"feature","b1":"draft","b2":"merged","b3":"no-pr","owner":"peter","repo":"calculator"}`: `{"data": {"repository": {
+					"b0": {"nodes": [
+						{"number": 5, "state": "OPEN", "isDraft": false, "url": "https://github.com/someone-else/calculator/pull/5", "headRefName": "feature", "headRepository": {"nameWithOwner": "someone-else/calculator"}},
+						{"number": 4, "state": "OPEN", "isDraft": false, "url": "https://github.com/peter/calculator/pull/4", "headRefName": "feature", "headRepository": {"nameWithOwner": "peter/calculator"},
+						 "latestOpinionatedReviews": {"nodes": [{"state": "APPROVED", "author": {"login": "alice"}}, {"state": "COMMENTED", "author": null}]}},
+						{"number": 1, "state": "CLOSED", "isDraft": false, "url": "https://github.com/peter/calculator/pull/1", "headRefName": "feature", "headRepository": {"nameWithOwner": "peter/calculator"}}
+					]},
+					"b1": {"nodes": [
+						{"number": 3, "state": "OPEN", "isDraft": true, "url": "https://github.com/peter/calculator/pull/3", "headRefName": "draft", "headRepository": {"nameWithOwner": "peter/calculator"},
+						 "latestOpinionatedReviews": {"nodes": [{"state": "APPROVED", "author": {"login": "alice"}}, {"state": "CHANGES_REQUESTED", "author": {"login": "bob"}}]}}
+					]},
+					"b2": {"nodes": [
+						{"number": 2, "state": "MERGED", "isDraft": false, "url": "https://github.com/peter/calculator/pull/2", "headRefName": "merged", "headRepository": {"nameWithOwner": "peter/calculator"},
+						 "latestOpinionatedReviews": {"nodes": [{"state": "APPROVED", "author": {"login": "alice"}}]}}
+					]},
+					"b3": {"nodes": []}
+				}}}`,
+			},
+			expectedAuth: [2]string{"Authorization", "Bearer secret"},
+			headBranches: []string{"feature", "draft", "merged", "no-pr"},
+			expected: map[string]*models.PullRequest{
+				"feature": {Number: 4, State: models.PullRequestStateOpen, ReviewStatus: models.PullRequestReviewStatusApproved, URL: "https://github.com/peter/calculator/pull/4", HeadBranch: "feature"},
+				"draft":   {Number: 3, State: models.PullRequestStateDraft, ReviewStatus: models.PullRequestReviewStatusChangesRequested, URL: "https://github.com/peter/calculator/pull/3", HeadBranch: "draft"},
+				"merged":  {Number: 2, State: models.PullRequestStateMerged, URL: "https://github.com/peter/calculator/pull/2", HeadBranch: "merged"},
+			},
+		},
+		{
+			testName:  "GitHub without a token",
+			remoteUrl: "git@github.com:peter/calculator.git",
+			webDomain: "github.com",
+			responses: map[string]string{
+				"/repos/peter/calculator/pulls?state=all&sort=updated&direction=desc&per_page=100": `[
+					{"number": 5, "state": "open", "draft": false, "merged_at": null, "html_url": "https://github.com/someone-else/calculator/pull/5", "head": {"ref": "feature", "repo": {"full_name": "someone-else/calculator"}}},
+					{"number": 4, "state": "open", "draft": false, "merged_at": null, "html_url": "https://github.com/peter/calculator/pull/4", "head": {"ref": "feature", "repo": {"full_name": "peter/calculator"}}},
+					{"number": 3, "state": "open", "draft": true, "merged_at": null, "html_url": "https://github.com/peter/calculator/pull/3", "head": {"ref": "draft", "repo": {"full_name": "peter/calculator"}}},
+					{"number": 2, "state": "closed", "draft": false, "merged_at": "2024-01-01T00:00:00Z", "html_url": "https://github.com/peter/calculator/pull/2", "head": {"ref": "merged", "repo": {"full_name": "peter/calculator"}}},
+					{"number": 1, "state": "closed", "draft": false, "merged_at": null, "html_url": "https://github.com/peter/calculator/pull/1", "head": {"ref": "feature", "repo": {"full_name": "peter/calculator"}}}
+				]`,
+			},
+			expectedAuth: [2]string{"Authorization", ""},
+			headBranches: []string{"feature", "draft", "merged", "no-pr"},
+			expected: map[string]*models.PullRequest{
+				"feature": {Number: 4, State: models.PullRequestStateOpen, URL: "https://github.com/peter/calculator/pull/4", HeadBranch: "feature"},
+				"draft":   {Number: 3, State: models.PullRequestStateDraft, URL: "https://github.com/peter/calculator/pull/3", HeadBranch: "draft"},
+				"merged":  {Number: 2, State: models.PullRequestStateMerged, URL: "https://github.com/peter/calculator/pull/2", HeadBranch: "merged"},
+			},
+		},
+		{
+			testName:             "GitHub Enterprise",
+			remoteUrl:            "git@github.work.com:peter/calculator.git",
+			configServiceDomains: map[string]string{"github.work.com": "github:github.work.com"},
+			webDomain:            "github.work.com",
+			token:                "secret",
+			baseURLPath:          "/api/v3",
+			responses: map[string]string{
+				`/api/graphql {"b0":"feature","owner":"peter","repo":"calculator"}`: `{"data": {"repository": {
+					"b0": {"nodes": [
+						{"number": 4, "state": "OPEN", "isDraft": false, "url": "https://github.work.com/peter/calculator/pull/4", "headRefName": "feature", "headRepository": {"nameWithOwner": "Peter/Calculator"}}
+					]}
+				}}}`,
+			},
+			expectedAuth: [2]string{"Authorization", "Bearer secret"},
+			headBranches: []string{"feature"},
+			expected: map[string]*models.PullRequest{
+				"feature": {Number: 4, State: models.PullRequestStateOpen, URL: "https://github.work.com/peter/calculator/pull/4", HeadBranch: "feature"},
+			},
+		},
+		{
+			testName:  "GitLab with subgroup, over several pages",
+			remoteUrl: "git@gitlab.com:peter/tools/calculator.git",
+			webDomain: "gitlab.com",
+			token:     "secret",
+			responses: map[string]string{
+				`/api/graphql {"branches":["feature","abandoned"],"project":"peter/tools/calculator"}`: `{"data": {"project": {"mergeRequests": {
+					"nodes": [
+						{"iid": "9", "state": "opened", "draft": false, "webUrl": "https://gitlab.com/someone-else/calculator/-/merge_requests/9", "sourceBranch": "feature", "sourceProjectId": 2, "targetProjectId": 1},
+						{"iid": "7", "state": "opened", "draft": false, "webUrl": "https://gitlab.com/peter/tools/calculator/-/merge_requests/7", "sourceBranch": "feature", "sourceProjectId": 1, "targetProjectId": 1,
+						 "approved": true, "approvedBy": {"nodes": [{"id": "gid://gitlab/User/1"}]}}
+					],
+					"pageInfo": {"hasNextPage": true, "endCursor": "page2"}
+				}}}}`,
+				`/api/graphql {"after":"page2","branches":["feature","abandoned"],"project":"peter/tools/calculator"}`: `{"data": {"project": {"mergeRequests": {
+					"nodes": [
+						{"iid": "6", "state": "closed", "draft": false, "webUrl": "https://gitlab.com/peter/tools/calculator/-/merge_requests/6", "sourceBranch": "abandoned", "sourceProjectId": 1, "targetProjectId": 1},
+						{"iid": "5", "state": "merged", "draft": false, "webUrl": "https://gitlab.com/peter/tools/calculator/-/merge_requests/5", "sourceBranch": "feature", "sourceProjectId": 1, "targetProjectId": 1}
+					],
+					"pageInfo": {"hasNextPage": false, "endCursor": "page3"}
+				}}}}`,
+			},
+			expectedAuth: [2]string{"PRIVATE-TOKEN", "secret"},
+			headBranches: []string{"feature", "abandoned"},
+			expected: map[string]*models.PullRequest{
+				"feature":   {Number: 7, State: models.PullRequestStateOpen, ReviewStatus: models.PullRequestReviewStatusApproved, URL: "https://gitlab.com/peter/tools/calculator/-/merge_requests/7", HeadBranch: "feature"},
+				"abandoned": {Number: 6, State: models.PullRequestStateClosed, URL: "https://gitlab.com/peter/tools/calculator/-/merge_requests/6", HeadBranch: "abandoned"},
+			},
+		},
+		{
+			testName:             "Gitea on a custom domain",
+			remoteUrl:            "git@git.work.com:peter/calculator.git",
+			configServiceDomains: map[string]string{"git.work.com": "gitea:gitea.work.com"},
+			webDomain:            "gitea.work.com",
+			token:                "secret",
+			responses: map[string]string{
+				"/repos/peter/calculator/pulls?state=all&sort=recentupdate&limit=50&page=1": `[
+					{"number": 12, "state": "open", "merged": false, "html_url": "https://gitea.work.com/peter/calculator/pulls/12", "head": {"ref": "feature", "repo": {"full_name": "peter/calculator"}}}
+				]`,
+				"/repos/peter/calculator/pulls/12/reviews": `[
+					{"state": "APPROVED", "user": {"login": "alice"}},
+					{"state": "REQUEST_CHANGES", "user": {"login": "bob"}}
+				]`,
+			},
+			expectedAuth: [2]string{"Authorization", "token secret"},
+			headBranches: []string{"feature", "no-pr"},
+			expected: map[string]*models.PullRequest{
+				"feature": {Number: 12, State: models.PullRequestStateOpen, ReviewStatus: models.PullRequestReviewStatusChangesRequested, URL: "https://gitea.work.com/peter/calculator/pulls/12", HeadBranch: "feature"},
+			},
+		},
+		{
+			testName:      "Reports API errors",
+			remoteUrl:     "git@github.com:peter/calculator.git",
+			webDomain:     "github.com",
+			token:         "secret",
+			responses:     map[string]string{},
+			headBranches:  []string{"feature"},
+			expectedError: "POST /graphql: 404 Not Found: Not Found",
+		},
+		{
+			testName:  "Reports GraphQL errors",
+			remoteUrl: "git@github.com:peter/calculator.git",
+			webDomain: "github.com",
+			token:     "secret",
+			responses: map[string]string{
+				`/graphql {"b0":"feature","owner":"peter","repo":"calculator"}`: `{"data": {"repository": null}, "errors": [{"message": "Could not resolve to a Repository with the name 'peter/calculator'."}]}`,
+			},
+			headBranches:  []string{"feature"},
+			expectedError: "Could not resolve to a Repository with the name 'peter/calculator'.",
+		},
+		{
+			testName:      "Unsupported service",
+			remoteUrl:     "git@bitbucket.org:peter/calculator.git",
+			webDomain:     "bitbucket.org",
+			headBranches:  []string{"feature"},
+			expectedError: "Talking to the API of this git service is not supported. Only GitHub, GitLab and Gitea are supported",
+		},
+	}
+
+	for _, s := range scenarios {
+		t.Run(s.testName, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if s.expectedAuth[0] != "" {
+					assert.Equal(t, s.expectedAuth[1], r.Header.Get(s.expectedAuth[0]))
+				}
+				key := r.URL.RequestURI()
+				if r.Method == http.MethodPost {
+					var body struct {
+						Variables map[string]any `json:"variables"`
+					}
+					_ = json.NewDecoder(r.Body).Decode(&body)
+					variables, _ := json.Marshal(body.Variables)
+					key += " " + string(variables)
+				}
+				response, ok := s.responses[key]
+				if !ok {
+					w.WriteHeader(http.StatusNotFound)
+					_, _ = w.Write([]byte(`{"message": "Not Found"}`))
+					return
+				}
+				_, _ = w.Write([]byte(response))
+			}))
+			defer server.Close()
+
+			apiConfig := config.HostingServiceAPIConfig{
+				Tokens:   map[string]string{s.webDomain: s.token},
+				BaseURLs: map[string]string{s.webDomain: server.URL + s.baseURLPath},
+			}
+
+			tr := i18n.EnglishTranslationSet()
+			log := &fakes.FakeFieldLogger{}
+			hostingServiceMgr := NewHostingServiceMgr(log, tr, s.remoteUrl, s.configServiceDomains)
+			pullRequests, err := hostingServiceMgr.GetPullRequests(apiConfig, s.headBranches)
+			if s.expectedError != "" {
+				assert.EqualError(t, err, s.expectedError)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, s.expected, pullRequests)
+			}
+		})
+	}
+}
+
+func TestCreatePullRequest(t *testing.T) {
+	type scenario struct {
+		testName             string
+		remoteUrl            string
+		configServiceDomains map[string]string
+		webDomain            string
+		opts                 CreatePullRequestOpts
+		expectedPath         string
+		expectedBody         string
+		responseStatus       int
+		response             string
+		expected             *models.PullRequest
+		expectedError        string
+	}
+
+	scenarios := []scenario{
+		{
+			testName:       "GitHub",
+			remoteUrl:      "git@github.com:peter/calculator.git",
+			webDomain:      "github.com",
+			opts:           CreatePullRequestOpts{Title: "Add division", Body: "Finally", HeadBranch: "feature", BaseBranch: "master", Draft: true},
+			expectedPath:   "/repos/peter/calculator/pulls",
+			expectedBody:   `{"base":"master","body":"Finally","draft":true,"head":"feature","title":"Add division"}`,
+			responseStatus: http.StatusCreated,
+			response:       `{"number": 5, "state": "open", "draft": true, "html_url": "https://github.com/peter/calculator/pull/5", "head": {"ref": "feature"}}`,
+			expected:       &models.PullRequest{Number: 5, State: models.PullRequestStateDraft, URL: "https://github.com/peter/calculator/pull/5", HeadBranch: "feature"},
+		},
+		{
+			testName:       "GitLab draft",
+			remoteUrl:      "git@gitlab.com:peter/calculator.git",
+			webDomain:      "gitlab.com",
+			opts:           CreatePullRequestOpts{Title: "Add division", HeadBranch: "feature", BaseBranch: "master", Draft: true},
+			expectedPath:   "/projects/peter%2Fcalculator/merge_requests",
+			expectedBody:   `{"description":"","source_branch":"feature","target_branch":"master","title":"Draft: Add division"}`,
+			responseStatus: http.StatusCreated,
+			response:       `{"iid": 8, "state": "opened", "draft": true, "web_url": "https://gitlab.com/peter/calculator/-/merge_requests/8", "source_branch": "feature"}`,
+			expected:       &models.PullRequest{Number: 8, State: models.PullRequestStateDraft, URL: "https://gitlab.com/peter/calculator/-/merge_requests/8", HeadBranch: "feature"},
+		},
+		{
+			testName:             "Gitea",
+			remoteUrl:            "git@git.work.com:peter/calculator.git",
+			configServiceDomains: map[string]string{"git.work.com": "gitea:gitea.work.com"},
+			webDomain:            "gitea.work.com",
+			opts:                 CreatePullRequestOpts{Title: "Add division", HeadBranch: "feature", BaseBranch: "master"},
+			expectedPath:         "/repos/peter/calculator/pulls",
+			expectedBody:         `{"base":"master","body":"","head":"feature","title":"Add division"}`,
+			responseStatus:       http.StatusCreated,
+			response:             `{"number": 13, "state": "open", "html_url": "https://gitea.work.com/peter/calculator/pulls/13", "head": {"ref": "feature"}}`,
+			expected:             &models.PullRequest{Number: 13, State: models.PullRequestStateOpen, URL: "https://gitea.work.com/peter/calculator/pulls/13", HeadBranch: "feature"},
+		},
+		{
+			testName:       "Reports validation errors",
+			remoteUrl:      "git@github.com:peter/calculator.git",
+			webDomain:      "github.com",
+			opts:           CreatePullRequestOpts{Title: "Add division", HeadBranch: "feature", BaseBranch: "master"},
+			expectedPath:   "/repos/peter/calculator/pulls",
+			expectedBody:   `{"base":"master","body":"","draft":false,"head":"feature","title":"Add division"}`,
+			responseStatus: http.StatusUnprocessableEntity,
+			response:       `{"message": "Validation Failed", "errors": [{"message": "A pull request already exists for peter:feature."}]}`,
+			expectedError:  "POST /repos/peter/calculator/pulls: 422 Unprocessable Entity: Validation Failed; A pull request already exists for peter:feature.",
+		},
+	}
+
+	for _, s := range scenarios {
+		t.Run(s.testName, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, http.MethodPost, r.Method)
+				assert.Equal(t, s.expectedPath, r.URL.EscapedPath())
+				body, _ := io.ReadAll(r.Body)
+				assert.JSONEq(t, s.expectedBody, string(body))
+				w.WriteHeader(s.responseStatus)
+				_, _ = w.Write([]byte(s.response))
+			}))
+			defer server.Close()
+
+			apiConfig := config.HostingServiceAPIConfig{
+				Tokens:   map[string]string{s.webDomain: "secret"},
+				BaseURLs: map[string]string{s.webDomain: server.URL},
+			}
+
+			tr := i18n.EnglishTranslationSet()
+			log := &fakes.FakeFieldLogger{}
+			hostingServiceMgr := NewHostingServiceMgr(log, tr, s.remoteUrl, s.configServiceDomains)
+			assert.True(t, hostingServiceMgr.HasAPIToken(apiConfig))
+			pullRequest, err := hostingServiceMgr.CreatePullRequest(apiConfig, s.opts)
+			if s.expectedError != "" {
+				assert.EqualError(t, err, s.expectedError)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, s.expected, pullRequest)
+			}
+		})
+	}
+}
+
+func TestGetCheckStatus(t *testing.T) {
+	type scenario struct {
+		testName             string
+		remoteUrl            string
+		configServiceDomains map[string]string
+		webDomain            string
+		responses            map[string]string
+		expected             *models.CheckStatus
+		expectedState        models.CheckState
+		expectNetworkError   bool
+	}
+
+	scenarios := []scenario{
+		{
+			testName:  "GitHub statuses and check runs",
+			remoteUrl: "git@github.com:peter/calculator.git",
+			webDomain: "github.com",
+			responses: map[string]string{
+				"/repos/peter/calculator/commits/abc123/status": `{"statuses": [
+					{"state": "success", "context": "ci/jenkins", "target_url": "https://ci.example.com/1"}
+				]}`,
+				"/repos/peter/calculator/commits/abc123/check-runs": `{"check_runs": [
+					{"name": "build", "status": "completed", "conclusion": "success", "html_url": "https://github.com/peter/calculator/runs/1"},
+					{"name": "lint", "status": "completed", "conclusion": "skipped", "html_url": "https://github.com/peter/calculator/runs/2"},
+					{"name": "test", "status": "in_progress", "conclusion": null, "html_url": "https://github.com/peter/calculator/runs/3"}
+				]}`,
+			},
+			expected: &models.CheckStatus{Checks: []*models.Check{
+				{Name: "ci/jenkins", State: models.CheckStateSuccess, URL: "https://ci.example.com/1"},
+				{Name: "build", State: models.CheckStateSuccess, URL: "https://github.com/peter/calculator/runs/1"},
+				{Name: "lint", State: models.CheckStateNone, URL: "https://github.com/peter/calculator/runs/2"},
+				{Name: "test", State: models.CheckStatePending, URL: "https://github.com/peter/calculator/runs/3"},
+			}},
+			expectedState: models.CheckStatePending,
+		},
+		{
+			testName:  "GitLab",
+			remoteUrl: "git@gitlab.com:peter/calculator.git",
+			webDomain: "gitlab.com",
+			responses: map[string]string{
+				"/projects/peter%2Fcalculator/repository/commits/abc123/statuses": `[
+					{"name": "build", "status": "success", "target_url": "https://gitlab.com/peter/calculator/-/jobs/1"},
+					{"name": "test", "status": "failed", "target_url": "https://gitlab.com/peter/calculator/-/jobs/2"},
+					{"name": "deploy", "status": "manual", "target_url": "https://gitlab.com/peter/calculator/-/jobs/3"}
+				]`,
+			},
+			expected: &models.CheckStatus{Checks: []*models.Check{
+				{Name: "build", State: models.CheckStateSuccess, URL: "https://gitlab.com/peter/calculator/-/jobs/1"},
+				{Name: "test", State: models.CheckStateFailure, URL: "https://gitlab.com/peter/calculator/-/jobs/2"},
+				{Name: "deploy", State: models.CheckStateNone, URL: "https://gitlab.com/peter/calculator/-/jobs/3"},
+			}},
+			expectedState: models.CheckStateFailure,
+		},
+		{
+			testName:             "Gitea",
+			remoteUrl:            "git@git.work.com:peter/calculator.git",
+			configServiceDomains: map[string]string{"git.work.com": "gitea:gitea.work.com"},
+			webDomain:            "gitea.work.com",
+			responses: map[string]string{
+				"/repos/peter/calculator/commits/abc123/status": `{"statuses": [
+					{"status": "success", "context": "build", "target_url": "https://gitea.work.com/peter/calculator/actions/runs/1"}
+				]}`,
+			},
+			expected: &models.CheckStatus{Checks: []*models.Check{
+				{Name: "build", State: models.CheckStateSuccess, URL: "https://gitea.work.com/peter/calculator/actions/runs/1"},
+			}},
+			expectedState: models.CheckStateSuccess,
+		},
+		{
+			testName:  "No checks",
+			remoteUrl: "git@github.com:peter/calculator.git",
+			webDomain: "github.com",
+			responses: map[string]string{
+				"/repos/peter/calculator/commits/abc123/status":     `{"statuses": []}`,
+				"/repos/peter/calculator/commits/abc123/check-runs": `{"check_runs": []}`,
+			},
+			expected:      &models.CheckStatus{},
+			expectedState: models.CheckStateNone,
+		},
+		{
+			testName:           "Service unreachable",
+			remoteUrl:          "git@github.com:peter/calculator.git",
+			webDomain:          "github.com",
+			expectNetworkError: true,
+		},
+	}
+
+	for _, s := range scenarios {
+		t.Run(s.testName, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				response, ok := s.responses[r.URL.EscapedPath()]
+				if !ok {
+					t.Errorf("unexpected request: %s", r.URL)
+					w.WriteHeader(http.StatusNotFound)
+					return
+				}
+				_, _ = w.Write([]byte(response))
+			}))
+			serverURL := server.URL
+			if s.expectNetworkError {
+				server.Close()
+			} else {
+				defer server.Close()
+			}
+
+			apiConfig := config.HostingServiceAPIConfig{
+				Tokens:   map[string]string{s.webDomain: "secret"},
+				BaseURLs: map[string]string{s.webDomain: serverURL},
+			}
+
+			tr := i18n.EnglishTranslationSet()
+			log := &fakes.FakeFieldLogger{}
+			hostingServiceMgr := NewHostingServiceMgr(log, tr, s.remoteUrl, s.configServiceDomains)
+			checkStatus, err := hostingServiceMgr.GetCheckStatus(apiConfig, "abc123")
+			if s.expectNetworkError {
+				assert.True(t, IsNetworkError(err))
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, s.expected, checkStatus)
+			assert.Equal(t, s.expectedState, checkStatus.State())
+		})
+	}
+}
@@ -17,6 +17,8 @@ var githubServiceDef = ServiceDefinition{
 	commitURL:                       "/commit/{{.CommitHash}}",
 	regexStrings:                    defaultUrlRegexStrings,
 	repoURLTemplate:                 defaultRepoURLTemplate,
+	apiBaseURLTemplate:              "https://api.{{.webDomain}}",
+	api:                             githubAPI{},
 }
 
 var bitbucketServiceDef = ServiceDefinition{
@@ -38,6 +40,8 @@ var gitLabServiceDef = ServiceDefinition{
 	commitURL:                       "/-/commit/{{.CommitHash}}",
 	regexStrings:                    defaultUrlRegexStrings,
 	repoURLTemplate:                 defaultRepoURLTemplate,
+	apiBaseURLTemplate:              "https://{{.webDomain}}/api/v4",
+	api:                             gitlabAPI{},
 }
 
 var azdoServiceDef = ServiceDefinition{
@@ -73,6 +77,8 @@ var giteaServiceDef = ServiceDefinition{
 	commitURL:                       "/commit/{{.CommitHash}}",
 	regexStrings:                    defaultUrlRegexStrings,
 	repoURLTemplate:                 defaultRepoURLTemplate,
+	apiBaseURLTemplate:              "https://{{.webDomain}}/api/v1",
+	api:                             giteaAPI{},
 }
 
 var serviceDefinitions = []ServiceDefinition{
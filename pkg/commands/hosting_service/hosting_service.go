@@ -67,13 +67,15 @@ func (self *HostingServiceMgr) getService() (*Service, error) {
 		return nil, err
 	}
 
-	repoURL, err := serviceDomain.serviceDefinition.getRepoURLFromRemoteURL(self.remoteURL, serviceDomain.webDomain)
+	repoInfo, err := serviceDomain.serviceDefinition.getRepoInfoFromRemoteURL(self.remoteURL, serviceDomain.webDomain)
 	if err != nil {
 		return nil, err
 	}
 
 	return &Service{
-		repoURL:           repoURL,
+		repoURL:           utils.ResolvePlaceholderString(serviceDomain.serviceDefinition.repoURLTemplate, repoInfo),
+		repoInfo:          repoInfo,
+		webDomain:         serviceDomain.webDomain,
 		ServiceDefinition: serviceDomain.serviceDefinition,
 	}, nil
 }
@@ -145,23 +147,31 @@ type ServiceDefinition struct {
 
 	// can expect 'webdomain' to be passed in. Otherwise, you get to pick what we match in the regex
 	repoURLTemplate string
+
+	// The default base URL of the REST API; same placeholders as repoURLTemplate.
+	// Empty (along with api) for services whose API we don't support.
+	apiBaseURLTemplate string
+	api                serviceAPI
 }
 
-func (self ServiceDefinition) getRepoURLFromRemoteURL(url string, webDomain string) (string, error) {
+// Returns the named matches of the first regex that matches the url, plus the webDomain
+func (self ServiceDefinition) getRepoInfoFromRemoteURL(url string, webDomain string) (map[string]string, error) {
 	for _, regexStr := range self.regexStrings {
 		re := regexp.MustCompile(regexStr)
 		input := utils.FindNamedMatches(re, url)
 		if input != nil {
 			input["webDomain"] = webDomain
-			return utils.ResolvePlaceholderString(self.repoURLTemplate, input), nil
+			return input, nil
 		}
 	}
 
-	return "", errors.New("Failed to parse repo information from url")
+	return nil, errors.New("Failed to parse repo information from url")
 }
 
 type Service struct {
-	repoURL string
+	repoURL   string
+	repoInfo  map[string]string
+	webDomain string
 	ServiceDefinition
 }
 
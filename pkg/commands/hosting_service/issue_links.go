@@ -0,0 +1,115 @@
+package hosting_service
+
+import (
+	"net/url"
+	"regexp"
+	"slices"
+
+	"github.com/jesseduffield/lazygit/pkg/config"
+	"github.com/jesseduffield/lazygit/pkg/utils"
+)
+
+// A reference to an issue in an issue tracker, found in a commit message or a
+// branch name
+type IssueLink struct {
+	// The text that matched the pattern, e.g. "PROJ-1234"
+	Text string
+	URL  string
+	// Byte offsets of the text within the string it was found in
+	Start int
+	End   int
+}
+
+type issueLinkRule struct {
+	re          *regexp.Regexp
+	urlTemplate string
+}
+
+// Finds references to issues in arbitrary text, according to the issueLinks
+// rules of the user config
+type IssueLinkMatcher struct {
+	rules []issueLinkRule
+}
+
+func NewIssueLinkMatcher(configs []config.IssueLinkConfig) *IssueLinkMatcher {
+	rules := []issueLinkRule{}
+	for _, config := range configs {
+		// The config has been validated, so this can only fail for rules that
+		// are half filled in; we just ignore those
+		re, err := regexp.Compile(config.Pattern)
+		if err != nil || config.Pattern == "" || config.URLTemplate == "" {
+			continue
+		}
+		rules = append(rules, issueLinkRule{re: re, urlTemplate: config.URLTemplate})
+	}
+
+	return &IssueLinkMatcher{rules: rules}
+}
+
+func (self *IssueLinkMatcher) IsEmpty() bool {
+	return len(self.rules) == 0
+}
+
+// Returns the links in the text in the order in which they appear. When the
+// matches of several rules overlap, the one that starts first wins, and for
+// matches starting at the same position the rule that comes first in the
+// config.
+func (self *IssueLinkMatcher) FindLinks(text string) []IssueLink {
+	links := []IssueLink{}
+	for _, rule := range self.rules {
+		for _, match := range rule.re.FindAllStringSubmatchIndex(text, -1) {
+			start, end := match[0], match[1]
+			if start == end {
+				continue
+			}
+
+			args := map[string]string{"match": url.PathEscape(text[start:end])}
+			for i, name := range rule.re.SubexpNames() {
+				if name != "" && match[2*i] >= 0 {
+					args[name] = url.PathEscape(text[match[2*i]:match[2*i+1]])
+				}
+			}
+
+			links = append(links, IssueLink{
+				Text:  text[start:end],
+				URL:   utils.ResolvePlaceholderString(rule.urlTemplate, args),
+				Start: start,
+				End:   end,
+			})
+		}
+	}
+
+	slices.SortStableFunc(links, func(a, b IssueLink) int { return a.Start - b.Start })
+
+	result := []IssueLink{}
+	for _, link := range links {
+		if len(result) > 0 && link.Start < result[len(result)-1].End {
+			continue
+		}
+		result = append(result, link)
+	}
+	return result
+}
+
+// Returns the text with every link passed through highlightLink, and the
+// parts in between passed through highlightText
+func (self *IssueLinkMatcher) Highlight(text string, highlightText func(string) string, highlightLink func(string) string) string {
+	links := self.FindLinks(text)
+	if len(links) == 0 {
+		return highlightText(text)
+	}
+
+	result := ""
+	pos := 0
+	for _, link := range links {
+		if link.Start > pos {
+			result += highlightText(text[pos:link.Start])
+		}
+		result += highlightLink(link.Text)
+		pos = link.End
+	}
+	if pos < len(text) {
+		result += highlightText(text[pos:])
+	}
+	return result
+}
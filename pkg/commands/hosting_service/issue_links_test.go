@@ -0,0 +1,116 @@
+package hosting_service
+
+import (
+	"testing"
+
+	"github.com/jesseduffield/lazygit/pkg/config"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIssueLinkMatcherFindLinks(t *testing.T) {
+	scenarios := []struct {
+		testName string
+		configs  []config.IssueLinkConfig
+		text     string
+		expected []IssueLink
+	}{
+		{
+			testName: "no rules",
+			configs:  nil,
+			text:     "PROJ-1234 fix the thing",
+			expected: []IssueLink{},
+		},
+		{
+			testName: "no match",
+			configs: []config.IssueLinkConfig{
+				{Pattern: `\bPROJ-\d+\b`, URLTemplate: "https://jira.example.com/browse/{{.match}}"},
+			},
+			text:     "fix the thing",
+			expected: []IssueLink{},
+		},
+		{
+			testName: "several matches",
+			configs: []config.IssueLinkConfig{
+				{Pattern: `\bPROJ-\d+\b`, URLTemplate: "https://jira.example.com/browse/{{.match}}"},
+			},
+			text: "PROJ-1234: fix the thing (see PROJ-99)",
+			expected: []IssueLink{
+				{Text: "PROJ-1234", URL: "https://jira.example.com/browse/PROJ-1234", Start: 0, End: 9},
+				{Text: "PROJ-99", URL: "https://jira.example.com/browse/PROJ-99", Start: 30, End: 37},
+			},
+		},
+		{
+			testName: "named capture groups",
+			configs: []config.IssueLinkConfig{
+				{Pattern: `#(?P<number>\d+)`, URLTemplate: "https://tracker.example.com/issues/{{.number}}"},
+			},
+			text: "Fixes #42",
+			expected: []IssueLink{
+				{Text: "#42", URL: "https://tracker.example.com/issues/42", Start: 6, End: 9},
+			},
+		},
+		{
+			testName: "matches of several rules, in order of appearance",
+			configs: []config.IssueLinkConfig{
+				{Pattern: `#(?P<number>\d+)`, URLTemplate: "https://tracker.example.com/issues/{{.number}}"},
+				{Pattern: `\bPROJ-\d+\b`, URLTemplate: "https://jira.example.com/browse/{{.match}}"},
+			},
+			text: "feature/PROJ-1234-#42",
+			expected: []IssueLink{
+				{Text: "PROJ-1234", URL: "https://jira.example.com/browse/PROJ-1234", Start: 8, End: 17},
+				{Text: "#42", URL: "https://tracker.example.com/issues/42", Start: 18, End: 21},
+			},
+		},
+		{
+			testName: "overlapping matches",
+			configs: []config.IssueLinkConfig{
+				{Pattern: `\d+`, URLTemplate: "https://numbers.example.com/{{.match}}"},
+				{Pattern: `[A-Z]+-\d+`, URLTemplate: "https://jira.example.com/browse/{{.match}}"},
+				{Pattern: `PROJ-\d+`, URLTemplate: "https://other.example.com/{{.match}}"},
+			},
+			text: "PROJ-1234",
+			expected: []IssueLink{
+				{Text: "PROJ-1234", URL: "https://jira.example.com/browse/PROJ-1234", Start: 0, End: 9},
+			},
+		},
+		{
+			testName: "matched text is escaped in the url",
+			configs: []config.IssueLinkConfig{
+				{Pattern: `issue \S+`, URLTemplate: "https://tracker.example.com/{{.match}}"},
+			},
+			text: "see issue a/b",
+			expected: []IssueLink{
+				{Text: "issue a/b", URL: "https://tracker.example.com/issue%20a%2Fb", Start: 4, End: 13},
+			},
+		},
+		{
+			testName: "rules with an invalid pattern or no url template are ignored",
+			configs: []config.IssueLinkConfig{
+				{Pattern: `PROJ-(`, URLTemplate: "https://jira.example.com/browse/{{.match}}"},
+				{Pattern: `PROJ-\d+`, URLTemplate: ""},
+			},
+			text:     "PROJ-1234",
+			expected: []IssueLink{},
+		},
+	}
+
+	for _, s := range scenarios {
+		t.Run(s.testName, func(t *testing.T) {
+			matcher := NewIssueLinkMatcher(s.configs)
+			assert.Equal(t, s.expected, matcher.FindLinks(s.text))
+		})
+	}
+}
+
+func TestIssueLinkMatcherHighlight(t *testing.T) {
+	matcher := NewIssueLinkMatcher([]config.IssueLinkConfig{
+		{Pattern: `\bPROJ-\d+\b`, URLTemplate: "https://jira.example.com/browse/{{.match}}"},
+	})
+
+	highlightText := func(s string) string { return "(" + s + ")" }
+	highlightLink := func(s string) string { return "[" + s + "]" }
+
+	assert.Equal(t, "(fix the thing)", matcher.Highlight("fix the thing", highlightText, highlightLink))
+	assert.Equal(t, "[PROJ-1]", matcher.Highlight("PROJ-1", highlightText, highlightLink))
+	assert.Equal(t, "[PROJ-1](: fix )[PROJ-2]( and more)", matcher.Highlight("PROJ-1: fix PROJ-2 and more", highlightText, highlightLink))
+}
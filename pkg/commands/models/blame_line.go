@@ -0,0 +1,52 @@
+package models
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/jesseduffield/lazygit/pkg/utils"
+)
+
+// BlameLine is a single line of a blamed file, together with information
+// about the commit that last changed it
+type BlameLine struct {
+	Hash          string
+	AuthorName    string
+	AuthorEmail   string
+	UnixTimestamp int64
+	Summary       string
+
+	// Line number (1-based) of this line in the blamed revision of the file
+	LineNumber int
+	// Line number (1-based) of this line in the commit that introduced it
+	OriginalLineNumber int
+	// Path of the file in the commit that introduced the line. This can be
+	// different from the blamed path if the file was renamed in the meantime.
+	Filename string
+
+	// The parent commit of Hash, and the path of the file in that commit; used
+	// for re-blaming the file at the parent. Both are empty if Hash is a root
+	// commit, or if the line is not committed yet.
+	PreviousHash     string
+	PreviousFilename string
+
+	Content string
+}
+
+func (b *BlameLine) ID() string {
+	return strconv.Itoa(b.LineNumber)
+}
+
+func (b *BlameLine) ShortHash() string {
+	return utils.ShortHash(b.Hash)
+}
+
+// IsCommitted returns false for lines that have uncommitted changes in the
+// working tree; git reports these with an all-zeroes hash
+func (b *BlameLine) IsCommitted() bool {
+	return strings.Trim(b.Hash, "0") != ""
+}
+
+func (b *BlameLine) HasPrevious() bool {
+	return b.PreviousHash != ""
+}
@@ -40,6 +40,10 @@ type Branch struct {
 	// determined yet, or up to date with base branch. (We don't need to
 	// distinguish the two, as we don't draw anything in both cases.)
 	BehindBaseBranch atomic.Int32
+
+	// How many files would conflict when merging this branch into its base
+	// branch. 0 means either not determined yet, or merges cleanly.
+	ConflictsWithBaseBranch atomic.Int32
 }
 
 func (b *Branch) FullRefName() string {
@@ -0,0 +1,44 @@
+package models
+
+type CheckState int
+
+const (
+	// For a check: it was skipped, or it's purely informational. For a
+	// commit: it has no checks that succeeded, failed or are still running.
+	CheckStateNone CheckState = iota
+	CheckStatePending
+	CheckStateSuccess
+	CheckStateFailure
+)
+
+// A single CI check (or commit status) that was reported for a commit
+type Check struct {
+	Name  string
+	State CheckState
+	URL   string
+}
+
+// The CI checks of a commit, as reported by the API of a git hosting service
+type CheckStatus struct {
+	Checks []*Check
+}
+
+// The combined state of all checks: failed if any of them failed, pending if
+// any of them is still running, and successful otherwise
+func (self *CheckStatus) State() CheckState {
+	result := CheckStateNone
+	for _, check := range self.Checks {
+		switch check.State {
+		case CheckStateFailure:
+			return CheckStateFailure
+		case CheckStatePending:
+			result = CheckStatePending
+		case CheckStateSuccess:
+			if result == CheckStateNone {
+				result = CheckStateSuccess
+			}
+		case CheckStateNone:
+		}
+	}
+	return result
+}
@@ -25,6 +25,13 @@ type File struct {
 
 	// If true, this must be a worktree folder
 	IsWorktree bool
+
+	// If true, the file is tracked by Git LFS according to .gitattributes
+	IsLfs bool
+
+	// If true, git still reports the file as conflicted, but rerere has already
+	// resolved it using a recorded resolution
+	IsRerereResolved bool
 }
 
 // sometimes we need to deal with either a node (which contains a file) or an actual file
@@ -0,0 +1,34 @@
+package models
+
+type PullRequestState int
+
+const (
+	PullRequestStateOpen PullRequestState = iota
+	PullRequestStateDraft
+	PullRequestStateMerged
+	PullRequestStateClosed
+)
+
+type PullRequestReviewStatus int
+
+const (
+	// No approvals or change requests yet (or we couldn't tell)
+	PullRequestReviewStatusNone PullRequestReviewStatus = iota
+	PullRequestReviewStatusApproved
+	PullRequestReviewStatusChangesRequested
+)
+
+// A pull request (or merge request, in GitLab's terminology) as reported by
+// the API of a git hosting service
+type PullRequest struct {
+	Number       int
+	State        PullRequestState
+	ReviewStatus PullRequestReviewStatus
+	URL          string
+	// The name of the branch on the remote that the pull request merges from
+	HeadBranch string
+}
+
+func (self *PullRequest) IsOpen() bool {
+	return self.State == PullRequestStateOpen || self.State == PullRequestStateDraft
+}
@@ -11,10 +11,13 @@ type WorkingTreeState struct {
 	Merging       bool
 	CherryPicking bool
 	Reverting     bool
+	// Applying patches from a mailbox with `git am`. This can't be combined with
+	// Rebasing, because they both use the same directory for their state.
+	ApplyingPatches bool
 }
 
 func (self WorkingTreeState) Any() bool {
-	return self.Rebasing || self.Merging || self.CherryPicking || self.Reverting
+	return self.Rebasing || self.Merging || self.CherryPicking || self.Reverting || self.ApplyingPatches
 }
 
 func (self WorkingTreeState) None() bool {
@@ -30,6 +33,7 @@ const (
 	WORKING_TREE_STATE_MERGING
 	WORKING_TREE_STATE_CHERRY_PICKING
 	WORKING_TREE_STATE_REVERTING
+	WORKING_TREE_STATE_APPLYING_PATCHES
 )
 
 // Effective returns the "current" state; if several states are true at once,
@@ -55,51 +59,59 @@ func (self WorkingTreeState) Effective() EffectiveWorkingTreeState {
 	if self.Rebasing {
 		return WORKING_TREE_STATE_REBASING
 	}
+	if self.ApplyingPatches {
+		return WORKING_TREE_STATE_APPLYING_PATCHES
+	}
 	return WORKING_TREE_STATE_NONE
 }
 
 func (self WorkingTreeState) Title(tr *i18n.TranslationSet) string {
 	return map[EffectiveWorkingTreeState]string{
-		WORKING_TREE_STATE_REBASING:       tr.RebasingStatus,
-		WORKING_TREE_STATE_MERGING:        tr.MergingStatus,
-		WORKING_TREE_STATE_CHERRY_PICKING: tr.CherryPickingStatus,
-		WORKING_TREE_STATE_REVERTING:      tr.RevertingStatus,
+		WORKING_TREE_STATE_REBASING:         tr.RebasingStatus,
+		WORKING_TREE_STATE_MERGING:          tr.MergingStatus,
+		WORKING_TREE_STATE_CHERRY_PICKING:   tr.CherryPickingStatus,
+		WORKING_TREE_STATE_REVERTING:        tr.RevertingStatus,
+		WORKING_TREE_STATE_APPLYING_PATCHES: tr.ApplyingPatchesStatus,
 	}[self.Effective()]
 }
 
 func (self WorkingTreeState) LowerCaseTitle(tr *i18n.TranslationSet) string {
 	return map[EffectiveWorkingTreeState]string{
-		WORKING_TREE_STATE_REBASING:       tr.LowercaseRebasingStatus,
-		WORKING_TREE_STATE_MERGING:        tr.LowercaseMergingStatus,
-		WORKING_TREE_STATE_CHERRY_PICKING: tr.LowercaseCherryPickingStatus,
-		WORKING_TREE_STATE_REVERTING:      tr.LowercaseRevertingStatus,
+		WORKING_TREE_STATE_REBASING:         tr.LowercaseRebasingStatus,
+		WORKING_TREE_STATE_MERGING:          tr.LowercaseMergingStatus,
+		WORKING_TREE_STATE_CHERRY_PICKING:   tr.LowercaseCherryPickingStatus,
+		WORKING_TREE_STATE_REVERTING:        tr.LowercaseRevertingStatus,
+		WORKING_TREE_STATE_APPLYING_PATCHES: tr.LowercaseApplyingPatchesStatus,
 	}[self.Effective()]
 }
 
 func (self WorkingTreeState) OptionsMenuTitle(tr *i18n.TranslationSet) string {
 	return map[EffectiveWorkingTreeState]string{
-		WORKING_TREE_STATE_REBASING:       tr.RebaseOptionsTitle,
-		WORKING_TREE_STATE_MERGING:        tr.MergeOptionsTitle,
-		WORKING_TREE_STATE_CHERRY_PICKING: tr.CherryPickOptionsTitle,
-		WORKING_TREE_STATE_REVERTING:      tr.RevertOptionsTitle,
+		WORKING_TREE_STATE_REBASING:         tr.RebaseOptionsTitle,
+		WORKING_TREE_STATE_MERGING:          tr.MergeOptionsTitle,
+		WORKING_TREE_STATE_CHERRY_PICKING:   tr.CherryPickOptionsTitle,
+		WORKING_TREE_STATE_REVERTING:        tr.RevertOptionsTitle,
+		WORKING_TREE_STATE_APPLYING_PATCHES: tr.ApplyPatchesOptionsTitle,
 	}[self.Effective()]
 }
 
 func (self WorkingTreeState) OptionsMapTitle(tr *i18n.TranslationSet) string {
 	return map[EffectiveWorkingTreeState]string{
-		WORKING_TREE_STATE_REBASING:       tr.ViewRebaseOptions,
-		WORKING_TREE_STATE_MERGING:        tr.ViewMergeOptions,
-		WORKING_TREE_STATE_CHERRY_PICKING: tr.ViewCherryPickOptions,
-		WORKING_TREE_STATE_REVERTING:      tr.ViewRevertOptions,
+		WORKING_TREE_STATE_REBASING:         tr.ViewRebaseOptions,
+		WORKING_TREE_STATE_MERGING:          tr.ViewMergeOptions,
+		WORKING_TREE_STATE_CHERRY_PICKING:   tr.ViewCherryPickOptions,
+		WORKING_TREE_STATE_REVERTING:        tr.ViewRevertOptions,
+		WORKING_TREE_STATE_APPLYING_PATCHES: tr.ViewApplyPatchesOptions,
 	}[self.Effective()]
 }
 
 func (self WorkingTreeState) CommandName() string {
 	return map[EffectiveWorkingTreeState]string{
-		WORKING_TREE_STATE_REBASING:       "rebase",
-		WORKING_TREE_STATE_MERGING:        "merge",
-		WORKING_TREE_STATE_CHERRY_PICKING: "cherry-pick",
-		WORKING_TREE_STATE_REVERTING:      "revert",
+		WORKING_TREE_STATE_REBASING:         "rebase",
+		WORKING_TREE_STATE_MERGING:          "merge",
+		WORKING_TREE_STATE_CHERRY_PICKING:   "cherry-pick",
+		WORKING_TREE_STATE_REVERTING:        "revert",
+		WORKING_TREE_STATE_APPLYING_PATCHES: "am",
 	}[self.Effective()]
 }
 
@@ -108,5 +120,5 @@ func (self WorkingTreeState) CanShowTodos() bool {
 }
 
 func (self WorkingTreeState) CanSkip() bool {
-	return self.Rebasing || self.CherryPicking || self.Reverting
+	return self.Rebasing || self.CherryPicking || self.Reverting || self.ApplyingPatches
 }
@@ -1,6 +1,7 @@
 package oscommands
 
 import (
+	"io"
 	"os/exec"
 	"strings"
 
@@ -22,6 +23,9 @@ type CmdObj struct {
 	// see StreamOutput()
 	streamOutput bool
 
+	// see AlsoStreamOutputTo()
+	extraOutputWriter io.Writer
+
 	// see UsePty()
 	usePty bool
 
@@ -128,6 +132,19 @@ func (self *CmdObj) ShouldStreamOutput() bool {
 	return self.streamOutput
 }
 
+// When the output is streamed to the command log (see StreamOutput() and
+// PromptOnCredentialRequest()), it is additionally written to the given writer,
+// e.g. to show the progress of a command in a popup
+func (self *CmdObj) AlsoStreamOutputTo(writer io.Writer) *CmdObj {
+	self.extraOutputWriter = writer
+
+	return self
+}
+
+func (self *CmdObj) GetExtraOutputWriter() io.Writer {
+	return self.extraOutputWriter
+}
+
 // when you call this, then call Run(), we'll use a PTY to run the command. Only
 // has an effect if StreamOutput() was also called. Ignored on Windows.
 func (self *CmdObj) UsePty() *CmdObj {
@@ -228,6 +228,9 @@ func (self *cmdObjRunner) runAndStreamAux(
 	onRun func(*cmdHandler, io.Writer),
 ) error {
 	cmdWriter := self.guiIO.newCmdWriterFn()
+	if extraOutputWriter := cmdObj.GetExtraOutputWriter(); extraOutputWriter != nil {
+		cmdWriter = io.MultiWriter(cmdWriter, extraOutputWriter)
+	}
 
 	if cmdObj.ShouldLog() {
 		self.logCmdObj(cmdObj)
@@ -1,10 +1,12 @@
 package patch
 
 import (
+	"slices"
 	"strings"
 
 	"github.com/jesseduffield/generics/set"
 	"github.com/jesseduffield/lazygit/pkg/gui/style"
+	"github.com/jesseduffield/lazygit/pkg/syntax"
 	"github.com/jesseduffield/lazygit/pkg/theme"
 	"github.com/samber/lo"
 )
@@ -16,6 +18,12 @@ type patchPresenter struct {
 
 	// line indices for tagged lines (e.g. lines added to a custom patch)
 	incLineIndices *set.Set[int]
+
+	// word diff and moved-code highlighting for changed lines
+	highlights map[*PatchLine]*lineHighlight
+
+	// syntax highlighting for body lines; nil if turned off
+	syntaxTokens map[*PatchLine][]syntax.Token
 }
 
 // formats the patch as a plain string
@@ -41,21 +49,38 @@ func formatRangePlain(patch *Patch, startIdx int, endIdx int) string {
 type FormatViewOpts struct {
 	// line indices for tagged lines (e.g. lines added to a custom patch)
 	IncLineIndices *set.Set[int]
+	// if true, the words in which a changed line differs from its counterpart
+	// on the other side are emphasised
+	WordDiff bool
+	// if true, lines that were moved elsewhere in the patch rather than changed
+	// are coloured differently
+	ColorMoved bool
+	// if true, the code is syntax highlighted according to the file's extension
+	SyntaxHighlighting bool
 }
 
 // formats the patch for rendering within a view, meaning it's coloured and
 // highlights selected items
 func formatView(patch *Patch, opts FormatViewOpts) string {
+	return newViewPresenter(patch, opts).format()
+}
+
+func newViewPresenter(patch *Patch, opts FormatViewOpts) *patchPresenter {
 	includedLineIndices := opts.IncLineIndices
 	if includedLineIndices == nil {
 		includedLineIndices = set.New[int]()
 	}
-	presenter := &patchPresenter{
+	var syntaxTokens map[*PatchLine][]syntax.Token
+	if opts.SyntaxHighlighting {
+		syntaxTokens = computeSyntaxTokens(patch)
+	}
+	return &patchPresenter{
 		patch:          patch,
 		plain:          false,
 		incLineIndices: includedLineIndices,
+		highlights:     computeHighlights(patch, opts.WordDiff, opts.ColorMoved),
+		syntaxTokens:   syntaxTokens,
 	}
-	return presenter.format()
 }
 
 func (self *patchPresenter) format() string {
@@ -79,28 +104,14 @@ func (self *patchPresenter) format() string {
 	}
 
 	for _, hunk := range self.patch.hunks {
-		appendLine(
-			self.formatLineAux(
-				hunk.formatHeaderStart(),
-				style.FgCyan,
-				false,
-			) +
-				// we're splitting the line into two parts: the diff header and the context
-				// We explicitly pass 'included' as false for both because these are not part
-				// of the actual patch
-				self.formatLineAux(
-					hunk.headerContext,
-					theme.DefaultTextColor,
-					false,
-				),
-		)
+		appendLine(self.formatHunkHeader(hunk))
 
 		for _, line := range hunk.bodyLines {
 			style := self.patchLineStyle(line)
 			if line.IsChange() {
-				appendLine(self.formatLine(line.Content, style, lineIdx))
+				appendLine(self.formatChangedLine(line, style, lineIdx))
 			} else {
-				appendLine(self.formatLineAux(line.Content, style, false))
+				appendLine(self.formatContextLine(line, style))
 			}
 		}
 	}
@@ -108,6 +119,22 @@ func (self *patchPresenter) format() string {
 	return stringBuilder.String()
 }
 
+func (self *patchPresenter) formatHunkHeader(hunk *Hunk) string {
+	return self.formatLineAux(
+		hunk.formatHeaderStart(),
+		style.FgCyan,
+		false,
+	) +
+		// we're splitting the line into two parts: the diff header and the context
+		// We explicitly pass 'included' as false for both because these are not part
+		// of the actual patch
+		self.formatLineAux(
+			hunk.headerContext,
+			theme.DefaultTextColor,
+			false,
+		)
+}
+
 func (self *patchPresenter) patchLineStyle(patchLine *PatchLine) style.TextStyle {
 	switch patchLine.Kind {
 	case ADDITION:
@@ -119,10 +146,89 @@ func (self *patchPresenter) patchLineStyle(patchLine *PatchLine) style.TextStyle
 	}
 }
 
-func (self *patchPresenter) formatLine(str string, textStyle style.TextStyle, index int) string {
+func (self *patchPresenter) formatContextLine(line *PatchLine, textStyle style.TextStyle) string {
+	tokens := self.syntaxTokens[line]
+	if self.plain || tokens == nil {
+		return self.formatLineAux(line.Content, textStyle, false)
+	}
+
+	return self.formatCodeLine(line.Content, textStyle, false, tokens, nil)
+}
+
+func (self *patchPresenter) formatChangedLine(line *PatchLine, textStyle style.TextStyle, index int) string {
 	included := self.incLineIndices.Includes(index)
 
-	return self.formatLineAux(str, textStyle, included)
+	highlight := self.highlights[line]
+	tokens := self.syntaxTokens[line]
+	if self.plain || (highlight == nil && tokens == nil) {
+		return self.formatLineAux(line.Content, textStyle, included)
+	}
+
+	if highlight != nil && highlight.moved {
+		return self.formatLineAux(line.Content, self.movedLineStyle(line), included)
+	}
+
+	var changedRanges []byteRange
+	if highlight != nil {
+		changedRanges = highlight.changedRanges
+	}
+	return self.formatCodeLine(line.Content, textStyle, included, tokens, changedRanges)
+}
+
+// Formats a body line whose content is split into differently styled parts:
+// syntax tokens get their own colors, and the ranges that differ from the
+// line's counterpart (for word diffs) are shown reversed.
+func (self *patchPresenter) formatCodeLine(str string, textStyle style.TextStyle, included bool, tokens []syntax.Token, changedRanges []byteRange) string {
+	firstCharStyle := textStyle
+	if included {
+		firstCharStyle = firstCharStyle.MergeStyle(style.BgGreen)
+	}
+
+	boundaries := set.NewFromSlice([]int{1, len(str)})
+	for _, token := range tokens {
+		boundaries.Add(token.Start)
+		boundaries.Add(token.End)
+	}
+	for _, changed := range changedRanges {
+		boundaries.Add(changed.start)
+		boundaries.Add(changed.end)
+	}
+	positions := boundaries.ToSlice()
+	slices.Sort(positions)
+
+	result := &strings.Builder{}
+	result.WriteString(firstCharStyle.Sprint(str[:1]))
+	tokenIdx, changedIdx := 0, 0
+	for i := 0; i+1 < len(positions); i++ {
+		start, end := positions[i], positions[i+1]
+		if start < 1 || start >= end {
+			continue
+		}
+
+		for tokenIdx < len(tokens) && tokens[tokenIdx].End <= start {
+			tokenIdx++
+		}
+		for changedIdx < len(changedRanges) && changedRanges[changedIdx].end <= start {
+			changedIdx++
+		}
+
+		segmentStyle := textStyle
+		if tokenIdx < len(tokens) && tokens[tokenIdx].Start <= start {
+			segmentStyle = tokens[tokenIdx].Kind.Style()
+		}
+		if changedIdx < len(changedRanges) && changedRanges[changedIdx].start <= start {
+			segmentStyle = segmentStyle.SetReverse()
+		}
+		result.WriteString(segmentStyle.Sprint(str[start:end]))
+	}
+	return result.String()
+}
+
+func (self *patchPresenter) movedLineStyle(patchLine *PatchLine) style.TextStyle {
+	if patchLine.Kind == ADDITION {
+		return style.FgCyan.SetBold()
+	}
+	return style.FgMagenta.SetBold()
 }
 
 // 'selected' means you've got it highlighted with your cursor
@@ -0,0 +1,301 @@
+package patch
+
+import (
+	"strings"
+	"unicode"
+
+	"github.com/jesseduffield/lazygit/pkg/syntax"
+	"github.com/samber/lo"
+)
+
+// Mirrors git's --color-moved default: a block of moved lines is only
+// highlighted if it has at least this many alphanumeric characters, so that
+// short lines like closing braces don't light up everywhere.
+const minMovedBlockAlnumCount = 20
+
+// Above this, computing the longest common subsequence of two lines' tokens
+// gets too expensive, and we just compare common prefixes and suffixes.
+const maxTokenPairsForLCS = 40000
+
+type lineHighlight struct {
+	// the line's content also appears on the other side of the patch
+	moved bool
+	// byte ranges within the line's content (which includes the leading '+' or
+	// '-') that differ from the line it was paired with
+	changedRanges []byteRange
+}
+
+type byteRange struct {
+	start int
+	end   int // exclusive
+}
+
+type token struct {
+	text  string
+	start int
+}
+
+func computeHighlights(patch *Patch, wordDiff bool, colorMoved bool) map[*PatchLine]*lineHighlight {
+	highlights := map[*PatchLine]*lineHighlight{}
+	get := func(line *PatchLine) *lineHighlight {
+		if _, ok := highlights[line]; !ok {
+			highlights[line] = &lineHighlight{}
+		}
+		return highlights[line]
+	}
+
+	if colorMoved {
+		for _, line := range movedLines(patch) {
+			get(line).moved = true
+		}
+	}
+
+	if wordDiff {
+		for _, hunk := range patch.hunks {
+			for _, pair := range changedLinePairs(hunk.bodyLines) {
+				deletion, addition := pair[0], pair[1]
+				if highlights[deletion] != nil && highlights[deletion].moved ||
+					highlights[addition] != nil && highlights[addition].moved {
+					continue
+				}
+
+				deletionRanges, additionRanges, ok := changedTokenRanges(deletion.Content, addition.Content)
+				if !ok {
+					continue
+				}
+				get(deletion).changedRanges = deletionRanges
+				get(addition).changedRanges = additionRanges
+			}
+		}
+	}
+
+	return highlights
+}
+
+// Returns the syntax highlighted parts of the patch's body lines, with offsets
+// into the lines' content (which includes the leading '+', '-' or ' '). Returns
+// nil if we don't know the language of the patched file.
+func computeSyntaxTokens(patch *Patch) map[*PatchLine][]syntax.Token {
+	language := syntax.LanguageForPath(patch.filePath())
+	if language == nil {
+		return nil
+	}
+
+	tokens := map[*PatchLine][]syntax.Token{}
+	for _, hunk := range patch.hunks {
+		// we can't know whether a hunk starts inside a comment or string
+		var oldState, newState syntax.State
+		for _, line := range hunk.bodyLines {
+			if line.Content == "" {
+				continue
+			}
+
+			var lineTokens []syntax.Token
+			switch line.Kind {
+			case ADDITION:
+				lineTokens = language.Tokenize(line.Content[1:], &newState)
+			case DELETION:
+				lineTokens = language.Tokenize(line.Content[1:], &oldState)
+			case CONTEXT:
+				language.Tokenize(line.Content[1:], &oldState)
+				lineTokens = language.Tokenize(line.Content[1:], &newState)
+			}
+
+			tokens[line] = lo.Map(lineTokens, func(token syntax.Token, _ int) syntax.Token {
+				return syntax.Token{Kind: token.Kind, Start: token.Start + 1, End: token.End + 1}
+			})
+		}
+	}
+	return tokens
+}
+
+// Returns the changed lines whose content also appears on the other side of
+// the patch, grouped into blocks the way git does it.
+func movedLines(patch *Patch) []*PatchLine {
+	contents := map[PatchLineKind]map[string]bool{
+		ADDITION: {},
+		DELETION: {},
+	}
+	for _, hunk := range patch.hunks {
+		for _, line := range hunk.bodyLines {
+			if line.IsChange() {
+				contents[line.Kind][line.Content[1:]] = true
+			}
+		}
+	}
+
+	otherKind := func(kind PatchLineKind) PatchLineKind {
+		return lo.Ternary(kind == ADDITION, DELETION, ADDITION)
+	}
+
+	result := []*PatchLine{}
+	for _, hunk := range patch.hunks {
+		block := []*PatchLine{}
+		flush := func() {
+			alnumCount := lo.SumBy(block, func(line *PatchLine) int {
+				return lo.CountBy([]rune(line.Content[1:]), isWordRune)
+			})
+			if alnumCount >= minMovedBlockAlnumCount {
+				result = append(result, block...)
+			}
+			block = []*PatchLine{}
+		}
+
+		for _, line := range hunk.bodyLines {
+			isCandidate := line.IsChange() &&
+				strings.TrimSpace(line.Content[1:]) != "" &&
+				contents[otherKind(line.Kind)][line.Content[1:]]
+			if !isCandidate || (len(block) > 0 && block[0].Kind != line.Kind) {
+				flush()
+			}
+			if isCandidate {
+				block = append(block, line)
+			}
+		}
+		flush()
+	}
+
+	return result
+}
+
+// Pairs up each run of deleted lines with the run of added lines directly
+// following it, line by line.
+func changedLinePairs(lines []*PatchLine) [][2]*PatchLine {
+	result := [][2]*PatchLine{}
+	for i := 0; i < len(lines); {
+		deletionsStart := i
+		for i < len(lines) && lines[i].Kind == DELETION {
+			i++
+		}
+		additionsStart := i
+		for i < len(lines) && lines[i].Kind == ADDITION {
+			i++
+		}
+
+		deletions := lines[deletionsStart:additionsStart]
+		additions := lines[additionsStart:i]
+		for j := range min(len(deletions), len(additions)) {
+			result = append(result, [2]*PatchLine{deletions[j], additions[j]})
+		}
+
+		if i == deletionsStart {
+			i++
+		}
+	}
+	return result
+}
+
+// Compares the two lines word by word, returning which byte ranges of each of
+// them differ. Returns false if the lines have nothing but whitespace in
+// common, in which case highlighting the difference wouldn't add anything.
+func changedTokenRanges(oldContent string, newContent string) ([]byteRange, []byteRange, bool) {
+	oldTokens := tokenize(oldContent[1:], 1)
+	newTokens := tokenize(newContent[1:], 1)
+
+	oldCommon, newCommon := commonTokens(oldTokens, newTokens)
+
+	hasCommonWord := false
+	for i, isCommon := range oldCommon {
+		if isCommon && strings.TrimSpace(oldTokens[i].text) != "" {
+			hasCommonWord = true
+			break
+		}
+	}
+	if !hasCommonWord {
+		return nil, nil, false
+	}
+
+	return uncommonRanges(oldTokens, oldCommon), uncommonRanges(newTokens, newCommon), true
+}
+
+// Splits the string into runs of word characters, runs of whitespace, and
+// single other characters. Offsets are shifted by the given amount.
+func tokenize(str string, offset int) []token {
+	tokens := []token{}
+	runes := []rune(str)
+	byteIdx := 0
+	for i := 0; i < len(runes); {
+		j := i + 1
+		switch {
+		case isWordRune(runes[i]):
+			for j < len(runes) && isWordRune(runes[j]) {
+				j++
+			}
+		case unicode.IsSpace(runes[i]):
+			for j < len(runes) && unicode.IsSpace(runes[j]) {
+				j++
+			}
+		}
+		text := string(runes[i:j])
+		tokens = append(tokens, token{text: text, start: offset + byteIdx})
+		byteIdx += len(text)
+		i = j
+	}
+	return tokens
+}
+
+func isWordRune(r rune) bool {
+	return r == '_' || unicode.IsLetter(r) || unicode.IsDigit(r)
+}
+
+// Returns for each token of the two slices whether it is part of their longest
+// common subsequence.
+func commonTokens(a []token, b []token) ([]bool, []bool) {
+	aCommon := make([]bool, len(a))
+	bCommon := make([]bool, len(b))
+
+	if len(a)*len(b) > maxTokenPairsForLCS {
+		for i := 0; i < len(a) && i < len(b) && a[i].text == b[i].text; i++ {
+			aCommon[i], bCommon[i] = true, true
+		}
+		for i, j := len(a)-1, len(b)-1; i >= 0 && j >= 0 && !aCommon[i] && !bCommon[j] && a[i].text == b[j].text; i, j = i-1, j-1 {
+			aCommon[i], bCommon[j] = true, true
+		}
+		return aCommon, bCommon
+	}
+
+	// lengths[i][j] is the length of the LCS of a[i:] and b[j:]
+	lengths := make([][]int, len(a)+1)
+	for i := range lengths {
+		lengths[i] = make([]int, len(b)+1)
+	}
+	for i := len(a) - 1; i >= 0; i-- {
+		for j := len(b) - 1; j >= 0; j-- {
+			if a[i].text == b[j].text {
+				lengths[i][j] = lengths[i+1][j+1] + 1
+			} else {
+				lengths[i][j] = max(lengths[i+1][j], lengths[i][j+1])
+			}
+		}
+	}
+
+	for i, j := 0, 0; i < len(a) && j < len(b); {
+		switch {
+		case a[i].text == b[j].text:
+			aCommon[i], bCommon[j] = true, true
+			i++
+			j++
+		case lengths[i+1][j] >= lengths[i][j+1]:
+			i++
+		default:
+			j++
+		}
+	}
+	return aCommon, bCommon
+}
+
+func uncommonRanges(tokens []token, common []bool) []byteRange {
+	ranges := []byteRange{}
+	for i, tok := range tokens {
+		if common[i] {
+			continue
+		}
+		end := tok.start + len(tok.text)
+		if len(ranges) > 0 && ranges[len(ranges)-1].end == tok.start {
+			ranges[len(ranges)-1].end = end
+		} else {
+			ranges = append(ranges, byteRange{start: tok.start, end: end})
+		}
+	}
+	return ranges
+}
@@ -1,6 +1,8 @@
 package patch
 
 import (
+	"strings"
+
 	"github.com/samber/lo"
 )
 
@@ -37,6 +39,34 @@ func (self *Patch) FormatView(opts FormatViewOpts) string {
 	return formatView(self, opts)
 }
 
+// Returns the old and the new side of the patch, formatted for displaying next
+// to each other in a view (see formatViewSideBySide)
+func (self *Patch) FormatViewSideBySide(opts FormatViewOpts, alignChanges bool) ([]string, []string) {
+	return formatViewSideBySide(self, opts, alignChanges)
+}
+
+// Returns the layout of the rows of FormatViewSideBySide, i.e. which patch
+// lines are shown in each row
+func (self *Patch) SideBySideRows(alignChanges bool) []SideBySideRow {
+	return sideBySideRows(self, alignChanges)
+}
+
+// Returns the path of the patched file as given in the header (i.e. including
+// git's "a/" or "b/" prefix), or "" if the header doesn't have it
+func (self *Patch) filePath() string {
+	path := ""
+	for _, line := range self.header {
+		if rest, ok := strings.CutPrefix(line, "+++ "); ok && rest != "/dev/null" {
+			path = rest
+			break
+		}
+		if rest, ok := strings.CutPrefix(line, "--- "); ok && rest != "/dev/null" {
+			path = rest
+		}
+	}
+	return strings.Trim(path, "\"\t")
+}
+
 // Returns the lines of the patch
 func (self *Patch) Lines() []*PatchLine {
 	lines := []*PatchLine{}
@@ -80,15 +110,27 @@ func (self *Patch) ContainsChanges() bool {
 // If the line is a hunk header line, returns the first file line number in that hunk.
 // If the line is out of range below, returns the last file line number in the last hunk.
 func (self *Patch) LineNumberOfLine(idx int) int {
+	return self.lineNumberOfLine(idx, false)
+}
+
+// Same as LineNumberOfLine, but returns the line number in the old file.
+func (self *Patch) OldLineNumberOfLine(idx int) int {
+	return self.lineNumberOfLine(idx, true)
+}
+
+func (self *Patch) lineNumberOfLine(idx int, old bool) int {
 	if idx < len(self.header) || len(self.hunks) == 0 {
 		return 1
 	}
 
+	start := func(hunk *Hunk) int { return lo.Ternary(old, hunk.oldStart, hunk.newStart) }
+	kinds := lo.Ternary(old, []PatchLineKind{DELETION, CONTEXT}, []PatchLineKind{ADDITION, CONTEXT})
+
 	hunkIdx := self.HunkContainingLine(idx)
 	// cursor out of range, return last file line number
 	if hunkIdx == -1 {
 		lastHunk := self.hunks[len(self.hunks)-1]
-		return lastHunk.newStart + lastHunk.newLength() - 1
+		return start(lastHunk) + nLinesWithKind(lastHunk.bodyLines, kinds) - 1
 	}
 
 	hunk := self.hunks[hunkIdx]
@@ -96,12 +138,12 @@ func (self *Patch) LineNumberOfLine(idx int) int {
 	idxInHunk := idx - hunkStartIdx
 
 	if idxInHunk == 0 {
-		return hunk.newStart
+		return start(hunk)
 	}
 
 	lines := hunk.bodyLines[:idxInHunk-1]
-	offset := nLinesWithKind(lines, []PatchLineKind{ADDITION, CONTEXT})
-	return hunk.newStart + offset
+	offset := nLinesWithKind(lines, kinds)
+	return start(hunk) + offset
 }
 
 // Returns hunk index containing the line at the given patch line index
@@ -1,9 +1,16 @@
 package patch
 
 import (
+	"strings"
 	"testing"
 
+	"github.com/gookit/color"
+	"github.com/jesseduffield/lazygit/pkg/gui/style"
+	"github.com/jesseduffield/lazygit/pkg/theme"
+	"github.com/jesseduffield/lazygit/pkg/utils"
+	"github.com/samber/lo"
 	"github.com/stretchr/testify/assert"
+	"github.com/xo/terminfo"
 )
 
 const simpleDiff = `diff --git a/filename b/filename
@@ -627,6 +634,34 @@ func TestLineNumberOfLine(t *testing.T) {
 	}
 }
 
+func TestOldLineNumberOfLine(t *testing.T) {
+	type scenario struct {
+		testName  string
+		patchStr  string
+		indexes   []int
+		expecteds []int
+	}
+
+	scenarios := []scenario{
+		{
+			testName:  "twoHunks",
+			patchStr:  twoHunks,
+			indexes:   []int{0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16, 17, 18, 19, 20, 1000},
+			expecteds: []int{1, 1, 1, 1, 1, 1, 2, 3, 3, 4, 5, 8, 8, 9, 10, 11, 11, 11, 12, 13, 13, 13},
+		},
+	}
+
+	for _, s := range scenarios {
+		t.Run(s.testName, func(t *testing.T) {
+			for i, idx := range s.indexes {
+				patch := Parse(s.patchStr)
+				result := patch.OldLineNumberOfLine(idx)
+				assert.Equal(t, s.expecteds[i], result)
+			}
+		})
+	}
+}
+
 func TestGetNextStageableLineIndex(t *testing.T) {
 	type scenario struct {
 		testName  string
@@ -771,3 +806,206 @@ func TestIsSingleHunkForWholeFile(t *testing.T) {
 		})
 	}
 }
+
+const movedAndChangedLines = `diff --git a/filename b/filename
+index dcd3485..1ba5540 100644
+--- a/filename
++++ b/filename
+@@ -1,6 +1,6 @@
+-func moveThisFunctionDown() {
+-}
+ fruit := "apple"
+-fmt.Println(fruit, 1)
++fmt.Println(fruit, 2)
+ ...
++func moveThisFunctionDown() {
++}
+ }
+`
+
+func TestComputeHighlights(t *testing.T) {
+	type expectedHighlight struct {
+		content       string
+		moved         bool
+		changedRanges []byteRange
+	}
+
+	scenarios := []struct {
+		testName   string
+		patchStr   string
+		wordDiff   bool
+		colorMoved bool
+		expected   []expectedHighlight
+	}{
+		{
+			testName:   "nothing enabled",
+			patchStr:   movedAndChangedLines,
+			wordDiff:   false,
+			colorMoved: false,
+			expected:   []expectedHighlight{},
+		},
+		{
+			testName:   "word diff",
+			patchStr:   movedAndChangedLines,
+			wordDiff:   true,
+			colorMoved: false,
+			expected: []expectedHighlight{
+				{content: "-fmt.Println(fruit, 1)", changedRanges: []byteRange{{start: 20, end: 21}}},
+				{content: "+fmt.Println(fruit, 2)", changedRanges: []byteRange{{start: 20, end: 21}}},
+			},
+		},
+		{
+			testName:   "moved lines",
+			patchStr:   movedAndChangedLines,
+			wordDiff:   false,
+			colorMoved: true,
+			expected: []expectedHighlight{
+				{content: "-func moveThisFunctionDown() {", moved: true},
+				{content: "-}", moved: true},
+				{content: "+func moveThisFunctionDown() {", moved: true},
+				{content: "+}", moved: true},
+			},
+		},
+		{
+			testName:   "lines with nothing in common are not word-diffed",
+			patchStr:   simpleDiff,
+			wordDiff:   true,
+			colorMoved: true,
+			expected:   []expectedHighlight{},
+		},
+	}
+
+	for _, s := range scenarios {
+		t.Run(s.testName, func(t *testing.T) {
+			patch := Parse(s.patchStr)
+			highlights := computeHighlights(patch, s.wordDiff, s.colorMoved)
+
+			actual := []expectedHighlight{}
+			for _, line := range patch.Lines() {
+				if highlight, ok := highlights[line]; ok {
+					actual = append(actual, expectedHighlight{
+						content:       line.Content,
+						moved:         highlight.moved,
+						changedRanges: highlight.changedRanges,
+					})
+				}
+			}
+			assert.Equal(t, s.expected, actual)
+		})
+	}
+}
+
+func TestFormatViewSideBySide(t *testing.T) {
+	const patchStr = `diff --git a/filename b/filename
+index dcd3485..1ba5540 100644
+--- a/filename
++++ b/filename
+@@ -1,4 +1,4 @@
+ apple
+-orange
+-banana
++grape
+ pear
++kiwi
+`
+	withHeader := func(lines ...string) []string {
+		return append([]string{
+			"diff --git a/filename b/filename",
+			"index dcd3485..1ba5540 100644",
+			"--- a/filename",
+			"+++ b/filename",
+			"@@ -1,4 +1,4 @@",
+		}, lines...)
+	}
+
+	scenarios := []struct {
+		testName     string
+		alignChanges bool
+		expectedOld  []string
+		expectedNew  []string
+		expectedRows []SideBySideRow
+	}{
+		{
+			testName:     "changes aligned",
+			alignChanges: true,
+			expectedOld:  withHeader(" apple", "-orange", "-banana", " pear", ""),
+			expectedNew:  withHeader(" apple", "+grape", "", " pear", "+kiwi"),
+			expectedRows: []SideBySideRow{{0, 0}, {1, 1}, {2, 2}, {3, 3}, {4, 4}, {5, 5}, {6, 8}, {7, -1}, {9, 9}, {-1, 10}},
+		},
+		{
+			testName:     "one line per patch line",
+			alignChanges: false,
+			expectedOld:  withHeader(" apple", "-orange", "-banana", "", " pear", ""),
+			expectedNew:  withHeader(" apple", "", "", "+grape", " pear", "+kiwi"),
+			expectedRows: []SideBySideRow{{0, 0}, {1, 1}, {2, 2}, {3, 3}, {4, 4}, {5, 5}, {6, -1}, {7, -1}, {-1, 8}, {9, 9}, {-1, 10}},
+		},
+	}
+
+	for _, s := range scenarios {
+		t.Run(s.testName, func(t *testing.T) {
+			oldLines, newLines := Parse(patchStr).FormatViewSideBySide(FormatViewOpts{}, s.alignChanges)
+			assert.Equal(t, s.expectedRows, Parse(patchStr).SideBySideRows(s.alignChanges))
+			assert.Equal(t, s.expectedOld, lo.Map(oldLines, func(line string, _ int) string { return utils.Decolorise(line) }))
+			assert.Equal(t, s.expectedNew, lo.Map(newLines, func(line string, _ int) string { return utils.Decolorise(line) }))
+		})
+	}
+}
+
+func TestFormatViewSyntaxHighlighting(t *testing.T) {
+	oldColorLevel := color.ForceSetColorLevel(terminfo.ColorLevelMillions)
+	defer color.ForceSetColorLevel(oldColorLevel)
+
+	goPatch := strings.ReplaceAll(movedAndChangedLines, "filename", "main.go")
+
+	scenarios := []struct {
+		testName string
+		patchStr string
+		wordDiff bool
+		// the formatted lines for the "fruit" lines of the patch
+		expected []string
+	}{
+		{
+			testName: "syntax highlighting",
+			patchStr: goPatch,
+			wordDiff: false,
+			expected: []string{
+				theme.DefaultTextColor.Sprint(" ") + theme.DefaultTextColor.Sprint("fruit := ") + theme.SyntaxStringColor.Sprint(`"apple"`),
+				style.FgRed.Sprint("-") + style.FgRed.Sprint("fmt.Println(fruit, ") + theme.SyntaxNumberColor.Sprint("1") + style.FgRed.Sprint(")"),
+				style.FgGreen.Sprint("+") + style.FgGreen.Sprint("fmt.Println(fruit, ") + theme.SyntaxNumberColor.Sprint("2") + style.FgGreen.Sprint(")"),
+			},
+		},
+		{
+			testName: "syntax highlighting combined with word diff",
+			patchStr: goPatch,
+			wordDiff: true,
+			expected: []string{
+				theme.DefaultTextColor.Sprint(" ") + theme.DefaultTextColor.Sprint("fruit := ") + theme.SyntaxStringColor.Sprint(`"apple"`),
+				style.FgRed.Sprint("-") + style.FgRed.Sprint("fmt.Println(fruit, ") + theme.SyntaxNumberColor.SetReverse().Sprint("1") + style.FgRed.Sprint(")"),
+				style.FgGreen.Sprint("+") + style.FgGreen.Sprint("fmt.Println(fruit, ") + theme.SyntaxNumberColor.SetReverse().Sprint("2") + style.FgGreen.Sprint(")"),
+			},
+		},
+		{
+			testName: "unknown file type",
+			patchStr: movedAndChangedLines,
+			wordDiff: false,
+			expected: []string{
+				theme.DefaultTextColor.Sprint(" ") + theme.DefaultTextColor.Sprint(`fruit := "apple"`),
+				style.FgRed.Sprint("-") + style.FgRed.Sprint("fmt.Println(fruit, 1)"),
+				style.FgGreen.Sprint("+") + style.FgGreen.Sprint("fmt.Println(fruit, 2)"),
+			},
+		},
+	}
+
+	for _, s := range scenarios {
+		t.Run(s.testName, func(t *testing.T) {
+			formatted := Parse(s.patchStr).FormatView(FormatViewOpts{
+				WordDiff:           s.wordDiff,
+				SyntaxHighlighting: true,
+			})
+			lines := lo.Filter(strings.Split(formatted, "\n"), func(line string, _ int) bool {
+				return strings.Contains(line, "fruit")
+			})
+			assert.Equal(t, s.expected, lines)
+		})
+	}
+}
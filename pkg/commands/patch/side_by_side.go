@@ -0,0 +1,116 @@
+package patch
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/theme"
+	"github.com/samber/lo"
+)
+
+// formats the patch for rendering the old and the new version of the file next
+// to each other. Returns the lines of both sides, which always have the same
+// length so that each line of one side is aligned with the corresponding line
+// of the other. Header lines appear on both sides.
+//
+// If alignChanges is true, the deleted lines of each block of changes are shown
+// next to the added lines replacing them. Otherwise each changed line gets a
+// line of its own (with the other side left blank), so that the lines of the
+// result correspond one-to-one to the lines of the patch.
+func formatViewSideBySide(patch *Patch, opts FormatViewOpts, alignChanges bool) ([]string, []string) {
+	if !patch.ContainsChanges() {
+		return []string{}, []string{}
+	}
+
+	presenter := newViewPresenter(patch, opts)
+	lines := patch.Lines()
+	formatLine := func(lineIdx int) string {
+		if lineIdx == -1 {
+			return ""
+		}
+
+		line := lines[lineIdx]
+		switch {
+		case lineIdx < len(patch.header):
+			return presenter.formatLineAux(line.Content, theme.DefaultTextColor.SetBold(), false)
+		case line.Kind == HUNK_HEADER:
+			return presenter.formatHunkHeader(patch.hunks[patch.HunkContainingLine(lineIdx)])
+		case line.IsChange():
+			return presenter.formatChangedLine(line, presenter.patchLineStyle(line), lineIdx)
+		default:
+			return presenter.formatContextLine(line, presenter.patchLineStyle(line))
+		}
+	}
+
+	rows := sideBySideRows(patch, alignChanges)
+	oldLines := make([]string, 0, len(rows))
+	newLines := make([]string, 0, len(rows))
+	for _, row := range rows {
+		if row.Old == row.New {
+			formatted := formatLine(row.Old)
+			oldLines = append(oldLines, formatted)
+			newLines = append(newLines, formatted)
+			continue
+		}
+
+		oldLines = append(oldLines, formatLine(row.Old))
+		newLines = append(newLines, formatLine(row.New))
+	}
+
+	return oldLines, newLines
+}
+
+// A row of the side-by-side view, given as the indices of the patch lines shown
+// on its old and new side. Unchanged lines appear on both sides, so Old and New
+// are the same; a side that is left blank has the index -1.
+type SideBySideRow struct {
+	Old int
+	New int
+}
+
+// Returns the indices of the first and last patch line shown in the row
+func (self SideBySideRow) PatchLineRange() (int, int) {
+	if self.Old == -1 {
+		return self.New, self.New
+	}
+	if self.New == -1 {
+		return self.Old, self.Old
+	}
+	return min(self.Old, self.New), max(self.Old, self.New)
+}
+
+func sideBySideRows(patch *Patch, alignChanges bool) []SideBySideRow {
+	rows := []SideBySideRow{}
+	lines := patch.Lines()
+	for i := 0; i < len(lines); {
+		if !lines[i].IsChange() {
+			rows = append(rows, SideBySideRow{Old: i, New: i})
+			i++
+			continue
+		}
+
+		if !alignChanges {
+			rows = append(rows, lo.Ternary(lines[i].Kind == DELETION, SideBySideRow{Old: i, New: -1}, SideBySideRow{Old: -1, New: i}))
+			i++
+			continue
+		}
+
+		deletions := []int{}
+		for ; i < len(lines) && lines[i].Kind == DELETION; i++ {
+			deletions = append(deletions, i)
+		}
+		additions := []int{}
+		for ; i < len(lines) && lines[i].Kind == ADDITION; i++ {
+			additions = append(additions, i)
+		}
+		for j := range max(len(deletions), len(additions)) {
+			rows = append(rows, SideBySideRow{Old: indexAt(deletions, j), New: indexAt(additions, j)})
+		}
+	}
+
+	return rows
+}
+
+func indexAt(indices []int, idx int) int {
+	if idx < len(indices) {
+		return indices[idx]
+	}
+	return -1
+}
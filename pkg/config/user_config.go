@@ -28,6 +28,12 @@ type UserConfig struct {
 	CustomCommands []CustomCommand `yaml:"customCommands" jsonschema:"uniqueItems=true"`
 	// See https://github.com/jesseduffield/lazygit/blob/master/docs/Config.md#custom-pull-request-urls
 	Services map[string]string `yaml:"services"`
+	// Config for talking to the APIs of git hosting services (GitHub, GitLab and Gitea)
+	// See https://github.com/jesseduffield/lazygit/blob/master/docs/Config.md#hosting-service-apis
+	HostingServiceAPI HostingServiceAPIConfig `yaml:"hostingServiceAPI"`
+	// Rules for linking references to issues (e.g. Jira keys like 'PROJ-1234') in commit messages and branch names to an issue tracker
+	// See https://github.com/jesseduffield/lazygit/blob/master/docs/Config.md#issue-tracker-links
+	IssueLinks []IssueLinkConfig `yaml:"issueLinks"`
 	// What to do when opening Lazygit outside of a git repo.
 	// - 'prompt': (default) ask whether to initialize a new repo or open in the most recent repo
 	// - 'create': initialize a new repo
@@ -157,6 +163,9 @@ type GuiConfig struct {
 	// Whether to show the divergence from the base branch in the branches view.
 	// One of: 'none' | 'onlyArrow'  | 'arrowAndNumber'
 	ShowDivergenceFromBaseBranch string `yaml:"showDivergenceFromBaseBranch" jsonschema:"enum=none,enum=onlyArrow,enum=arrowAndNumber"`
+	// If true, show in the branches view how many files would conflict when
+	// merging each branch into its base branch. Requires git 2.38 or later.
+	ShowConflictsWithBaseBranch bool `yaml:"showConflictsWithBaseBranch"`
 	// Height of the command log view
 	CommandLogSize int `yaml:"commandLogSize" jsonschema:"minimum=0"`
 	// Whether to split the main window when viewing file changes.
@@ -220,6 +229,14 @@ type ThemeConfig struct {
 	UnstagedChangesColor []string `yaml:"unstagedChangesColor" jsonschema:"minItems=1,uniqueItems=true"`
 	// Default text color
 	DefaultFgColor []string `yaml:"defaultFgColor" jsonschema:"minItems=1,uniqueItems=true"`
+	// Color of keywords when syntax highlighting diffs (see git.syntaxHighlightingInDiffView)
+	SyntaxKeywordColor []string `yaml:"syntaxKeywordColor" jsonschema:"minItems=1,uniqueItems=true"`
+	// Color of string literals when syntax highlighting diffs
+	SyntaxStringColor []string `yaml:"syntaxStringColor" jsonschema:"minItems=1,uniqueItems=true"`
+	// Color of comments when syntax highlighting diffs
+	SyntaxCommentColor []string `yaml:"syntaxCommentColor" jsonschema:"minItems=1,uniqueItems=true"`
+	// Color of number literals when syntax highlighting diffs
+	SyntaxNumberColor []string `yaml:"syntaxNumberColor" jsonschema:"minItems=1,uniqueItems=true"`
 }
 
 type CommitLengthConfig struct {
@@ -284,10 +301,23 @@ type GitConfig struct {
 	AllBranchesLogCmds []string `yaml:"allBranchesLogCmds"`
 	// If true, git diffs are rendered with the `--ignore-all-space` flag, which ignores whitespace changes. Can be toggled from within Lazygit with `<c-w>`.
 	IgnoreWhitespaceInDiffView bool `yaml:"ignoreWhitespaceInDiffView"`
+	// If true, diffs highlight the words that changed within a line (using `--word-diff=color` in the main view). Can be toggled from within Lazygit in the diffing menu.
+	WordDiffInDiffView bool `yaml:"wordDiffInDiffView"`
+	// If true, diffs show lines that were moved rather than changed in different colors (using `--color-moved` in the main view). Can be toggled from within Lazygit in the diffing menu.
+	ColorMovedInDiffView bool `yaml:"colorMovedInDiffView"`
+	// If true, the diff of a single file is shown with its old and new version next to each other. In the staging and patch building views, where every line of the diff must be selectable, changed lines then get a row of their own instead of being lined up with the lines replacing them. Can be toggled from within Lazygit in the diffing menu.
+	SideBySideDiffInDiffView bool `yaml:"sideBySideDiffInDiffView"`
+	// If true, the code in diffs is syntax highlighted, based on the file extension and using the colors from gui.theme. This is done by Lazygit itself, so it also works in the staging and patch building views, but not when using a pager. In the main view it is skipped while word diff or moved line highlighting is on, because git's own coloring is needed for those. Can be toggled from within Lazygit in the diffing menu.
+	SyntaxHighlightingInDiffView bool `yaml:"syntaxHighlightingInDiffView"`
 	// The number of lines of context to show around each diff hunk. Can be changed from within Lazygit with the `{` and `}` keys.
 	DiffContextSize uint64 `yaml:"diffContextSize"`
 	// The threshold for considering a file to be renamed, in percent. Can be changed from within Lazygit with the `(` and `)` keys.
 	RenameSimilarityThreshold int `yaml:"renameSimilarityThreshold" jsonschema:"minimum=0,maximum=100"`
+	// The algorithm git uses to compute diffs. 'default' uses whatever is configured in git's `diff.algorithm` setting. Can be changed from within Lazygit with the diff options menu (`<c-g>`).
+	// One of: 'default' | 'myers' | 'minimal' | 'patience' | 'histogram'
+	DiffAlgorithm string `yaml:"diffAlgorithm" jsonschema:"enum=default,enum=myers,enum=minimal,enum=patience,enum=histogram"`
+	// If true, diffs detect files that were copied from another file, in addition to renames (using `--find-copies`). Can be toggled from within Lazygit with the diff options menu (`<c-g>`).
+	FindCopiesInDiffView bool `yaml:"findCopiesInDiffView"`
 	// If true, do not spawn a separate process when using GPG
 	OverrideGpg bool `yaml:"overrideGpg"`
 	// If true, do not allow force pushes
@@ -313,6 +343,8 @@ type GitConfig struct {
 	RemoteBranchSortOrder string `yaml:"remoteBranchSortOrder" jsonschema:"enum=date,enum=alphabetical"`
 	// When copying commit hashes to the clipboard, truncate them to this length. Set to 40 to disable truncation.
 	TruncateCopiedCommitHashesTo int `yaml:"truncateCopiedCommitHashesTo"`
+	// The notes ref to read and write commit notes from, e.g. 'refs/notes/review'. If empty, git's default is used (refs/notes/commits, or the value of core.notesRef)
+	NotesRef string `yaml:"notesRef"`
 }
 
 type PagerType string
@@ -388,6 +420,27 @@ type UpdateConfig struct {
 	Days int64 `yaml:"days" jsonschema:"minimum=0"`
 }
 
+type HostingServiceAPIConfig struct {
+	// If true, look up the pull request of each local branch and show its number, state and review status in the branches panel.
+	// The pull requests are refreshed whenever we fetch.
+	ShowPullRequests bool `yaml:"showPullRequests"`
+	// If true, show whether the CI checks of commits (in the commits panel) and branches (in the branches panel) succeeded, failed, or are still running. This only works for commits that exist on origin.
+	// The status is only loaded for the rows that are visible, and cached per commit until we fetch again. After failing to reach the hosting service, we stop trying until the next successful fetch.
+	ShowCheckStatus bool `yaml:"showCheckStatus"`
+	// Access tokens per web domain, e.g. 'github.com': '<token>'. If there is no token for a domain, the GITHUB_TOKEN, GITLAB_TOKEN or GITEA_TOKEN environment variable is used, depending on the provider.
+	Tokens map[string]string `yaml:"tokens"`
+	// Base URLs of the APIs per web domain, for when they differ from the provider's default. e.g. 'github.work.com': 'https://github.work.com/api/v3'
+	BaseURLs map[string]string `yaml:"baseURLs"`
+}
+
+type IssueLinkConfig struct {
+	// Regular expression matching a reference to an issue, e.g. '\bPROJ-\d+\b'
+	Pattern string `yaml:"pattern" jsonschema:"example=\\b[A-Z]+-\\d+\\b"`
+	// URL of the issue. '{{.match}}' is replaced by the matched text, and '{{.<name>}}' by the text of the named capture group '(?P<name>...)'.
+	// e.g. 'https://jira.example.com/browse/{{.match}}'
+	URLTemplate string `yaml:"urlTemplate"`
+}
+
 type KeybindingConfig struct {
 	Universal      KeybindingUniversalConfig      `yaml:"universal"`
 	Status         KeybindingStatusConfig         `yaml:"status"`
@@ -439,6 +492,7 @@ type KeybindingUniversalConfig struct {
 	StartSearch                       string   `yaml:"startSearch"`
 	OptionMenu                        string   `yaml:"optionMenu"`
 	OptionMenuAlt1                    string   `yaml:"optionMenu-alt1"`
+	OpenCommandPalette                string   `yaml:"openCommandPalette"`
 	Select                            string   `yaml:"select"`
 	GoInto                            string   `yaml:"goInto"`
 	Confirm                           string   `yaml:"confirm"`
@@ -481,13 +535,15 @@ type KeybindingUniversalConfig struct {
 	DecreaseContextInDiffView         string   `yaml:"decreaseContextInDiffView"`
 	IncreaseRenameSimilarityThreshold string   `yaml:"increaseRenameSimilarityThreshold"`
 	DecreaseRenameSimilarityThreshold string   `yaml:"decreaseRenameSimilarityThreshold"`
+	DiffOptionsMenu                   string   `yaml:"diffOptionsMenu"`
 	OpenDiffTool                      string   `yaml:"openDiffTool"`
 }
 
 type KeybindingStatusConfig struct {
-	CheckForUpdate      string `yaml:"checkForUpdate"`
-	RecentRepos         string `yaml:"recentRepos"`
-	AllBranchesLogGraph string `yaml:"allBranchesLogGraph"`
+	CheckForUpdate        string `yaml:"checkForUpdate"`
+	RecentRepos           string `yaml:"recentRepos"`
+	AllBranchesLogGraph   string `yaml:"allBranchesLogGraph"`
+	SparseCheckoutOptions string `yaml:"sparseCheckoutOptions"`
 }
 
 type KeybindingFilesConfig struct {
@@ -510,11 +566,17 @@ type KeybindingFilesConfig struct {
 	CopyFileInfoToClipboard  string `yaml:"copyFileInfoToClipboard"`
 	CollapseAll              string `yaml:"collapseAll"`
 	ExpandAll                string `yaml:"expandAll"`
+	OpenBlame                string `yaml:"openBlame"`
+	ToggleSparseCheckoutDir  string `yaml:"toggleSparseCheckoutDir"`
+	ViewLfsLockOptions       string `yaml:"viewLfsLockOptions"`
+	ReviewHunks              string `yaml:"reviewHunks"`
 }
 
 type KeybindingBranchesConfig struct {
 	CreatePullRequest      string `yaml:"createPullRequest"`
 	ViewPullRequestOptions string `yaml:"viewPullRequestOptions"`
+	ViewCheckStatus        string `yaml:"viewCheckStatus"`
+	OpenIssueLink          string `yaml:"openIssueLink"`
 	CopyPullRequestURL     string `yaml:"copyPullRequestURL"`
 	CheckoutBranchByName   string `yaml:"checkoutBranchByName"`
 	ForceCheckoutBranch    string `yaml:"forceCheckoutBranch"`
@@ -562,6 +624,11 @@ type KeybindingCommitsConfig struct {
 	ViewBisectOptions              string `yaml:"viewBisectOptions"`
 	StartInteractiveRebase         string `yaml:"startInteractiveRebase"`
 	SelectCommitsOfCurrentBranch   string `yaml:"selectCommitsOfCurrentBranch"`
+	ViewNoteOptions                string `yaml:"viewNoteOptions"`
+	ViewPatchFileOptions           string `yaml:"viewPatchFileOptions"`
+	SplitCommit                    string `yaml:"splitCommit"`
+	ViewCheckStatus                string `yaml:"viewCheckStatus"`
+	OpenIssueLink                  string `yaml:"openIssueLink"`
 }
 
 type KeybindingAmendAttributeConfig struct {
@@ -576,13 +643,22 @@ type KeybindingStashConfig struct {
 }
 
 type KeybindingCommitFilesConfig struct {
-	CheckoutCommitFile string `yaml:"checkoutCommitFile"`
+	CheckoutCommitFile   string `yaml:"checkoutCommitFile"`
+	RestoreFileFromStash string `yaml:"restoreFileFromStash"`
 }
 
 type KeybindingMainConfig struct {
-	ToggleSelectHunk string `yaml:"toggleSelectHunk"`
-	PickBothHunks    string `yaml:"pickBothHunks"`
-	EditSelectHunk   string `yaml:"editSelectHunk"`
+	ToggleSelectHunk        string `yaml:"toggleSelectHunk"`
+	PickBothHunks           string `yaml:"pickBothHunks"`
+	PickBothHunksInOrder    string `yaml:"pickBothHunksInOrder"`
+	EditSelectHunk          string `yaml:"editSelectHunk"`
+	SkipHunk                string `yaml:"skipHunk"`
+	SplitHunk               string `yaml:"splitHunk"`
+	ToggleConflictSplitView string `yaml:"toggleConflictSplitView"`
+	BlameAtParent           string `yaml:"blameAtParent"`
+	ViewLineHistory         string `yaml:"viewLineHistory"`
+	NextRangeDiffPair       string `yaml:"nextRangeDiffPair"`
+	PrevRangeDiffPair       string `yaml:"prevRangeDiffPair"`
 }
 
 type KeybindingSubmodulesConfig struct {
@@ -773,6 +849,10 @@ func GetDefaultConfig() *UserConfig {
 				MarkedBaseCommitFgColor:         []string{"blue"},
 				UnstagedChangesColor:            []string{"red"},
 				DefaultFgColor:                  []string{"default"},
+				SyntaxKeywordColor:              []string{"magenta"},
+				SyntaxStringColor:               []string{"yellow"},
+				SyntaxCommentColor:              []string{"blue"},
+				SyntaxNumberColor:               []string{"cyan"},
 			},
 			CommitLength:                        CommitLengthConfig{Show: true},
 			SkipNoStagedFilesWarning:            false,
@@ -792,6 +872,7 @@ func GetDefaultConfig() *UserConfig {
 			CommitHashLength:                    8,
 			ShowBranchCommitHash:                false,
 			ShowDivergenceFromBaseBranch:        "none",
+			ShowConflictsWithBaseBranch:         false,
 			CommandLogSize:                      8,
 			SplitDiff:                           "auto",
 			SkipRewordInEditorWarning:           false,
@@ -838,8 +919,14 @@ func GetDefaultConfig() *UserConfig {
 			BranchLogCmd:                 "git log --graph --color=always --abbrev-commit --decorate --date=relative --pretty=medium {{branchName}} --",
 			AllBranchesLogCmds:           []string{"git log --graph --all --color=always --abbrev-commit --decorate --date=relative  --pretty=medium"},
 			IgnoreWhitespaceInDiffView:   false,
+			WordDiffInDiffView:           false,
+			ColorMovedInDiffView:         false,
+			SideBySideDiffInDiffView:     false,
+			SyntaxHighlightingInDiffView: false,
 			DiffContextSize:              3,
 			RenameSimilarityThreshold:    50,
+			DiffAlgorithm:                "default",
+			FindCopiesInDiffView:         false,
 			DisableForcePushing:          false,
 			CommitPrefixes:               map[string][]CommitPrefixConfig(nil),
 			BranchPrefix:                 "",
@@ -862,6 +949,13 @@ func GetDefaultConfig() *UserConfig {
 		Services:                     map[string]string(nil),
 		NotARepository:               "prompt",
 		PromptToReturnFromSubprocess: true,
+		HostingServiceAPI: HostingServiceAPIConfig{
+			ShowPullRequests: false,
+			ShowCheckStatus:  false,
+			Tokens:           map[string]string(nil),
+			BaseURLs:         map[string]string(nil),
+		},
+		IssueLinks: []IssueLinkConfig(nil),
 		Keybinding: KeybindingConfig{
 			Universal: KeybindingUniversalConfig{
 				Quit:                              "q",
@@ -898,6 +992,7 @@ func GetDefaultConfig() *UserConfig {
 				StartSearch:                       "/",
 				OptionMenu:                        "<disabled>",
 				OptionMenuAlt1:                    "?",
+				OpenCommandPalette:                ";",
 				Select:                            "<space>",
 				GoInto:                            "<enter>",
 				Confirm:                           "<enter>",
@@ -940,12 +1035,14 @@ func GetDefaultConfig() *UserConfig {
 				DecreaseContextInDiffView:         "{",
 				IncreaseRenameSimilarityThreshold: ")",
 				DecreaseRenameSimilarityThreshold: "(",
+				DiffOptionsMenu:                   "<c-g>",
 				OpenDiffTool:                      "<c-t>",
 			},
 			Status: KeybindingStatusConfig{
-				CheckForUpdate:      "u",
-				RecentRepos:         "<enter>",
-				AllBranchesLogGraph: "a",
+				CheckForUpdate:        "u",
+				RecentRepos:           "<enter>",
+				AllBranchesLogGraph:   "a",
+				SparseCheckoutOptions: "s",
 			},
 			Files: KeybindingFilesConfig{
 				CommitChanges:            "c",
@@ -967,11 +1064,17 @@ func GetDefaultConfig() *UserConfig {
 				CopyFileInfoToClipboard:  "y",
 				CollapseAll:              "-",
 				ExpandAll:                "=",
+				OpenBlame:                "b",
+				ToggleSparseCheckoutDir:  "<c-k>",
+				ViewLfsLockOptions:       "<c-l>",
+				ReviewHunks:              "<c-a>",
 			},
 			Branches: KeybindingBranchesConfig{
 				CopyPullRequestURL:     "<c-y>",
 				CreatePullRequest:      "o",
 				ViewPullRequestOptions: "O",
+				ViewCheckStatus:        "I",
+				OpenIssueLink:          "U",
 				CheckoutBranchByName:   "c",
 				ForceCheckoutBranch:    "F",
 				CheckoutPreviousBranch: "-",
@@ -1016,6 +1119,11 @@ func GetDefaultConfig() *UserConfig {
 				ViewBisectOptions:              "b",
 				StartInteractiveRebase:         "i",
 				SelectCommitsOfCurrentBranch:   "*",
+				ViewNoteOptions:                "<c-n>",
+				ViewPatchFileOptions:           "<c-x>",
+				SplitCommit:                    "E",
+				ViewCheckStatus:                "I",
+				OpenIssueLink:                  "U",
 			},
 			AmendAttribute: KeybindingAmendAttributeConfig{
 				ResetAuthor: "a",
@@ -1027,12 +1135,21 @@ func GetDefaultConfig() *UserConfig {
 				RenameStash: "r",
 			},
 			CommitFiles: KeybindingCommitFilesConfig{
-				CheckoutCommitFile: "c",
+				CheckoutCommitFile:   "c",
+				RestoreFileFromStash: "r",
 			},
 			Main: KeybindingMainConfig{
-				ToggleSelectHunk: "a",
-				PickBothHunks:    "b",
-				EditSelectHunk:   "E",
+				ToggleSelectHunk:        "a",
+				PickBothHunks:           "b",
+				PickBothHunksInOrder:    "B",
+				EditSelectHunk:          "E",
+				SkipHunk:                "n",
+				SplitHunk:               "s",
+				ToggleConflictSplitView: "s",
+				BlameAtParent:           "b",
+				ViewLineHistory:         "<c-l>",
+				NextRangeDiffPair:       "]",
+				PrevRangeDiffPair:       "[",
 			},
 			Submodules: KeybindingSubmodulesConfig{
 				Init:     "i",
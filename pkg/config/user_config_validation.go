@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"log"
 	"reflect"
+	"regexp"
 	"slices"
 	"strings"
 
@@ -31,6 +32,10 @@ func (config *UserConfig) Validate() error {
 		[]string{"date", "alphabetical"}); err != nil {
 		return err
 	}
+	if err := validateEnum("git.diffAlgorithm", config.Git.DiffAlgorithm,
+		[]string{"default", "myers", "minimal", "patience", "histogram"}); err != nil {
+		return err
+	}
 	if err := validateEnum("git.log.order", config.Git.Log.Order,
 		[]string{"date-order", "author-date-order", "topo-order", "default"}); err != nil {
 		return err
@@ -45,6 +50,9 @@ func (config *UserConfig) Validate() error {
 	if err := validateCustomCommands(config.CustomCommands); err != nil {
 		return err
 	}
+	if err := validateIssueLinks(config.IssueLinks); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -144,3 +152,15 @@ func validateCustomCommands(customCommands []CustomCommand) error {
 	}
 	return nil
 }
+
+func validateIssueLinks(issueLinks []IssueLinkConfig) error {
+	for _, issueLink := range issueLinks {
+		if issueLink.Pattern == "" || issueLink.URLTemplate == "" {
+			return fmt.Errorf("Both pattern and urlTemplate must be set for issueLinks")
+		}
+		if _, err := regexp.Compile(issueLink.Pattern); err != nil {
+			return fmt.Errorf("Invalid pattern '%s' in issueLinks: %w", issueLink.Pattern, err)
+		}
+	}
+	return nil
+}
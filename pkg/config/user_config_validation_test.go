@@ -82,6 +82,21 @@ func TestUserConfigValidate_enums(t *testing.T) {
 				{value: "invalid_value", valid: false},
 			},
 		},
+		{
+			name: "Git.DiffAlgorithm",
+			setup: func(config *UserConfig, value string) {
+				config.Git.DiffAlgorithm = value
+			},
+			testCases: []testCase{
+				{value: "default", valid: true},
+				{value: "myers", valid: true},
+				{value: "minimal", valid: true},
+				{value: "patience", valid: true},
+				{value: "histogram", valid: true},
+				{value: "", valid: false},
+				{value: "invalid_value", valid: false},
+			},
+		},
 		{
 			name: "Git.Log.Order",
 			setup: func(config *UserConfig, value string) {
@@ -195,6 +210,32 @@ func TestUserConfigValidate_enums(t *testing.T) {
 				{value: "invalid_value", valid: false},
 			},
 		},
+		{
+			name: "Issue link pattern",
+			setup: func(config *UserConfig, value string) {
+				config.IssueLinks = []IssueLinkConfig{
+					{Pattern: value, URLTemplate: "https://jira.example.com/browse/{{.match}}"},
+				}
+			},
+			testCases: []testCase{
+				{value: `\bPROJ-\d+\b`, valid: true},
+				{value: `#(?P<number>\d+)`, valid: true},
+				{value: "", valid: false},
+				{value: "PROJ-(", valid: false},
+			},
+		},
+		{
+			name: "Issue link URL template",
+			setup: func(config *UserConfig, value string) {
+				config.IssueLinks = []IssueLinkConfig{
+					{Pattern: `\bPROJ-\d+\b`, URLTemplate: value},
+				}
+			},
+			testCases: []testCase{
+				{value: "https://jira.example.com/browse/{{.match}}", valid: true},
+				{value: "", valid: false},
+			},
+		},
 		{
 			name: "Custom command sub menu",
 			setup: func(config *UserConfig, _ string) {
@@ -129,6 +129,8 @@ func (self *BackgroundRoutineMgr) backgroundFetch() (err error) {
 	self.gui.c.Refresh(types.RefreshOptions{Scope: []types.RefreshableView{types.BRANCHES, types.COMMITS, types.REMOTES, types.TAGS}, Mode: types.SYNC})
 
 	if err == nil {
+		self.gui.helpers.PullRequests.Refresh()
+		self.gui.helpers.CheckStatus.OnFetched()
 		err = self.gui.helpers.BranchesHelper.AutoForwardBranches()
 	}
 
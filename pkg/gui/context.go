@@ -287,6 +287,25 @@ func (self *ContextMgr) IsCurrentOrParent(c types.Context) bool {
 	return false
 }
 
+// Calls f as if the given side context were the current one, without actually
+// focusing it: no views are shown or rendered and no focus handlers run. This
+// is for asking the bindings of another panel whether they are enabled, which
+// often depends on what is focused. f must not change the context stack.
+func (self *ContextMgr) WithPretendedCurrent(c types.Context, f func()) {
+	self.Lock()
+	stack := self.ContextStack
+	self.ContextStack = []types.Context{c}
+	self.Unlock()
+
+	defer func() {
+		self.Lock()
+		self.ContextStack = stack
+		self.Unlock()
+	}()
+
+	f()
+}
+
 func (self *ContextMgr) AllFilterable() []types.IFilterableContext {
 	var result []types.IFilterableContext
 
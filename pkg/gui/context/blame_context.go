@@ -0,0 +1,129 @@
+package context
+
+import (
+	"github.com/jesseduffield/gocui"
+	"github.com/jesseduffield/lazygit/pkg/commands/models"
+	"github.com/jesseduffield/lazygit/pkg/gui/presentation"
+	"github.com/jesseduffield/lazygit/pkg/gui/types"
+	"github.com/jesseduffield/lazygit/pkg/utils"
+)
+
+type BlameContext struct {
+	*BlameViewModel
+	*ListContextTrait
+	*DynamicTitleBuilder
+	*SearchTrait
+}
+
+var (
+	_ types.IListContext       = (*BlameContext)(nil)
+	_ types.ISearchableContext = (*BlameContext)(nil)
+)
+
+func NewBlameContext(c *ContextCommon) *BlameContext {
+	viewModel := &BlameViewModel{}
+	viewModel.ListViewModel = NewListViewModel(func() []*models.BlameLine { return viewModel.lines })
+
+	getDisplayStrings := func(_ int, _ int) [][]string {
+		return presentation.GetBlameLineListDisplayStrings(
+			viewModel.lines,
+			c.UserConfig().Gui.CommitAuthorLongLength,
+			c.Tr,
+		)
+	}
+
+	getColumnAlignments := func() []utils.Alignment {
+		return []utils.Alignment{utils.AlignLeft, utils.AlignLeft, utils.AlignLeft, utils.AlignRight, utils.AlignLeft}
+	}
+
+	ctx := &BlameContext{
+		BlameViewModel:      viewModel,
+		DynamicTitleBuilder: NewDynamicTitleBuilder(c.Tr.BlameDynamicTitle),
+		SearchTrait:         NewSearchTrait(c),
+		ListContextTrait: &ListContextTrait{
+			Context: NewSimpleContext(NewBaseContext(NewBaseContextOpts{
+				View:       c.Views().Blame,
+				WindowName: "main",
+				Key:        BLAME_CONTEXT_KEY,
+				Kind:       types.MAIN_CONTEXT,
+				Focusable:  true,
+			})),
+			ListRenderer: ListRenderer{
+				list:                viewModel,
+				getDisplayStrings:   getDisplayStrings,
+				getColumnAlignments: getColumnAlignments,
+			},
+			c: c,
+		},
+	}
+
+	ctx.GetView().SetOnSelectItem(ctx.SearchTrait.onSelectItemWrapper(ctx.OnSearchSelect))
+
+	return ctx
+}
+
+func (self *BlameContext) ModelSearchResults(searchStr string, caseSensitive bool) []gocui.SearchPosition {
+	return nil
+}
+
+// Range selection doesn't make sense for any of the blame actions
+func (self *BlameContext) RangeSelectEnabled() bool {
+	return false
+}
+
+type BlameViewModel struct {
+	*ListViewModel[*models.BlameLine]
+
+	lines []*models.BlameLine
+
+	// the path of the blamed file, relative to the repo root
+	path string
+	// the commit at which the file is blamed; empty for the working tree
+	ref string
+
+	// previously shown revisions, so that we can go back after re-blaming at a
+	// parent commit
+	history []BlameHistoryEntry
+}
+
+type BlameHistoryEntry struct {
+	Path            string
+	Ref             string
+	SelectedLineIdx int
+}
+
+func (self *BlameViewModel) SetBlame(path string, ref string, lines []*models.BlameLine) {
+	self.path = path
+	self.ref = ref
+	self.lines = lines
+}
+
+func (self *BlameViewModel) GetPath() string {
+	return self.path
+}
+
+func (self *BlameViewModel) GetRef() string {
+	return self.ref
+}
+
+func (self *BlameViewModel) PushHistory() {
+	self.history = append(self.history, BlameHistoryEntry{
+		Path:            self.path,
+		Ref:             self.ref,
+		SelectedLineIdx: self.GetSelectedLineIdx(),
+	})
+}
+
+func (self *BlameViewModel) PopHistory() (BlameHistoryEntry, bool) {
+	if len(self.history) == 0 {
+		return BlameHistoryEntry{}, false
+	}
+
+	entry := self.history[len(self.history)-1]
+	self.history = self.history[:len(self.history)-1]
+	return entry, true
+}
+
+func (self *BlameViewModel) ClearHistory() {
+	self.history = nil
+}
@@ -34,6 +34,9 @@ func NewBranchesContext(c *ContextCommon) *BranchesContext {
 			c.Tr,
 			c.UserConfig(),
 			c.Model().Worktrees,
+			c.Model().PullRequests,
+			c.Model().CheckStatuses,
+			c.IssueLinkMatcher(),
 		)
 	}
 
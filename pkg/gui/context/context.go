@@ -29,6 +29,8 @@ const (
 	PATCH_BUILDING_MAIN_CONTEXT_KEY      types.ContextKey = "patchBuilding"
 	PATCH_BUILDING_SECONDARY_CONTEXT_KEY types.ContextKey = "patchBuildingSecondary"
 	MERGE_CONFLICTS_CONTEXT_KEY          types.ContextKey = "mergeConflicts"
+	MERGE_CONFLICTS_SIDES_CONTEXT_KEY    types.ContextKey = "mergeConflictsSides"
+	BLAME_CONTEXT_KEY                    types.ContextKey = "blame"
 
 	// these shouldn't really be needed for anything but I'm giving them unique keys nonetheless
 	OPTIONS_CONTEXT_KEY        types.ContextKey = "options"
@@ -71,6 +73,8 @@ var AllContextKeys = []types.ContextKey{
 	PATCH_BUILDING_MAIN_CONTEXT_KEY,
 	PATCH_BUILDING_SECONDARY_CONTEXT_KEY,
 	MERGE_CONFLICTS_CONTEXT_KEY,
+	MERGE_CONFLICTS_SIDES_CONTEXT_KEY,
+	BLAME_CONTEXT_KEY,
 
 	MENU_CONTEXT_KEY,
 	CONFIRMATION_CONTEXT_KEY,
@@ -107,6 +111,8 @@ type ContextTree struct {
 	CustomPatchBuilder          *PatchExplorerContext
 	CustomPatchBuilderSecondary types.Context
 	MergeConflicts              *MergeConflictsContext
+	MergeConflictsSides         types.Context
+	Blame                       *BlameContext
 	Confirmation                *ConfirmationContext
 	Prompt                      *PromptContext
 	CommitMessage               *CommitMessageContext
@@ -148,6 +154,8 @@ func (self *ContextTree) Flatten() []types.Context {
 		self.CommitMessage,
 		self.CommitDescription,
 
+		self.Blame,
+		self.MergeConflictsSides,
 		self.MergeConflicts,
 		self.StagingSecondary,
 		self.Staging,
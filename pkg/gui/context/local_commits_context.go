@@ -51,6 +51,8 @@ func NewLocalCommitsContext(c *ContextCommon) *LocalCommitsContext {
 			hasRebaseUpdateRefsConfig,
 			c.State().GetRepoState().GetScreenMode() != types.SCREEN_NORMAL,
 			c.Modes().CherryPicking.SelectedHashSet(),
+			c.Model().NotedCommitHashes,
+			c.Model().CheckStatuses,
 			c.Modes().Diffing.Ref,
 			c.Modes().MarkedBaseCommit.GetHash(),
 			c.UserConfig().Gui.TimeFormat,
@@ -62,13 +64,17 @@ func NewLocalCommitsContext(c *ContextCommon) *LocalCommitsContext {
 			endIdx,
 			shouldShowGraph(c),
 			c.Model().BisectInfo,
+			c.IssueLinkMatcher(),
 		)
 	}
 
 	getNonModelItems := func() []*NonModelItem {
 		result := []*NonModelItem{}
 		if c.Model().WorkingTreeStateAtLastCommitRefresh.CanShowTodos() {
-			if c.Model().WorkingTreeStateAtLastCommitRefresh.Rebasing {
+			// When filtering, the todos may have been left out (see
+			// CommitLoader.GetCommits), so there'd be nothing under the header
+			hasTodos := lo.SomeBy(c.Model().Commits, func(commit *models.Commit) bool { return commit.IsTODO() })
+			if c.Model().WorkingTreeStateAtLastCommitRefresh.Rebasing && (hasTodos || !c.Modes().Filtering.Active()) {
 				result = append(result, &NonModelItem{
 					Index:   0,
 					Content: fmt.Sprintf("--- %s ---", c.Tr.PendingRebaseTodosSectionHeader),
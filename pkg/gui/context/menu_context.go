@@ -56,6 +56,7 @@ type MenuViewModel struct {
 	promptLines               []string
 	columnAlignment           []utils.Alignment
 	allowFilteringKeybindings bool
+	alwaysUseFuzzySearch      bool
 	*FilteredListViewModel[*types.MenuItem]
 }
 
@@ -74,7 +75,11 @@ func NewMenuViewModel(c *ContextCommon) *MenuViewModel {
 				return []string{keybindings.LabelFromKey(item.Key)}
 			}
 
-			return item.LabelColumns
+			// The columns might be colored, and we don't want to match the
+			// escape sequences
+			return lo.Map(item.LabelColumns, func(column string, _ int) string {
+				return utils.Decolorise(column)
+			})
 		},
 	)
 
@@ -117,6 +122,22 @@ func (self *MenuViewModel) SetAllowFilteringKeybindings(allow bool) {
 	self.allowFilteringKeybindings = allow
 }
 
+func (self *MenuViewModel) SetAlwaysUseFuzzySearch(value bool) {
+	self.alwaysUseFuzzySearch = value
+}
+
+func (self *MenuViewModel) SetFilter(filter string, useFuzzySearch bool) {
+	self.FilteredListViewModel.SetFilter(filter, self.useFuzzySearch(useFuzzySearch))
+}
+
+func (self *MenuViewModel) ReApplyFilter(useFuzzySearch bool) {
+	self.FilteredListViewModel.ReApplyFilter(self.useFuzzySearch(useFuzzySearch))
+}
+
+func (self *MenuViewModel) useFuzzySearch(useFuzzySearchByConfig bool) bool {
+	return useFuzzySearchByConfig || self.alwaysUseFuzzySearch
+}
+
 // TODO: move into presentation package
 func (self *MenuViewModel) GetDisplayStrings(_ int, _ int) [][]string {
 	menuItems := self.FilteredListViewModel.GetItems()
@@ -164,7 +185,7 @@ func (self *MenuViewModel) GetNonModelItems() []*NonModelItem {
 	// Don't display section headers when we are filtering, and the filter mode
 	// is fuzzy. The reason is that filtering changes the order of the items
 	// (they are sorted by best match), so all the sections would be messed up.
-	if self.FilteredListViewModel.IsFiltering() && self.c.UserConfig().Gui.UseFuzzySearch() {
+	if self.FilteredListViewModel.IsFiltering() && self.useFuzzySearch(self.c.UserConfig().Gui.UseFuzzySearch()) {
 		return result
 	}
 
@@ -21,6 +21,10 @@ type ConflictsViewModel struct {
 	// userVerticalScrolling tells us if the user has started scrolling through the file themselves
 	// in which case we won't auto-scroll to a conflict.
 	userVerticalScrolling bool
+
+	// if true, the sides of the selected conflict are shown next to each other
+	// in the secondary view, with the main view showing the result
+	showSplitView bool
 }
 
 func NewMergeConflictsContext(
@@ -67,6 +71,14 @@ func (self *MergeConflictsContext) IsUserScrolling() bool {
 	return self.viewModel.userVerticalScrolling
 }
 
+func (self *MergeConflictsContext) ToggleSplitView() {
+	self.viewModel.showSplitView = !self.viewModel.showSplitView
+}
+
+func (self *MergeConflictsContext) IsShowingSplitView() bool {
+	return self.viewModel.showSplitView
+}
+
 func (self *MergeConflictsContext) RenderAndFocus() {
 	self.setContent()
 	self.FocusSelection()
@@ -90,8 +102,20 @@ func (self *MergeConflictsContext) GetContentToRender() string {
 	return mergeconflicts.ColoredConflictFile(self.GetState())
 }
 
+func (self *MergeConflictsContext) GetSidesContentToRender() string {
+	if self.GetState() == nil {
+		return ""
+	}
+
+	return mergeconflicts.ColoredConflictSides(self.GetState(), self.c.Views().MergeConflictsSides.InnerWidth())
+}
+
 func (self *MergeConflictsContext) setContent() {
 	self.GetView().SetContent(self.GetContentToRender())
+
+	if self.IsShowingSplitView() {
+		self.c.Views().MergeConflictsSides.SetContent(self.GetSidesContentToRender())
+	}
 }
 
 func (self *MergeConflictsContext) FocusSelection() {
@@ -2,6 +2,7 @@ package context
 
 import (
 	"github.com/jesseduffield/gocui"
+	"github.com/jesseduffield/lazygit/pkg/commands/patch"
 	"github.com/jesseduffield/lazygit/pkg/gui/patch_exploring"
 	"github.com/jesseduffield/lazygit/pkg/gui/types"
 	deadlock "github.com/sasha-s/go-deadlock"
@@ -120,7 +121,18 @@ func (self *PatchExplorerContext) GetContentToRender() string {
 		return ""
 	}
 
-	return self.GetState().RenderForLineIndices(self.GetIncludedLineIndices())
+	view := self.GetView()
+	gitConfig := self.c.UserConfig().Git
+	return self.GetState().RenderForLineIndices(
+		self.GetIncludedLineIndices(),
+		patch.FormatViewOpts{
+			WordDiff:           gitConfig.WordDiffInDiffView,
+			ColorMoved:         gitConfig.ColorMovedInDiffView,
+			SyntaxHighlighting: gitConfig.SyntaxHighlightingInDiffView,
+		},
+		view.InnerWidth(),
+		view.TabWidth,
+	)
 }
 
 func (self *PatchExplorerContext) NavigateTo(selectedLineIdx int) {
@@ -83,6 +83,16 @@ func NewContextTree(c *ContextCommon) *ContextTree {
 		MergeConflicts: NewMergeConflictsContext(
 			c,
 		),
+		MergeConflictsSides: NewSimpleContext(
+			NewBaseContext(NewBaseContextOpts{
+				Kind:       types.MAIN_CONTEXT,
+				View:       c.Views().MergeConflictsSides,
+				WindowName: "secondary",
+				Key:        MERGE_CONFLICTS_SIDES_CONTEXT_KEY,
+				Focusable:  false,
+			}),
+		),
+		Blame:         NewBlameContext(c),
 		Confirmation:  NewConfirmationContext(c),
 		Prompt:        NewPromptContext(c),
 		CommitMessage: NewCommitMessageContext(c),
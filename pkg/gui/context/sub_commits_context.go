@@ -66,6 +66,8 @@ func NewSubCommitsContext(
 			hasRebaseUpdateRefsConfig,
 			c.State().GetRepoState().GetScreenMode() != types.SCREEN_NORMAL,
 			c.Modes().CherryPicking.SelectedHashSet(),
+			c.Model().NotedCommitHashes,
+			c.Model().CheckStatuses,
 			c.Modes().Diffing.Ref,
 			"",
 			c.UserConfig().Gui.TimeFormat,
@@ -77,6 +79,7 @@ func NewSubCommitsContext(
 			endIdx,
 			shouldShowGraph(c),
 			git_commands.NewNullBisectInfo(),
+			c.IssueLinkMatcher(),
 		)
 	}
 
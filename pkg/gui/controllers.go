@@ -58,6 +58,8 @@ func (gui *Gui) resetHelpersAndControllers() {
 	stagingHelper := helpers.NewStagingHelper(helperCommon)
 	mergeConflictsHelper := helpers.NewMergeConflictsHelper(helperCommon)
 	searchHelper := helpers.NewSearchHelper(helperCommon)
+	hostHelper := helpers.NewHostHelper(helperCommon)
+	pullRequestsHelper := helpers.NewPullRequestsHelper(helperCommon, hostHelper, suggestionsHelper, commitsHelper)
 
 	refreshHelper := helpers.NewRefreshHelper(
 		helperCommon,
@@ -68,6 +70,7 @@ func (gui *Gui) resetHelpersAndControllers() {
 		mergeConflictsHelper,
 		worktreeHelper,
 		searchHelper,
+		pullRequestsHelper,
 	)
 	diffHelper := helpers.NewDiffHelper(helperCommon)
 	cherryPickHelper := helpers.NewCherryPickHelper(
@@ -84,6 +87,7 @@ func (gui *Gui) resetHelpersAndControllers() {
 		rebaseHelper,
 		bisectHelper,
 	)
+	subCommitsHelper := helpers.NewSubCommitsHelper(helperCommon, refreshHelper)
 	appStatusHelper := helpers.NewAppStatusHelper(
 		helperCommon,
 		func() *status.StatusManager { return gui.statusManager },
@@ -92,7 +96,7 @@ func (gui *Gui) resetHelpersAndControllers() {
 
 	gui.helpers = &helpers.Helpers{
 		Refs:            refsHelper,
-		Host:            helpers.NewHostHelper(helperCommon),
+		Host:            hostHelper,
 		PatchBuilding:   patchBuildingHelper,
 		Staging:         stagingHelper,
 		Bisect:          bisectHelper,
@@ -128,9 +132,14 @@ func (gui *Gui) resetHelpersAndControllers() {
 			modeHelper,
 			appStatusHelper,
 		),
-		Search:     searchHelper,
-		Worktree:   worktreeHelper,
-		SubCommits: helpers.NewSubCommitsHelper(helperCommon, refreshHelper),
+		Search:         searchHelper,
+		Worktree:       worktreeHelper,
+		SubCommits:     subCommitsHelper,
+		Blame:          helpers.NewBlameHelper(helperCommon, subCommitsHelper),
+		SparseCheckout: helpers.NewSparseCheckoutHelper(helperCommon),
+		PullRequests:   pullRequestsHelper,
+		CheckStatus:    helpers.NewCheckStatusHelper(helperCommon, hostHelper, windowHelper),
+		IssueLinks:     helpers.NewIssueLinksHelper(helperCommon),
 	}
 
 	gui.CustomCommandsClient = custom_commands.NewClient(
@@ -165,6 +174,7 @@ func (gui *Gui) resetHelpersAndControllers() {
 		common,
 	)
 	mergeConflictsController := controllers.NewMergeConflictsController(common)
+	blameController := controllers.NewBlameController(common)
 	remotesController := controllers.NewRemotesController(
 		common,
 		func(branches []*models.RemoteBranch) { gui.State.Model.RemoteBranches = branches },
@@ -174,6 +184,7 @@ func (gui *Gui) resetHelpersAndControllers() {
 	globalController := controllers.NewGlobalController(common)
 	contextLinesController := controllers.NewContextLinesController(common)
 	renameSimilarityThresholdController := controllers.NewRenameSimilarityThresholdController(common)
+	diffOptionsController := controllers.NewDiffOptionsController(common)
 	verticalScrollControllerFactory := controllers.NewVerticalScrollControllerFactory(common)
 	viewSelectionControllerFactory := controllers.NewViewSelectionControllerFactory(common)
 
@@ -186,6 +197,7 @@ func (gui *Gui) resetHelpersAndControllers() {
 	stagingSecondaryController := controllers.NewStagingController(common, gui.State.Contexts.StagingSecondary, gui.State.Contexts.Staging, true)
 	mainViewController := controllers.NewMainViewController(common, gui.State.Contexts.Normal, gui.State.Contexts.NormalSecondary)
 	secondaryViewController := controllers.NewMainViewController(common, gui.State.Contexts.NormalSecondary, gui.State.Contexts.Normal)
+	rangeDiffController := controllers.NewRangeDiffController(common, gui.State.Contexts.Normal)
 	patchBuildingController := controllers.NewPatchBuildingController(common)
 	snakeController := controllers.NewSnakeController(common)
 	reflogCommitsController := controllers.NewReflogCommitsController(common)
@@ -321,8 +333,13 @@ func (gui *Gui) resetHelpersAndControllers() {
 		mergeConflictsController,
 	)
 
+	controllers.AttachControllers(gui.State.Contexts.Blame,
+		blameController,
+	)
+
 	controllers.AttachControllers(gui.State.Contexts.Normal,
 		mainViewController,
+		rangeDiffController,
 		verticalScrollControllerFactory.Create(gui.State.Contexts.Normal),
 		viewSelectionControllerFactory.Create(gui.State.Contexts.Normal),
 	)
@@ -417,6 +434,7 @@ func (gui *Gui) resetHelpersAndControllers() {
 		globalController,
 		contextLinesController,
 		renameSimilarityThresholdController,
+		diffOptionsController,
 		jumpToSideWindowController,
 		syncController,
 	)
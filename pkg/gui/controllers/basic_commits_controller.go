@@ -74,6 +74,13 @@ func (self *BasicCommitsController) GetKeybindings(opts types.KeybindingsOpts) [
 			GetDisabledReason: self.require(self.singleItemSelected()),
 			Description:       self.c.Tr.OpenCommitInBrowser,
 		},
+		{
+			Key:               opts.GetKey(opts.Config.Commits.OpenIssueLink),
+			Handler:           self.withItem(self.c.Helpers().IssueLinks.OpenForCommit),
+			GetDisabledReason: self.require(self.singleItemSelected(self.c.Helpers().IssueLinks.CanOpenForCommit)),
+			Description:       self.c.Tr.OpenIssueLink,
+			Tooltip:           self.c.Tr.OpenIssueLinkTooltip,
+		},
 		{
 			Key:               opts.GetKey(opts.Config.Universal.New),
 			Handler:           self.withItem(self.newBranch),
@@ -99,6 +106,7 @@ func (self *BasicCommitsController) GetKeybindings(opts types.KeybindingsOpts) [
 			Tooltip:           self.c.Tr.ResetTooltip,
 			OpensMenu:         true,
 			DisplayOnScreen:   true,
+			GetMenuItems:      self.withItemMenuItems(self.resetMenuItems),
 		},
 		{
 			Key:               opts.GetKey(opts.Config.Commits.CherryPickCopy),
@@ -364,6 +372,10 @@ func (self *BasicCommitsController) createResetMenu(commit *models.Commit) error
 	return self.c.Helpers().Refs.CreateGitResetMenu(commit.Hash(), commit.Hash())
 }
 
+func (self *BasicCommitsController) resetMenuItems(commit *models.Commit) []*types.MenuItem {
+	return self.c.Helpers().Refs.GitResetMenuItems(commit.Hash(), commit.Hash())
+}
+
 func (self *BasicCommitsController) checkout(commit *models.Commit) error {
 	return self.c.Helpers().Refs.CreateCheckoutMenu(commit)
 }
@@ -3,7 +3,9 @@ package controllers
 import (
 	"fmt"
 	"strings"
+	"sync"
 
+	"github.com/jesseduffield/gocui"
 	"github.com/jesseduffield/lazygit/pkg/commands/git_commands"
 	"github.com/jesseduffield/lazygit/pkg/commands/models"
 	"github.com/jesseduffield/lazygit/pkg/gui/context"
@@ -145,6 +147,19 @@ func (self *BisectController) openMidBisectMenu(info *git_commands.BisectInfo, c
 			Key:            'S',
 		}))
 	}
+	var runDisabledReason *types.DisabledReason
+	if !info.Bisecting() {
+		runDisabledReason = &types.DisabledReason{
+			Text: fmt.Sprintf(self.c.Tr.Bisect.RunRequiresMarkedCommits, info.NewTerm(), info.OldTerm()),
+		}
+	}
+	menuItems = append(menuItems, lo.ToPtr(types.MenuItem{
+		Label:          fmt.Sprintf(self.c.Tr.Bisect.RunOption, info.NewTerm()),
+		Tooltip:        fmt.Sprintf(self.c.Tr.Bisect.RunTooltip, info.OldTerm(), info.NewTerm()),
+		OnPress:        self.promptForBisectRunCommand,
+		DisabledReason: runDisabledReason,
+		Key:            'x',
+	}))
 	menuItems = append(menuItems, lo.ToPtr(types.MenuItem{
 		Label: self.c.Tr.Bisect.ResetOption,
 		OnPress: func() error {
@@ -228,6 +243,57 @@ func (self *BisectController) openStartBisectMenu(info *git_commands.BisectInfo,
 	})
 }
 
+func (self *BisectController) promptForBisectRunCommand() error {
+	self.c.Prompt(types.PromptOpts{
+		Title: self.c.Tr.Bisect.RunPrompt,
+		HandleConfirm: func(command string) error {
+			if strings.TrimSpace(command) == "" {
+				return nil
+			}
+
+			return self.bisectRun(command)
+		},
+	})
+
+	return nil
+}
+
+func (self *BisectController) bisectRun(command string) error {
+	return self.c.WithWaitingStatus(self.c.Tr.Bisect.Running, func(gocui.Task) error {
+		self.c.LogAction(self.c.Tr.Actions.BisectRun)
+
+		// git prints a "Bisecting: ..." line whenever it has marked a commit and
+		// checked out the next one, so that's when we show the new status
+		progressWriter := &bisectRunProgressWriter{onStep: func() {
+			self.c.Refresh(types.RefreshOptions{Mode: types.ASYNC, Scope: []types.RefreshableView{types.COMMITS}})
+		}}
+
+		runErr := self.c.Git().Bisect.Run(command, progressWriter)
+
+		self.c.Refresh(types.RefreshOptions{Mode: types.SYNC})
+
+		if runErr != nil {
+			return runErr
+		}
+
+		done, candidateHashes, err := self.c.Git().Bisect.IsDone()
+		if err != nil {
+			return err
+		}
+
+		if !done {
+			return nil
+		}
+
+		self.c.OnUIThread(func() error {
+			self.selectCommit(self.c.Git().Bisect.GetInfo().GetNewHash())
+			return self.showBisectCompleteMessage(candidateHashes)
+		})
+
+		return nil
+	})
+}
+
 func (self *BisectController) showBisectCompleteMessage(candidateHashes []string) error {
 	prompt := self.c.Tr.Bisect.CompletePrompt
 	if len(candidateHashes) > 1 {
@@ -294,13 +360,17 @@ func (self *BisectController) afterBisectMarkRefresh(selectCurrent bool, waitToR
 func (self *BisectController) selectCurrentBisectCommit() {
 	info := self.c.Git().Bisect.GetInfo()
 	if info.GetCurrentHash() != "" {
-		// find index of commit with that hash, move cursor to that.
-		for i, commit := range self.c.Model().Commits {
-			if commit.Hash() == info.GetCurrentHash() {
-				self.context().SetSelection(i)
-				self.context().HandleFocus(types.OnFocusOpts{})
-				break
-			}
+		self.selectCommit(info.GetCurrentHash())
+	}
+}
+
+func (self *BisectController) selectCommit(hash string) {
+	// find index of commit with that hash, move cursor to that.
+	for i, commit := range self.c.Model().Commits {
+		if commit.Hash() == hash {
+			self.context().SetSelection(i)
+			self.context().HandleFocus(types.OnFocusOpts{})
+			break
 		}
 	}
 }
@@ -308,3 +378,26 @@ func (self *BisectController) selectCurrentBisectCommit() {
 func (self *BisectController) context() *context.LocalCommitsContext {
 	return self.c.Contexts().LocalCommits
 }
+
+// Watches the output of `git bisect run` and calls onStep whenever git moves
+// on to the next commit
+type bisectRunProgressWriter struct {
+	mutex       sync.Mutex
+	partialLine string
+	onStep      func()
+}
+
+func (self *bisectRunProgressWriter) Write(p []byte) (int, error) {
+	self.mutex.Lock()
+	lines := strings.Split(self.partialLine+string(p), "\n")
+	self.partialLine = lines[len(lines)-1]
+	self.mutex.Unlock()
+
+	for _, line := range lines[:len(lines)-1] {
+		if strings.HasPrefix(line, "Bisecting:") {
+			self.onStep()
+		}
+	}
+
+	return len(p), nil
+}
@@ -0,0 +1,104 @@
+package controllers
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/commands/models"
+	"github.com/jesseduffield/lazygit/pkg/gui/context"
+	"github.com/jesseduffield/lazygit/pkg/gui/keybindings"
+	"github.com/jesseduffield/lazygit/pkg/gui/types"
+	"github.com/jesseduffield/lazygit/pkg/utils"
+)
+
+type BlameController struct {
+	baseController
+	*ListControllerTrait[*models.BlameLine]
+	c *ControllerCommon
+}
+
+var _ types.IController = &BlameController{}
+
+func NewBlameController(
+	c *ControllerCommon,
+) *BlameController {
+	return &BlameController{
+		baseController: baseController{},
+		ListControllerTrait: NewListControllerTrait(
+			c,
+			c.Contexts().Blame,
+			c.Contexts().Blame.GetSelected,
+			c.Contexts().Blame.GetSelectedItems,
+		),
+		c: c,
+	}
+}
+
+func (self *BlameController) GetKeybindings(opts types.KeybindingsOpts) []*types.Binding {
+	return []*types.Binding{
+		{
+			Key:               opts.GetKey(opts.Config.Universal.GoInto),
+			Handler:           self.withItem(self.goToCommit),
+			GetDisabledReason: self.require(self.singleItemSelected(self.lineIsCommitted)),
+			Description:       self.c.Tr.BlameGoToCommit,
+			Tooltip:           self.c.Tr.BlameGoToCommitTooltip,
+			DisplayOnScreen:   true,
+		},
+		{
+			Key:               opts.GetKey(opts.Config.Main.BlameAtParent),
+			Handler:           self.withItem(self.blameAtParent),
+			GetDisabledReason: self.require(self.singleItemSelected(self.lineIsCommitted, self.lineHasParent)),
+			Description:       self.c.Tr.BlameAtParent,
+			Tooltip: utils.ResolvePlaceholderString(self.c.Tr.BlameAtParentTooltip,
+				map[string]string{"return": keybindings.Label(opts.Config.Universal.Return)},
+			),
+			DisplayOnScreen: true,
+		},
+		{
+			Key:             opts.GetKey(opts.Config.Universal.Return),
+			Handler:         self.escape,
+			Description:     self.c.Tr.BlameReturn,
+			Tooltip:         self.c.Tr.BlameReturnTooltip,
+			DisplayOnScreen: true,
+		},
+	}
+}
+
+func (self *BlameController) Context() types.Context {
+	return self.context()
+}
+
+func (self *BlameController) context() *context.BlameContext {
+	return self.c.Contexts().Blame
+}
+
+func (self *BlameController) goToCommit(line *models.BlameLine) error {
+	return self.c.Helpers().Blame.GoToCommit(line)
+}
+
+func (self *BlameController) blameAtParent(line *models.BlameLine) error {
+	return self.c.Helpers().Blame.BlameAtParent(line)
+}
+
+func (self *BlameController) escape() error {
+	wentBack, err := self.c.Helpers().Blame.GoBack()
+	if err != nil || wentBack {
+		return err
+	}
+
+	self.c.Context().Pop()
+	return nil
+}
+
+func (self *BlameController) lineIsCommitted(line *models.BlameLine) *types.DisabledReason {
+	if !line.IsCommitted() {
+		return &types.DisabledReason{Text: self.c.Tr.BlameLineNotCommittedYet}
+	}
+
+	return nil
+}
+
+func (self *BlameController) lineHasParent(line *models.BlameLine) *types.DisabledReason {
+	if !line.HasPrevious() {
+		return &types.DisabledReason{Text: self.c.Tr.BlameLineHasNoParent}
+	}
+
+	return nil
+}
@@ -83,6 +83,21 @@ func (self *BranchesController) GetKeybindings(opts types.KeybindingsOpts) []*ty
 			GetDisabledReason: self.require(self.singleItemSelected()),
 			Description:       self.c.Tr.CopyPullRequestURL,
 		},
+		{
+			Key:               opts.GetKey(opts.Config.Branches.ViewCheckStatus),
+			Handler:           self.withItem(self.viewCheckStatus),
+			GetDisabledReason: self.require(self.singleItemSelected(self.c.Helpers().CheckStatus.CanOpenMenuForBranch)),
+			Description:       self.c.Tr.ViewCheckStatus,
+			Tooltip:           self.c.Tr.ViewCheckStatusTooltip,
+			OpensMenu:         true,
+		},
+		{
+			Key:               opts.GetKey(opts.Config.Branches.OpenIssueLink),
+			Handler:           self.withItem(self.c.Helpers().IssueLinks.OpenForBranch),
+			GetDisabledReason: self.require(self.singleItemSelected(self.c.Helpers().IssueLinks.CanOpenForBranch)),
+			Description:       self.c.Tr.OpenIssueLink,
+			Tooltip:           self.c.Tr.OpenIssueLinkTooltip,
+		},
 		{
 			Key:         opts.GetKey(opts.Config.Branches.CheckoutBranchByName),
 			Handler:     self.checkoutByName,
@@ -118,6 +133,7 @@ func (self *BranchesController) GetKeybindings(opts types.KeybindingsOpts) []*ty
 			Tooltip:           self.c.Tr.RebaseBranchTooltip,
 			OpensMenu:         true,
 			DisplayOnScreen:   true,
+			GetMenuItems:      self.withItemMenuItems(self.rebaseMenuItems),
 		},
 		{
 			Key:               opts.GetKey(opts.Config.Branches.MergeIntoCurrentBranch),
@@ -154,6 +170,7 @@ func (self *BranchesController) GetKeybindings(opts types.KeybindingsOpts) []*ty
 			Description:       self.c.Tr.ViewResetOptions,
 			OpensMenu:         true,
 			DisplayOnScreen:   true,
+			GetMenuItems:      self.withItemMenuItems(self.resetMenuItems),
 		},
 		{
 			Key:               opts.GetKey(opts.Config.Branches.RenameBranch),
@@ -430,6 +447,10 @@ func (self *BranchesController) promptToCheckoutWorktree(worktree *models.Worktr
 }
 
 func (self *BranchesController) handleCreatePullRequest(selectedBranch *models.Branch) error {
+	if self.c.Helpers().Host.CanCreatePullRequestsViaAPI() {
+		return self.c.Helpers().PullRequests.Create(selectedBranch, "")
+	}
+
 	if !selectedBranch.IsTrackingRemote() {
 		return errors.New(self.c.Tr.PullRequestNoUpstream)
 	}
@@ -442,6 +463,10 @@ func (self *BranchesController) handleCreatePullRequestMenu(selectedBranch *mode
 	return self.createPullRequestMenu(selectedBranch, checkedOutBranch)
 }
 
+func (self *BranchesController) viewCheckStatus(branch *models.Branch) error {
+	return self.c.Helpers().CheckStatus.OpenMenu(branch.CommitHash)
+}
+
 func (self *BranchesController) copyPullRequestURL() error {
 	branch := self.context().GetSelected()
 
@@ -626,6 +651,21 @@ func (self *BranchesController) rebase(branch *models.Branch) error {
 	return self.c.Helpers().MergeAndRebase.RebaseOntoRef(branch.Name)
 }
 
+func (self *BranchesController) rebaseMenuItems(branch *models.Branch) []*types.MenuItem {
+	// The handler asks to leave filter mode first; there's no way to do that
+	// when running a menu item directly, so don't offer them
+	if self.c.Modes().Filtering.Active() {
+		return nil
+	}
+
+	menuItems, err := self.c.Helpers().MergeAndRebase.RebaseMenuItems(branch.Name)
+	if err != nil {
+		self.c.Log.Error(err)
+		return nil
+	}
+	return menuItems
+}
+
 func (self *BranchesController) fastForward(branch *models.Branch) error {
 	if !branch.IsTrackingRemote() {
 		return errors.New(self.c.Tr.FwdNoUpstream)
@@ -700,6 +740,10 @@ func (self *BranchesController) createResetMenu(selectedBranch *models.Branch) e
 	return self.c.Helpers().Refs.CreateGitResetMenu(selectedBranch.Name, selectedBranch.FullRefName())
 }
 
+func (self *BranchesController) resetMenuItems(selectedBranch *models.Branch) []*types.MenuItem {
+	return self.c.Helpers().Refs.GitResetMenuItems(selectedBranch.Name, selectedBranch.FullRefName())
+}
+
 func (self *BranchesController) rename(branch *models.Branch) error {
 	promptForNewName := func() error {
 		self.c.Prompt(types.PromptOpts{
@@ -764,6 +808,11 @@ func (self *BranchesController) createPullRequestMenu(selectedBranch *models.Bra
 			{
 				LabelColumns: fromToLabelColumns(branch.Name, self.c.Tr.SelectBranch),
 				OnPress: func() error {
+					if self.c.Helpers().Host.CanCreatePullRequestsViaAPI() {
+						// Creating the pull request prompts for the target branch anyway
+						return self.c.Helpers().PullRequests.Create(branch, "")
+					}
+
 					if !branch.IsTrackingRemote() {
 						return errors.New(self.c.Tr.PullRequestNoUpstream)
 					}
@@ -795,6 +844,13 @@ func (self *BranchesController) createPullRequestMenu(selectedBranch *models.Bra
 			&types.MenuItem{
 				LabelColumns: fromToLabelColumns(checkedOutBranch.Name, selectedBranch.Name),
 				OnPress: func() error {
+					if self.c.Helpers().Host.CanCreatePullRequestsViaAPI() {
+						if !selectedBranch.IsTrackingRemote() {
+							return errors.New(self.c.Tr.PullRequestNoUpstream)
+						}
+						return self.c.Helpers().PullRequests.Create(checkedOutBranch, selectedBranch.UpstreamBranch)
+					}
+
 					if !checkedOutBranch.IsTrackingRemote() || !selectedBranch.IsTrackingRemote() {
 						return errors.New(self.c.Tr.PullRequestNoUpstream)
 					}
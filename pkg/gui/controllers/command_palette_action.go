@@ -0,0 +1,220 @@
+package controllers
+
+import (
+	"strings"
+
+	"github.com/jesseduffield/lazygit/pkg/gui/keybindings"
+	"github.com/jesseduffield/lazygit/pkg/gui/style"
+	"github.com/jesseduffield/lazygit/pkg/gui/types"
+	"github.com/jesseduffield/lazygit/pkg/utils"
+	"github.com/samber/lo"
+)
+
+// Lets the user fuzzy-search the actions of all panels, rather than just the
+// ones of the current panel like the keybindings menu does, and runs the
+// selected one in the panel it belongs to. For bindings that open a menu and
+// provide its items (see Binding.GetMenuItems), the items are offered too.
+type CommandPaletteAction struct {
+	c *ControllerCommon
+}
+
+type commandPaletteEntry struct {
+	binding *types.Binding
+	// nil for global bindings, which can be run from anywhere
+	context types.Context
+	title   string
+}
+
+type commandPaletteContext struct {
+	context types.Context
+	title   string
+}
+
+func (self *CommandPaletteAction) Call() error {
+	menuItems := []*types.MenuItem{}
+	for _, entry := range self.getEntries() {
+		// Whether a binding is enabled often depends on what's focused, so ask
+		// it as if we were in the panel that it's going to be run in
+		self.withContext(entry.context, func() {
+			menuItems = append(menuItems, self.menuItemsForEntry(entry)...)
+		})
+	}
+
+	if err := self.c.Menu(types.CreateMenuOptions{
+		Title:                self.c.Tr.CommandPalette,
+		Items:                menuItems,
+		HideCancel:           true,
+		ColumnAlignment:      []utils.Alignment{utils.AlignLeft, utils.AlignLeft, utils.AlignRight},
+		AlwaysUseFuzzySearch: true,
+	}); err != nil {
+		return err
+	}
+
+	return self.c.Helpers().Search.OpenFilterPrompt(self.c.Contexts().Menu)
+}
+
+func (self *CommandPaletteAction) withContext(context types.Context, f func()) {
+	if context == nil || context.GetKey() == self.c.Context().Current().GetKey() {
+		f()
+		return
+	}
+
+	self.c.Context().WithPretendedCurrent(context, f)
+}
+
+// Returns the menu item for the entry's binding, followed by the items of the
+// menu that it opens, if it tells us what they are
+func (self *CommandPaletteAction) menuItemsForEntry(entry commandPaletteEntry) []*types.MenuItem {
+	var disabledReason *types.DisabledReason
+	if entry.binding.GetDisabledReason != nil {
+		disabledReason = entry.binding.GetDisabledReason()
+		// An empty text means that the binding doesn't apply in the current
+		// situation at all (e.g. escape when there's nothing to cancel), so it
+		// would only be noise here
+		if disabledReason != nil && disabledReason.Text == "" {
+			return nil
+		}
+	}
+
+	keyLabel := keybindings.LabelFromKey(entry.binding.Key)
+	result := []*types.MenuItem{
+		{
+			OpensMenu: entry.binding.OpensMenu,
+			LabelColumns: []string{
+				entry.binding.GetDescription(),
+				style.FgBlue.Sprint(entry.title),
+				// Not using the Key field of the menu item, because that would
+				// make the menu handle the key itself, and many of them are
+				// used by several panels
+				style.FgCyan.Sprint(keyLabel),
+			},
+			OnPress: func() error {
+				return self.run(entry)
+			},
+			Tooltip:        entry.binding.Tooltip,
+			DisabledReason: disabledReason,
+		},
+	}
+
+	if disabledReason != nil || entry.binding.GetMenuItems == nil {
+		return result
+	}
+
+	for _, item := range entry.binding.GetMenuItems() {
+		label := item.Label
+		if label == "" {
+			label = strings.Join(item.LabelColumns, " ")
+		}
+		itemKeyLabel := keyLabel
+		if item.Key != nil {
+			itemKeyLabel += " " + keybindings.LabelFromKey(item.Key)
+		}
+
+		result = append(result, &types.MenuItem{
+			OpensMenu: item.OpensMenu,
+			LabelColumns: []string{
+				entry.binding.GetDescription() + ": " + label,
+				style.FgBlue.Sprint(entry.title),
+				style.FgCyan.Sprint(itemKeyLabel),
+			},
+			OnPress: func() error {
+				self.focus(entry)
+				return item.OnPress()
+			},
+			Tooltip:        item.Tooltip,
+			DisabledReason: item.DisabledReason,
+		})
+	}
+
+	return result
+}
+
+func (self *CommandPaletteAction) run(entry commandPaletteEntry) error {
+	self.focus(entry)
+
+	return self.c.IGuiCommon.CallKeybindingHandler(entry.binding)
+}
+
+func (self *CommandPaletteAction) focus(entry commandPaletteEntry) {
+	if entry.context != nil && entry.context.GetKey() != self.c.Context().Current().GetKey() {
+		self.c.Context().Push(entry.context, types.OnFocusOpts{})
+	}
+}
+
+// Returns the bindings of the current context first, followed by the global
+// ones and those of the other panels
+func (self *CommandPaletteAction) getEntries() []commandPaletteEntry {
+	currentContext := self.c.Context().Current()
+	paletteContexts := self.getContexts(currentContext)
+	contextsByViewName := map[string]commandPaletteContext{}
+	for _, paletteContext := range paletteContexts {
+		contextsByViewName[paletteContext.context.GetViewName()] = paletteContext
+	}
+
+	bindings, _ := self.c.GetInitialKeybindingsWithCustomCommands()
+
+	entriesByViewName := map[string][]commandPaletteEntry{}
+	for _, binding := range bindings {
+		if binding.GetDescription() == "" || binding.Handler == nil || binding.Tag == "navigation" {
+			continue
+		}
+
+		if binding.ViewName == "" || binding.Tag == "global" {
+			entriesByViewName[""] = append(entriesByViewName[""], commandPaletteEntry{
+				binding: binding,
+				title:   self.c.Tr.KeybindingsMenuSectionGlobal,
+			})
+		} else if paletteContext, ok := contextsByViewName[binding.ViewName]; ok {
+			entriesByViewName[binding.ViewName] = append(entriesByViewName[binding.ViewName], commandPaletteEntry{
+				binding: binding,
+				context: paletteContext.context,
+				title:   paletteContext.title,
+			})
+		}
+	}
+
+	entries := entriesByViewName[currentContext.GetViewName()]
+	entries = append(entries, entriesByViewName[""]...)
+	for _, paletteContext := range paletteContexts {
+		if viewName := paletteContext.context.GetViewName(); viewName != currentContext.GetViewName() {
+			entries = append(entries, entriesByViewName[viewName]...)
+		}
+	}
+
+	// Some actions have several keys; only list them once
+	return lo.UniqBy(entries, func(entry commandPaletteEntry) string {
+		return entry.title + "\x00" + entry.binding.GetDescription()
+	})
+}
+
+// Returns the contexts whose bindings we offer: the side panels that can
+// always be switched to, plus the current context (which might be a nested
+// one like the commit files, or the main view)
+func (self *CommandPaletteAction) getContexts(currentContext types.Context) []commandPaletteContext {
+	contexts := self.c.Contexts()
+	tr := self.c.Tr
+	result := []commandPaletteContext{
+		{context: contexts.Status, title: tr.StatusTitle},
+		{context: contexts.Files, title: tr.FilesTitle},
+		{context: contexts.Worktrees, title: tr.WorktreesTitle},
+		{context: contexts.Submodules, title: tr.SubmodulesTitle},
+		{context: contexts.Branches, title: tr.LocalBranchesTitle},
+		{context: contexts.Remotes, title: tr.RemotesTitle},
+		{context: contexts.Tags, title: tr.TagsTitle},
+		{context: contexts.LocalCommits, title: tr.CommitsTitle},
+		{context: contexts.ReflogCommits, title: tr.ReflogCommitsTitle},
+		{context: contexts.Stash, title: tr.StashTitle},
+	}
+
+	if !lo.ContainsBy(result, func(paletteContext commandPaletteContext) bool {
+		return paletteContext.context.GetKey() == currentContext.GetKey()
+	}) {
+		title := currentContext.Title()
+		if title == "" {
+			title = currentContext.GetView().Title
+		}
+		result = append(result, commandPaletteContext{context: currentContext, title: title})
+	}
+
+	return result
+}
@@ -58,6 +58,13 @@ func (self *CommitFilesController) GetKeybindings(opts types.KeybindingsOpts) []
 			Tooltip:           self.c.Tr.CheckoutCommitFileTooltip,
 			DisplayOnScreen:   true,
 		},
+		{
+			Key:               opts.GetKey(opts.Config.CommitFiles.RestoreFileFromStash),
+			Handler:           self.withItem(self.restoreFromStash),
+			GetDisabledReason: self.require(self.singleItemSelected(self.canRestoreFromStash)),
+			Description:       self.c.Tr.RestoreFileFromStash,
+			Tooltip:           self.c.Tr.RestoreFileFromStashTooltip,
+		},
 		{
 			Key:               opts.GetKey(opts.Config.Universal.Remove),
 			Handler:           self.withItems(self.discard),
@@ -87,6 +94,13 @@ func (self *CommitFilesController) GetKeybindings(opts types.KeybindingsOpts) []
 			GetDisabledReason: self.require(self.singleItemSelected()),
 			Description:       self.c.Tr.OpenDiffTool,
 		},
+		{
+			Key:               opts.GetKey(opts.Config.Files.OpenBlame),
+			Handler:           self.withItem(self.openBlame),
+			GetDisabledReason: self.require(self.singleItemSelected(self.canBlame)),
+			Description:       self.c.Tr.OpenBlame,
+			Tooltip:           self.c.Tr.OpenBlameTooltip,
+		},
 		{
 			Key:               opts.GetKey(opts.Config.Universal.Select),
 			Handler:           self.withItems(self.toggleForPatch),
@@ -151,21 +165,35 @@ func (self *CommitFilesController) GetOnRenderToMain() func() {
 		from, to := self.context().GetFromAndToForDiff()
 		from, reverse := self.c.Modes().Diffing.GetFromAndReverseArgsForDiff(from)
 
-		cmdObj := self.c.Git().WorkingTree.ShowFileDiffCmdObj(from, to, reverse, node.GetPath(), false)
-		task := types.NewRunPtyTask(cmdObj.GetCmd())
-
-		self.c.RenderToMainViews(types.RefreshMainOpts{
-			Pair: self.c.MainViewPairs().Normal,
-			Main: &types.ViewUpdateOpts{
-				Title:    self.c.Tr.Patch,
-				SubTitle: self.c.Helpers().Diff.IgnoringWhitespaceSubTitle(),
-				Task:     task,
-			},
-			Secondary: secondaryPatchPanelUpdateOpts(self.c),
-		})
+		// The secondary view is taken when it shows the custom patch
+		secondary := secondaryPatchPanelUpdateOpts(self.c)
+		if node.IsFile() && secondary == nil {
+			cmdObj := self.c.Git().WorkingTree.SideBySideShowFileDiffCmdObj(from, to, reverse, node.GetPath())
+			renderNormally := func() { self.renderDiff(node.GetPath(), from, to, reverse, secondary) }
+			if self.c.Helpers().Diff.RenderSideBySide(self.context(), cmdObj, self.c.Tr.Patch, renderNormally) {
+				return
+			}
+		}
+
+		self.renderDiff(node.GetPath(), from, to, reverse, secondary)
 	}
 }
 
+func (self *CommitFilesController) renderDiff(path string, from string, to string, reverse bool, secondary *types.ViewUpdateOpts) {
+	cmdObj := self.c.Git().WorkingTree.ShowFileDiffCmdObj(from, to, reverse, path, false)
+	task := types.NewRunPtyTask(cmdObj.GetCmd())
+
+	self.c.RenderToMainViews(types.RefreshMainOpts{
+		Pair: self.c.MainViewPairs().Normal,
+		Main: &types.ViewUpdateOpts{
+			Title:    self.c.Tr.Patch,
+			SubTitle: self.c.Helpers().Diff.IgnoringWhitespaceSubTitle(),
+			Task:     task,
+		},
+		Secondary: secondary,
+	})
+}
+
 func (self *CommitFilesController) copyDiffToClipboard(path string, toastMessage string) error {
 	from, to := self.context().GetFromAndToForDiff()
 	from, reverse := self.c.Modes().Diffing.GetFromAndReverseArgsForDiff(from)
@@ -293,6 +321,48 @@ func (self *CommitFilesController) checkout(node *filetree.CommitFileNode) error
 	return nil
 }
 
+func (self *CommitFilesController) restoreFromStash(node *filetree.CommitFileNode) error {
+	stashEntry := self.context().GetRef().(*models.StashEntry)
+	path := node.GetPath()
+
+	restore := func() error {
+		self.c.LogAction(self.c.Tr.Actions.RestoreFileFromStash)
+		if err := self.c.Git().Stash.RestoreFile(stashEntry.Index, path); err != nil {
+			return err
+		}
+
+		self.c.Refresh(types.RefreshOptions{Mode: types.ASYNC, Scope: []types.RefreshableView{types.FILES}})
+		return nil
+	}
+
+	// Restoring overwrites the file in the working tree, so unstaged changes
+	// to it would be lost
+	if lo.SomeBy(self.c.Model().Files, func(file *models.File) bool {
+		return file.Path == path && file.HasUnstagedChanges
+	}) {
+		self.c.Confirm(types.ConfirmOpts{
+			Title:         self.c.Tr.RestoreFileFromStash,
+			Prompt:        utils.ResolvePlaceholderString(self.c.Tr.RestoreFileFromStashPrompt, map[string]string{"path": path}),
+			HandleConfirm: restore,
+		})
+		return nil
+	}
+
+	return restore()
+}
+
+func (self *CommitFilesController) canRestoreFromStash(node *filetree.CommitFileNode) *types.DisabledReason {
+	if _, ok := self.context().GetRef().(*models.StashEntry); !ok {
+		return &types.DisabledReason{Text: self.c.Tr.CanOnlyRestoreFromStash}
+	}
+
+	if !node.IsFile() {
+		return &types.DisabledReason{Text: self.c.Tr.CannotRestoreDirectoryFromStash}
+	}
+
+	return nil
+}
+
 func (self *CommitFilesController) discard(selectedNodes []*filetree.CommitFileNode) error {
 	parentContext := self.c.Context().Current().GetParentContext()
 	if parentContext == nil || parentContext.GetKey() != context.LOCAL_COMMITS_CONTEXT_KEY {
@@ -377,6 +447,22 @@ func (self *CommitFilesController) openDiffTool(node *filetree.CommitFileNode) e
 	return err
 }
 
+func (self *CommitFilesController) openBlame(node *filetree.CommitFileNode) error {
+	from, to := self.context().GetFromAndToForDiff()
+	// A deleted file only exists in the diff's base, so that's the only place
+	// where we can blame it
+	ref := lo.Ternary(node.File.Deleted(), from, to)
+	return self.c.Helpers().Blame.OpenBlame(node.GetPath(), ref)
+}
+
+func (self *CommitFilesController) canBlame(node *filetree.CommitFileNode) *types.DisabledReason {
+	if !node.IsFile() {
+		return &types.DisabledReason{Text: self.c.Tr.CannotBlameDirectory}
+	}
+
+	return nil
+}
+
 func (self *CommitFilesController) toggleForPatch(selectedNodes []*filetree.CommitFileNode) error {
 	if self.c.UserConfig().Git.DiffContextSize == 0 {
 		return fmt.Errorf(self.c.Tr.Actions.NotEnoughContextForCustomPatch,
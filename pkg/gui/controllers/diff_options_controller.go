@@ -0,0 +1,104 @@
+package controllers
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/gui/types"
+)
+
+// This controller lets you change how git computes diffs (the diff algorithm
+// and whether to detect copies) for the rest of the session.
+
+type DiffOptionsController struct {
+	baseController
+	c *ControllerCommon
+}
+
+var _ types.IController = &DiffOptionsController{}
+
+func NewDiffOptionsController(
+	c *ControllerCommon,
+) *DiffOptionsController {
+	return &DiffOptionsController{
+		baseController: baseController{},
+		c:              c,
+	}
+}
+
+func (self *DiffOptionsController) GetKeybindings(opts types.KeybindingsOpts) []*types.Binding {
+	bindings := []*types.Binding{
+		{
+			Key:         opts.GetKey(opts.Config.Universal.DiffOptionsMenu),
+			Handler:     self.openMenu,
+			Description: self.c.Tr.DiffOptionsMenu,
+			Tooltip:     self.c.Tr.DiffOptionsMenuTooltip,
+			OpensMenu:   true,
+		},
+	}
+
+	return bindings
+}
+
+func (self *DiffOptionsController) Context() types.Context {
+	return nil
+}
+
+func (self *DiffOptionsController) openMenu() error {
+	gitConfig := &self.c.UserConfig().Git
+
+	// Custom patches are made of line indices into the diffs they were built
+	// from, so they'd no longer match if the diffs changed underneath them.
+	var algorithmDisabledReason *types.DisabledReason
+	if self.c.Git().Patch.PatchBuilder.Active() {
+		algorithmDisabledReason = &types.DisabledReason{Text: self.c.Tr.CantChangeDiffAlgorithmError}
+	}
+
+	algorithms := []struct {
+		value   string
+		label   string
+		tooltip string
+		key     types.Key
+	}{
+		{"default", self.c.Tr.DefaultDiffAlgorithm, self.c.Tr.DefaultDiffAlgorithmTooltip, 'd'},
+		{"myers", self.c.Tr.MyersDiffAlgorithm, self.c.Tr.MyersDiffAlgorithmTooltip, 'm'},
+		{"minimal", self.c.Tr.MinimalDiffAlgorithm, self.c.Tr.MinimalDiffAlgorithmTooltip, 'i'},
+		{"patience", self.c.Tr.PatienceDiffAlgorithm, self.c.Tr.PatienceDiffAlgorithmTooltip, 'p'},
+		{"histogram", self.c.Tr.HistogramDiffAlgorithm, self.c.Tr.HistogramDiffAlgorithmTooltip, 'h'},
+	}
+
+	menuItems := []*types.MenuItem{}
+	for _, algorithm := range algorithms {
+		menuItems = append(menuItems, &types.MenuItem{
+			Label:   algorithm.label,
+			Tooltip: algorithm.tooltip,
+			OnPress: func() error {
+				gitConfig.DiffAlgorithm = algorithm.value
+				self.rerenderDiffs()
+				return nil
+			},
+			Key:            algorithm.key,
+			Widget:         types.MakeMenuRadioButton(gitConfig.DiffAlgorithm == algorithm.value),
+			DisabledReason: algorithmDisabledReason,
+		})
+	}
+
+	menuItems = append(menuItems, &types.MenuItem{
+		Label:   self.c.Tr.DetectCopies,
+		Tooltip: self.c.Tr.DetectCopiesTooltip,
+		OnPress: func() error {
+			gitConfig.FindCopiesInDiffView = !gitConfig.FindCopiesInDiffView
+			self.rerenderDiffs()
+			return nil
+		},
+		Key:    'c',
+		Widget: types.MakeMenuCheckBox(gitConfig.FindCopiesInDiffView),
+	})
+
+	return self.c.Menu(types.CreateMenuOptions{
+		Title: self.c.Tr.DiffOptionsMenuTitle,
+		Items: menuItems,
+	})
+}
+
+func (self *DiffOptionsController) rerenderDiffs() {
+	// This also reloads the commit files, whose statuses depend on copy detection
+	self.c.Refresh(types.RefreshOptions{Mode: types.ASYNC})
+}
@@ -4,8 +4,10 @@ import (
 	"fmt"
 	"strings"
 
+	"github.com/jesseduffield/lazygit/pkg/gui/context"
 	"github.com/jesseduffield/lazygit/pkg/gui/modes/diffing"
 	"github.com/jesseduffield/lazygit/pkg/gui/types"
+	"github.com/samber/lo"
 )
 
 type DiffingMenuAction struct {
@@ -22,11 +24,22 @@ func (self *DiffingMenuAction) Call() error {
 				Label: fmt.Sprintf("%s %s", self.c.Tr.Diff, name),
 				OnPress: func() error {
 					self.c.Modes().Diffing.Ref = name
+					self.c.Modes().Diffing.RangeDiff = false
 					// can scope this down based on current view but too lazy right now
 					self.c.Refresh(types.RefreshOptions{Mode: types.ASYNC})
 					return nil
 				},
 			},
+			{
+				Label: fmt.Sprintf("%s %s", self.c.Tr.RangeDiffAgainst, name),
+				OnPress: func() error {
+					self.c.Modes().Diffing.Ref = name
+					self.c.Modes().Diffing.RangeDiff = true
+					self.c.Refresh(types.RefreshOptions{Mode: types.ASYNC})
+					return nil
+				},
+				Tooltip: self.c.Tr.RangeDiffAgainstTooltip,
+			},
 		}...)
 	}
 
@@ -59,6 +72,14 @@ func (self *DiffingMenuAction) Call() error {
 					return nil
 				},
 			},
+			{
+				Label: lo.Ternary(self.c.Modes().Diffing.RangeDiff, self.c.Tr.ShowRegularDiff, self.c.Tr.ShowRangeDiff),
+				OnPress: func() error {
+					self.c.Modes().Diffing.RangeDiff = !self.c.Modes().Diffing.RangeDiff
+					self.c.Refresh(types.RefreshOptions{Mode: types.ASYNC})
+					return nil
+				},
+			},
 			{
 				Label: self.c.Tr.ExitDiffMode,
 				OnPress: func() error {
@@ -70,5 +91,55 @@ func (self *DiffingMenuAction) Call() error {
 		}...)
 	}
 
+	gitConfig := &self.c.UserConfig().Git
+	menuItems = append(menuItems, []*types.MenuItem{
+		{
+			Label:   lo.Ternary(gitConfig.WordDiffInDiffView, self.c.Tr.HideWordDiff, self.c.Tr.ShowWordDiff),
+			Tooltip: self.c.Tr.WordDiffTooltip,
+			OnPress: func() error {
+				gitConfig.WordDiffInDiffView = !gitConfig.WordDiffInDiffView
+				self.rerenderDiffs()
+				return nil
+			},
+		},
+		{
+			Label:   lo.Ternary(gitConfig.ColorMovedInDiffView, self.c.Tr.HideMovedLines, self.c.Tr.ShowMovedLines),
+			Tooltip: self.c.Tr.MovedLinesTooltip,
+			OnPress: func() error {
+				gitConfig.ColorMovedInDiffView = !gitConfig.ColorMovedInDiffView
+				self.rerenderDiffs()
+				return nil
+			},
+		},
+		{
+			Label:   lo.Ternary(gitConfig.SideBySideDiffInDiffView, self.c.Tr.HideSideBySideDiff, self.c.Tr.ShowSideBySideDiff),
+			Tooltip: self.c.Tr.SideBySideDiffTooltip,
+			OnPress: func() error {
+				gitConfig.SideBySideDiffInDiffView = !gitConfig.SideBySideDiffInDiffView
+				self.rerenderDiffs()
+				return nil
+			},
+		},
+		{
+			Label:   lo.Ternary(gitConfig.SyntaxHighlightingInDiffView, self.c.Tr.HideSyntaxHighlighting, self.c.Tr.ShowSyntaxHighlighting),
+			Tooltip: self.c.Tr.SyntaxHighlightingTooltip,
+			OnPress: func() error {
+				gitConfig.SyntaxHighlightingInDiffView = !gitConfig.SyntaxHighlightingInDiffView
+				self.rerenderDiffs()
+				return nil
+			},
+		},
+	}...)
+
 	return self.c.Menu(types.CreateMenuOptions{Title: self.c.Tr.DiffingMenuTitle, Items: menuItems})
 }
+
+func (self *DiffingMenuAction) rerenderDiffs() {
+	// The patch building view isn't covered by a regular refresh
+	if self.c.Context().Current().GetKey() == context.PATCH_BUILDING_MAIN_CONTEXT_KEY {
+		self.c.Refresh(types.RefreshOptions{Mode: types.ASYNC, Scope: []types.RefreshableView{types.PATCH_BUILDING}})
+		return
+	}
+
+	self.c.Refresh(types.RefreshOptions{Mode: types.ASYNC})
+}
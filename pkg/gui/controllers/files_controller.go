@@ -3,6 +3,7 @@ package controllers
 import (
 	"errors"
 	"fmt"
+	"os"
 	"path/filepath"
 	"strings"
 
@@ -12,6 +13,8 @@ import (
 	"github.com/jesseduffield/lazygit/pkg/commands/models"
 	"github.com/jesseduffield/lazygit/pkg/gui/context"
 	"github.com/jesseduffield/lazygit/pkg/gui/filetree"
+	"github.com/jesseduffield/lazygit/pkg/gui/keybindings"
+	"github.com/jesseduffield/lazygit/pkg/gui/modes/hunk_review"
 	"github.com/jesseduffield/lazygit/pkg/gui/types"
 	"github.com/jesseduffield/lazygit/pkg/utils"
 	"github.com/samber/lo"
@@ -21,6 +24,10 @@ type FilesController struct {
 	baseController
 	*ListControllerTrait[*filetree.FileNode]
 	c *ControllerCommon
+
+	// the LFS file whose locks the user asked to see; we stop showing them
+	// once another file is selected
+	lfsLocksPath string
 }
 
 var _ types.IController = &FilesController{}
@@ -123,11 +130,12 @@ func (self *FilesController) GetKeybindings(opts types.KeybindingsOpts) []*types
 			DisplayOnScreen: true,
 		},
 		{
-			Key:         opts.GetKey(opts.Config.Files.ViewStashOptions),
-			Handler:     self.createStashMenu,
-			Description: self.c.Tr.ViewStashOptions,
-			Tooltip:     self.c.Tr.ViewStashOptionsTooltip,
-			OpensMenu:   true,
+			Key:          opts.GetKey(opts.Config.Files.ViewStashOptions),
+			Handler:      self.createStashMenu,
+			Description:  self.c.Tr.ViewStashOptions,
+			Tooltip:      self.c.Tr.ViewStashOptionsTooltip,
+			OpensMenu:    true,
+			GetMenuItems: self.stashMenuItems,
 		},
 		{
 			Key:         opts.GetKey(opts.Config.Files.ToggleStagedAll),
@@ -177,6 +185,35 @@ func (self *FilesController) GetKeybindings(opts types.KeybindingsOpts) []*types
 			GetDisabledReason: self.require(self.singleItemSelected()),
 			Description:       self.c.Tr.OpenDiffTool,
 		},
+		{
+			Key:               opts.GetKey(opts.Config.Files.OpenBlame),
+			Handler:           self.withItem(self.openBlame),
+			GetDisabledReason: self.require(self.singleItemSelected(self.canBlame)),
+			Description:       self.c.Tr.OpenBlame,
+			Tooltip:           self.c.Tr.OpenBlameTooltip,
+		},
+		{
+			Key:               opts.GetKey(opts.Config.Files.ToggleSparseCheckoutDir),
+			Handler:           self.withItem(self.toggleSparseCheckoutDir),
+			GetDisabledReason: self.require(self.singleItemSelected(self.canToggleSparseCheckoutDir)),
+			Description:       self.c.Tr.ToggleSparseCheckoutDir,
+			Tooltip:           self.c.Tr.ToggleSparseCheckoutDirTooltip,
+		},
+		{
+			Key:               opts.GetKey(opts.Config.Files.ViewLfsLockOptions),
+			Handler:           self.withItem(self.openLfsLockMenu),
+			GetDisabledReason: self.require(self.singleItemSelected(self.isLfsFile)),
+			Description:       self.c.Tr.ViewLfsLockOptions,
+			Tooltip:           self.c.Tr.ViewLfsLockOptionsTooltip,
+			OpensMenu:         true,
+		},
+		{
+			Key:         opts.GetKey(opts.Config.Files.ReviewHunks),
+			Handler:     self.reviewHunks,
+			Description: self.c.Tr.ReviewHunks,
+			Tooltip:     self.c.Tr.ReviewHunksTooltip,
+			OpensMenu:   true,
+		},
 		{
 			Key:               opts.GetKey(opts.Config.Files.OpenMergeOptions),
 			Handler:           self.withItems(self.openMergeConflictMenu),
@@ -282,41 +319,127 @@ func (self *FilesController) GetOnRenderToMain() func() {
 
 			self.c.Helpers().MergeConflicts.ResetMergeState()
 
+			if node.GetPath() != self.lfsLocksPath {
+				self.lfsLocksPath = ""
+			}
+
+			if node.File != nil && node.File.IsLfs {
+				self.renderLfsSummary(node.File)
+				return
+			}
+
 			split := self.c.UserConfig().Gui.SplitDiff == "always" || (node.GetHasUnstagedChanges() && node.GetHasStagedChanges())
 			mainShowsStaged := !split && node.GetHasStagedChanges()
 
-			cmdObj := self.c.Git().WorkingTree.WorktreeFileDiffCmdObj(node, false, mainShowsStaged)
-			title := self.c.Tr.UnstagedChanges
-			if mainShowsStaged {
-				title = self.c.Tr.StagedChanges
-			}
-			refreshOpts := types.RefreshMainOpts{
-				Pair: self.c.MainViewPairs().Normal,
-				Main: &types.ViewUpdateOpts{
-					Task:     types.NewRunPtyTask(cmdObj.GetCmd()),
-					SubTitle: self.c.Helpers().Diff.IgnoringWhitespaceSubTitle(),
-					Title:    title,
-				},
+			if !split && node.IsFile() {
+				title := lo.Ternary(mainShowsStaged, self.c.Tr.StagedChanges, self.c.Tr.UnstagedChanges)
+				cmdObj := self.c.Git().WorkingTree.SideBySideWorktreeFileDiffCmdObj(node, mainShowsStaged)
+				renderNormally := func() { self.renderDiff(node, split, mainShowsStaged) }
+				if self.c.Helpers().Diff.RenderSideBySide(self.context(), cmdObj, title, renderNormally) {
+					return
+				}
 			}
 
-			if split {
-				cmdObj := self.c.Git().WorkingTree.WorktreeFileDiffCmdObj(node, false, true)
+			self.renderDiff(node, split, mainShowsStaged)
+		})
+	}
+}
+
+func (self *FilesController) renderDiff(node *filetree.FileNode, split bool, mainShowsStaged bool) {
+	cmdObj := self.c.Git().WorkingTree.WorktreeFileDiffCmdObj(node, false, mainShowsStaged)
+	title := self.c.Tr.UnstagedChanges
+	if mainShowsStaged {
+		title = self.c.Tr.StagedChanges
+	}
+	refreshOpts := types.RefreshMainOpts{
+		Pair: self.c.MainViewPairs().Normal,
+		Main: &types.ViewUpdateOpts{
+			Task:     types.NewRunPtyTask(cmdObj.GetCmd()),
+			SubTitle: self.c.Helpers().Diff.IgnoringWhitespaceSubTitle(),
+			Title:    title,
+		},
+	}
+
+	if split {
+		cmdObj := self.c.Git().WorkingTree.WorktreeFileDiffCmdObj(node, false, true)
 
-				title := self.c.Tr.StagedChanges
-				if mainShowsStaged {
-					title = self.c.Tr.UnstagedChanges
-				}
+		title := self.c.Tr.StagedChanges
+		if mainShowsStaged {
+			title = self.c.Tr.UnstagedChanges
+		}
 
-				refreshOpts.Secondary = &types.ViewUpdateOpts{
-					Title:    title,
-					SubTitle: self.c.Helpers().Diff.IgnoringWhitespaceSubTitle(),
-					Task:     types.NewRunPtyTask(cmdObj.GetCmd()),
-				}
+		refreshOpts.Secondary = &types.ViewUpdateOpts{
+			Title:    title,
+			SubTitle: self.c.Helpers().Diff.IgnoringWhitespaceSubTitle(),
+			Task:     types.NewRunPtyTask(cmdObj.GetCmd()),
+		}
+	}
+
+	self.c.RenderToMainViews(refreshOpts)
+}
+
+// The diff of an LFS file is just the diff of its pointer file, which isn't
+// useful, so we show a summary of the file's versions instead. Getting the
+// versions means running git, so we do that on a worker and only render the
+// result if the file is still selected by then. Showing the locks means asking
+// the LFS server, so we only do that when asked to (see lfsLocksPath).
+func (self *FilesController) renderLfsSummary(file *models.File) {
+	self.c.OnWorker(func(gocui.Task) error {
+		summary := self.lfsSummary(file)
+
+		self.c.OnUIThread(func() error {
+			if self.c.Context().CurrentSide() != self.context() {
+				return nil
+			}
+			node := self.context().GetSelected()
+			if node == nil || node.File == nil || node.File.Path != file.Path {
+				return nil
 			}
 
-			self.c.RenderToMainViews(refreshOpts)
+			var task types.UpdateTask = types.NewRenderStringTask(summary + "\n\n" + utils.ResolvePlaceholderString(
+				self.c.Tr.LfsLocksHint, map[string]string{
+					"key": keybindings.Label(self.c.UserConfig().Keybinding.Files.ViewLfsLockOptions),
+				}))
+			if self.lfsLocksPath == file.Path {
+				cmdObj := self.c.Git().Lfs.LocksCmdObj(file.Path)
+				task = types.NewRunCommandTaskWithPrefix(cmdObj.GetCmd(), summary+"\n\n"+self.c.Tr.LfsLocks+"\n")
+			}
+			self.c.RenderToMainViews(types.RefreshMainOpts{
+				Pair: self.c.MainViewPairs().Normal,
+				Main: &types.ViewUpdateOpts{
+					Title: self.c.Tr.LfsFileTitle,
+					Task:  task,
+				},
+			})
+			return nil
 		})
+		return nil
+	})
+}
+
+func (self *FilesController) lfsSummary(file *models.File) string {
+	formatPointer := func(pointer *git_commands.LfsPointer) string {
+		if pointer == nil {
+			return self.c.Tr.LfsNotPresent
+		}
+		return fmt.Sprintf("%s (%s)", pointer.Oid, utils.FormatBytes(pointer.Size))
 	}
+
+	rows := [][]string{
+		{self.c.Tr.LfsCommitted, formatPointer(self.c.Git().Lfs.Pointer("HEAD", file.Path))},
+	}
+	if file.HasStagedChanges {
+		rows = append(rows, []string{self.c.Tr.LfsStaged, formatPointer(self.c.Git().Lfs.Pointer("", file.Path))})
+	}
+	workingTreeSize := self.c.Tr.LfsNotPresent
+	if info, err := os.Stat(filepath.Join(self.c.Git().RepoPaths.WorktreePath(), file.Path)); err == nil {
+		workingTreeSize = utils.FormatBytes(info.Size())
+	}
+	rows = append(rows, []string{self.c.Tr.LfsWorkingTree, workingTreeSize})
+
+	lines, _ := utils.RenderDisplayStrings(rows, nil)
+	return utils.ResolvePlaceholderString(self.c.Tr.LfsFileSummary, map[string]string{"path": file.Path}) +
+		"\n\n" + strings.Join(lines, "\n")
 }
 
 func (self *FilesController) GetOnClick() func() error {
@@ -514,6 +637,49 @@ func (self *FilesController) enter() error {
 	return self.EnterFile(types.OnFocusOpts{ClickedWindowName: "", ClickedViewLineIdx: -1})
 }
 
+func (self *FilesController) reviewHunks() error {
+	withFilter := func(filterLines hunk_review.FilterLines) func() error {
+		return func() error {
+			self.c.Prompt(types.PromptOpts{
+				Title: self.c.Tr.ReviewHunksFilterPrompt,
+				HandleConfirm: func(filter string) error {
+					return self.c.Helpers().Staging.StartHunkReview(filter, filterLines)
+				},
+			})
+
+			return nil
+		}
+	}
+
+	return self.c.Menu(types.CreateMenuOptions{
+		Title: self.c.Tr.ReviewHunks,
+		Items: []*types.MenuItem{
+			{
+				Label: self.c.Tr.ReviewAllHunks,
+				OnPress: func() error {
+					return self.c.Helpers().Staging.StartHunkReview("", hunk_review.AddedOrRemovedLines)
+				},
+				Key: 'a',
+			},
+			{
+				Label:   self.c.Tr.ReviewHunksWithAddedLine,
+				OnPress: withFilter(hunk_review.AddedLines),
+				Key:     '+',
+			},
+			{
+				Label:   self.c.Tr.ReviewHunksWithRemovedLine,
+				OnPress: withFilter(hunk_review.RemovedLines),
+				Key:     '-',
+			},
+			{
+				Label:   self.c.Tr.ReviewHunksWithChangedLine,
+				OnPress: withFilter(hunk_review.AddedOrRemovedLines),
+				Key:     'c',
+			},
+		},
+	})
+}
+
 func (self *FilesController) collapseAll() error {
 	self.context().FileTreeViewModel.CollapseAll()
 
@@ -969,62 +1135,94 @@ func (self *FilesController) switchToMerge() error {
 func (self *FilesController) createStashMenu() error {
 	return self.c.Menu(types.CreateMenuOptions{
 		Title: self.c.Tr.StashOptions,
-		Items: []*types.MenuItem{
-			{
-				Label: self.c.Tr.StashAllChanges,
-				OnPress: func() error {
-					if !self.c.Helpers().WorkingTree.IsWorkingTreeDirtyExceptSubmodules() {
-						return errors.New(self.c.Tr.NoFilesToStash)
-					}
-					return self.handleStashSave(self.c.Git().Stash.Push, self.c.Tr.Actions.StashAllChanges)
-				},
-				Key: 'a',
+		Items: self.stashMenuItems(),
+	})
+}
+
+func (self *FilesController) stashMenuItems() []*types.MenuItem {
+	return []*types.MenuItem{
+		{
+			Label: self.c.Tr.StashAllChanges,
+			OnPress: func() error {
+				if !self.c.Helpers().WorkingTree.IsWorkingTreeDirtyExceptSubmodules() {
+					return errors.New(self.c.Tr.NoFilesToStash)
+				}
+				return self.handleStashSave(self.c.Git().Stash.Push, self.c.Tr.Actions.StashAllChanges)
 			},
-			{
-				Label: self.c.Tr.StashAllChangesKeepIndex,
-				OnPress: func() error {
-					if !self.c.Helpers().WorkingTree.IsWorkingTreeDirtyExceptSubmodules() {
-						return errors.New(self.c.Tr.NoFilesToStash)
-					}
-					// if there are no staged files it behaves the same as Stash.Save
-					return self.handleStashSave(self.c.Git().Stash.StashAndKeepIndex, self.c.Tr.Actions.StashAllChangesKeepIndex)
-				},
-				Key: 'i',
+			Key: 'a',
+		},
+		{
+			Label: self.c.Tr.StashAllChangesKeepIndex,
+			OnPress: func() error {
+				if !self.c.Helpers().WorkingTree.IsWorkingTreeDirtyExceptSubmodules() {
+					return errors.New(self.c.Tr.NoFilesToStash)
+				}
+				// if there are no staged files it behaves the same as Stash.Save
+				return self.handleStashSave(self.c.Git().Stash.StashAndKeepIndex, self.c.Tr.Actions.StashAllChangesKeepIndex)
 			},
-			{
-				Label: self.c.Tr.StashIncludeUntrackedChanges,
-				OnPress: func() error {
-					return self.handleStashSave(self.c.Git().Stash.StashIncludeUntrackedChanges, self.c.Tr.Actions.StashIncludeUntrackedChanges)
-				},
-				Key: 'U',
+			Key: 'i',
+		},
+		{
+			Label: self.c.Tr.StashIncludeUntrackedChanges,
+			OnPress: func() error {
+				return self.handleStashSave(self.c.Git().Stash.StashIncludeUntrackedChanges, self.c.Tr.Actions.StashIncludeUntrackedChanges)
 			},
-			{
-				Label: self.c.Tr.StashStagedChanges,
-				OnPress: func() error {
-					// there must be something in staging otherwise the current implementation mucks the stash up
-					if !self.c.Helpers().WorkingTree.AnyStagedFilesExceptSubmodules() {
-						return errors.New(self.c.Tr.NoTrackedStagedFilesStash)
-					}
-					return self.handleStashSave(self.c.Git().Stash.SaveStagedChanges, self.c.Tr.Actions.StashStagedChanges)
-				},
-				Key: 's',
+			Key: 'U',
+		},
+		{
+			Label: self.c.Tr.StashStagedChanges,
+			OnPress: func() error {
+				// there must be something in staging otherwise the current implementation mucks the stash up
+				if !self.c.Helpers().WorkingTree.AnyStagedFilesExceptSubmodules() {
+					return errors.New(self.c.Tr.NoTrackedStagedFilesStash)
+				}
+				return self.handleStashSave(self.c.Git().Stash.SaveStagedChanges, self.c.Tr.Actions.StashStagedChanges)
 			},
-			{
-				Label: self.c.Tr.StashUnstagedChanges,
-				OnPress: func() error {
-					if !self.c.Helpers().WorkingTree.IsWorkingTreeDirtyExceptSubmodules() {
-						return errors.New(self.c.Tr.NoFilesToStash)
-					}
-					if self.c.Helpers().WorkingTree.AnyStagedFilesExceptSubmodules() {
-						return self.handleStashSave(self.c.Git().Stash.StashUnstagedChanges, self.c.Tr.Actions.StashUnstagedChanges)
-					}
-					// ordinary stash
-					return self.handleStashSave(self.c.Git().Stash.Push, self.c.Tr.Actions.StashUnstagedChanges)
-				},
-				Key: 'u',
+			Key: 's',
+		},
+		{
+			Label: self.c.Tr.StashUnstagedChanges,
+			OnPress: func() error {
+				if !self.c.Helpers().WorkingTree.IsWorkingTreeDirtyExceptSubmodules() {
+					return errors.New(self.c.Tr.NoFilesToStash)
+				}
+				if self.c.Helpers().WorkingTree.AnyStagedFilesExceptSubmodules() {
+					return self.handleStashSave(self.c.Git().Stash.StashUnstagedChanges, self.c.Tr.Actions.StashUnstagedChanges)
+				}
+				// ordinary stash
+				return self.handleStashSave(self.c.Git().Stash.Push, self.c.Tr.Actions.StashUnstagedChanges)
+			},
+			Key: 'u',
+		},
+		{
+			Label:   self.c.Tr.StashSelectedPaths,
+			Tooltip: self.c.Tr.StashSelectedPathsTooltip,
+			OnPress: func() error {
+				selectedNodes, _, _ := self.context().GetSelectedItems()
+				return self.stashSelectedPaths(normalisedSelectedNodes(selectedNodes))
 			},
+			DisabledReason: self.require(self.itemsSelected())(),
+			Key:            'p',
 		},
+	}
+}
+
+func (self *FilesController) stashSelectedPaths(selectedNodes []*filetree.FileNode) error {
+	paths := lo.FlatMap(selectedNodes, func(node *filetree.FileNode, _ int) []string {
+		// A rename is only stashed completely if both of its paths are passed
+		if node.File != nil {
+			return node.File.Names()
+		}
+		return []string{node.GetPath()}
+	})
+
+	includeUntracked := lo.SomeBy(selectedNodes, func(node *filetree.FileNode) bool {
+		return node.SomeFile(func(file *models.File) bool { return !file.Tracked })
 	})
+
+	return self.handleStashSave(func(message string) error {
+		return self.c.Git().Stash.PushPaths(message, paths, includeUntracked)
+	}, self.c.Tr.Actions.StashSelectedPaths)
 }
 
 func (self *FilesController) openMergeConflictMenu(nodes []*filetree.FileNode) error {
@@ -1055,6 +1253,126 @@ func (self *FilesController) canOpenMergeConflictMenu(nodes []*filetree.FileNode
 	return nil
 }
 
+func (self *FilesController) openBlame(node *filetree.FileNode) error {
+	return self.c.Helpers().Blame.OpenBlame(node.GetPath(), "")
+}
+
+func (self *FilesController) canBlame(node *filetree.FileNode) *types.DisabledReason {
+	if node.File == nil {
+		return &types.DisabledReason{Text: self.c.Tr.CannotBlameDirectory}
+	}
+
+	if !node.File.Tracked {
+		return &types.DisabledReason{Text: self.c.Tr.CannotBlameUntrackedFile}
+	}
+
+	return nil
+}
+
+func (self *FilesController) openLfsLockMenu(node *filetree.FileNode) error {
+	path := node.GetPath()
+
+	unlock := func(force bool) error {
+		return self.c.WithWaitingStatus(self.c.Tr.UnlockingLfsFile, func(task gocui.Task) error {
+			self.c.LogAction(self.c.Tr.Actions.UnlockLfsFile)
+			if err := self.c.Git().Lfs.Unlock(task, path, force); err != nil {
+				return err
+			}
+			self.c.Refresh(types.RefreshOptions{Mode: types.ASYNC, Scope: []types.RefreshableView{types.FILES}})
+			return nil
+		})
+	}
+
+	return self.c.Menu(types.CreateMenuOptions{
+		Title: self.c.Tr.LfsLockTitle,
+		Items: []*types.MenuItem{
+			{
+				Label:   self.c.Tr.LfsShowLocks,
+				Tooltip: self.c.Tr.LfsShowLocksTooltip,
+				Key:     's',
+				OnPress: func() error {
+					self.lfsLocksPath = path
+					self.context().HandleRenderToMain()
+					return nil
+				},
+			},
+			{
+				Label:   self.c.Tr.LfsLock,
+				Tooltip: self.c.Tr.LfsLockTooltip,
+				Key:     'l',
+				OnPress: func() error {
+					return self.c.WithWaitingStatus(self.c.Tr.LockingLfsFile, func(task gocui.Task) error {
+						self.c.LogAction(self.c.Tr.Actions.LockLfsFile)
+						if err := self.c.Git().Lfs.Lock(task, path); err != nil {
+							return err
+						}
+						self.c.Refresh(types.RefreshOptions{Mode: types.ASYNC, Scope: []types.RefreshableView{types.FILES}})
+						return nil
+					})
+				},
+			},
+			{
+				Label:   self.c.Tr.LfsUnlock,
+				Tooltip: self.c.Tr.LfsUnlockTooltip,
+				Key:     'u',
+				OnPress: func() error {
+					return unlock(false)
+				},
+			},
+			{
+				Label:   self.c.Tr.LfsForceUnlock,
+				Tooltip: self.c.Tr.LfsForceUnlockTooltip,
+				Key:     'f',
+				OnPress: func() error {
+					self.c.Confirm(types.ConfirmOpts{
+						Title:  self.c.Tr.LfsForceUnlock,
+						Prompt: utils.ResolvePlaceholderString(self.c.Tr.LfsForceUnlockPrompt, map[string]string{"path": path}),
+						HandleConfirm: func() error {
+							return unlock(true)
+						},
+					})
+
+					return nil
+				},
+			},
+		},
+	})
+}
+
+func (self *FilesController) isLfsFile(node *filetree.FileNode) *types.DisabledReason {
+	if node.File == nil || !node.File.IsLfs {
+		return &types.DisabledReason{Text: self.c.Tr.NotAnLfsFile}
+	}
+
+	return nil
+}
+
+func (self *FilesController) toggleSparseCheckoutDir(node *filetree.FileNode) error {
+	return self.c.Helpers().SparseCheckout.ToggleDir(node.SparseConeDir())
+}
+
+func (self *FilesController) canToggleSparseCheckoutDir(node *filetree.FileNode) *types.DisabledReason {
+	if !self.c.Model().IsSparseCheckout {
+		return &types.DisabledReason{Text: self.c.Tr.SparseCheckoutNotEnabled}
+	}
+
+	dir := node.SparseConeDir()
+	if dir == "" {
+		return &types.DisabledReason{Text: self.c.Tr.SparseCheckoutRootDirAlwaysIncluded}
+	}
+
+	// Cone mode can only include whole directories, so there's nothing to
+	// toggle for a directory inside one of them
+	if coneDir, ok := lo.Find(self.c.Model().SparseCheckoutDirs, func(coneDir string) bool {
+		return strings.HasPrefix(dir, coneDir+"/")
+	}); ok {
+		return &types.DisabledReason{Text: utils.ResolvePlaceholderString(self.c.Tr.SparseCheckoutDirInsideConeDir,
+			map[string]string{"dir": dir, "coneDir": coneDir})}
+	}
+
+	return nil
+}
+
 func (self *FilesController) openCopyMenu() error {
 	node := self.context().GetSelected()
 
@@ -1232,6 +1550,8 @@ func (self *FilesController) fetch() error {
 		self.c.Refresh(types.RefreshOptions{Scope: []types.RefreshableView{types.BRANCHES, types.COMMITS, types.REMOTES, types.TAGS}, Mode: types.SYNC})
 
 		if err == nil {
+			self.c.Helpers().PullRequests.Refresh()
+			self.c.Helpers().CheckStatus.OnFetched()
 			err = self.c.Helpers().BranchesHelper.AutoForwardBranches()
 		}
 
@@ -4,7 +4,6 @@ import (
 	"fmt"
 	"strings"
 
-	"github.com/jesseduffield/lazygit/pkg/gui/controllers/helpers"
 	"github.com/jesseduffield/lazygit/pkg/gui/types"
 )
 
@@ -91,6 +90,51 @@ func (self *FilteringMenuAction) Call() error {
 		Tooltip: tooltip,
 	})
 
+	menuItems = append(menuItems, &types.MenuItem{
+		Label: self.c.Tr.FilterPickaxeOption,
+		OnPress: func() error {
+			self.c.Prompt(types.PromptOpts{
+				Title: self.c.Tr.EnterPickaxeString,
+				HandleConfirm: func(response string) error {
+					return self.setFilteringPickaxe(response)
+				},
+			})
+
+			return nil
+		},
+		Tooltip: self.withCancelNote(self.c.Tr.FilterPickaxeOptionTooltip, tooltip),
+	})
+
+	menuItems = append(menuItems, &types.MenuItem{
+		Label: self.c.Tr.FilterPickaxeRegexOption,
+		OnPress: func() error {
+			self.c.Prompt(types.PromptOpts{
+				Title: self.c.Tr.EnterPickaxeRegex,
+				HandleConfirm: func(response string) error {
+					return self.setFilteringPickaxeRegex(response)
+				},
+			})
+
+			return nil
+		},
+		Tooltip: self.withCancelNote(self.c.Tr.FilterPickaxeRegexOptionTooltip, tooltip),
+	})
+
+	menuItems = append(menuItems, &types.MenuItem{
+		Label: self.c.Tr.FilterMessageOption,
+		OnPress: func() error {
+			self.c.Prompt(types.PromptOpts{
+				Title: self.c.Tr.EnterMessagePattern,
+				HandleConfirm: func(response string) error {
+					return self.setFilteringMessage(response)
+				},
+			})
+
+			return nil
+		},
+		Tooltip: self.withCancelNote(self.c.Tr.FilterMessageOptionTooltip, tooltip),
+	})
+
 	if self.c.Modes().Filtering.Active() {
 		menuItems = append(menuItems, &types.MenuItem{
 			Label:   self.c.Tr.ExitFilterMode,
@@ -113,20 +157,44 @@ func (self *FilteringMenuAction) setFilteringAuthor(author string) error {
 	return self.setFiltering()
 }
 
-func (self *FilteringMenuAction) setFiltering() error {
-	self.c.Modes().Filtering.SetSelectedCommitHash(self.c.Contexts().LocalCommits.GetSelectedCommitHash())
+func (self *FilteringMenuAction) setFilteringPickaxe(pickaxe string) error {
+	if pickaxe == "" {
+		return nil
+	}
+
+	self.c.Modes().Filtering.Reset()
+	self.c.Modes().Filtering.SetPickaxe(pickaxe)
+	return self.setFiltering()
+}
 
-	repoState := self.c.State().GetRepoState()
-	if repoState.GetScreenMode() == types.SCREEN_NORMAL {
-		repoState.SetScreenMode(types.SCREEN_HALF)
+func (self *FilteringMenuAction) setFilteringPickaxeRegex(pickaxeRegex string) error {
+	if pickaxeRegex == "" {
+		return nil
 	}
 
-	self.c.Context().Push(self.c.Contexts().LocalCommits, types.OnFocusOpts{})
+	self.c.Modes().Filtering.Reset()
+	self.c.Modes().Filtering.SetPickaxeRegex(pickaxeRegex)
+	return self.setFiltering()
+}
 
-	self.c.Refresh(types.RefreshOptions{Scope: helpers.ScopesToRefreshWhenFilteringModeChanges(), Then: func() {
-		self.c.Contexts().LocalCommits.SetSelection(0)
-		self.c.Contexts().LocalCommits.HandleFocus(types.OnFocusOpts{})
-	}})
+func (self *FilteringMenuAction) setFilteringMessage(message string) error {
+	if message == "" {
+		return nil
+	}
 
-	return nil
+	self.c.Modes().Filtering.Reset()
+	self.c.Modes().Filtering.SetMessage(message)
+	return self.setFiltering()
+}
+
+func (self *FilteringMenuAction) withCancelNote(tooltip string, cancelNote string) string {
+	if cancelNote == "" {
+		return tooltip
+	}
+
+	return tooltip + "\n\n" + cancelNote
+}
+
+func (self *FilteringMenuAction) setFiltering() error {
+	return self.c.Helpers().Mode.StartFiltering()
 }
@@ -94,6 +94,13 @@ func (self *GlobalController) GetKeybindings(opts types.KeybindingsOpts) []*type
 			DisplayOnScreen:   true,
 			GetDisabledReason: self.optionsMenuDisabledReason,
 		},
+		{
+			Key:         opts.GetKey(opts.Config.Universal.OpenCommandPalette),
+			Handler:     opts.Guards.NoPopupPanel(self.openCommandPalette),
+			Description: self.c.Tr.OpenCommandPalette,
+			Tooltip:     self.c.Tr.OpenCommandPaletteTooltip,
+			OpensMenu:   true,
+		},
 		{
 			ViewName:    "",
 			Key:         opts.GetKey(opts.Config.Universal.FilteringMenu),
@@ -216,6 +223,10 @@ func (self *GlobalController) optionsMenuDisabledReason() *types.DisabledReason
 	return nil
 }
 
+func (self *GlobalController) openCommandPalette() error {
+	return (&CommandPaletteAction{c: self.c}).Call()
+}
+
 func (self *GlobalController) createFilteringMenu() error {
 	return (&FilteringMenuAction{c: self.c}).Call()
 }
@@ -0,0 +1,167 @@
+package helpers
+
+import (
+	"errors"
+	"strings"
+
+	"github.com/jesseduffield/gocui"
+	"github.com/jesseduffield/lazygit/pkg/commands/models"
+	"github.com/jesseduffield/lazygit/pkg/gui/context"
+	"github.com/jesseduffield/lazygit/pkg/gui/types"
+	"github.com/jesseduffield/lazygit/pkg/utils"
+)
+
+type BlameHelper struct {
+	c *HelperCommon
+
+	subCommitsHelper *SubCommitsHelper
+}
+
+func NewBlameHelper(
+	c *HelperCommon,
+	subCommitsHelper *SubCommitsHelper,
+) *BlameHelper {
+	return &BlameHelper{
+		c:                c,
+		subCommitsHelper: subCommitsHelper,
+	}
+}
+
+// OpenBlame shows the blame of the given file in the main view. An empty ref
+// blames the file as it is in the working tree.
+func (self *BlameHelper) OpenBlame(path string, ref string) error {
+	self.context().ClearHistory()
+
+	return self.showBlame(path, ref, 0)
+}
+
+// BlameAtParent re-blames the file as it was before the commit that last
+// changed the given line, so that we can keep digging into the history of
+// that line.
+func (self *BlameHelper) BlameAtParent(line *models.BlameLine) error {
+	if !line.IsCommitted() {
+		return errors.New(self.c.Tr.BlameLineNotCommittedYet)
+	}
+
+	if !line.HasPrevious() {
+		return errors.New(self.c.Tr.BlameLineHasNoParent)
+	}
+
+	self.context().PushHistory()
+
+	// The line doesn't necessarily exist in the parent, but the line number it
+	// had in the commit that introduced it is usually close to where the
+	// interesting code is.
+	return self.showBlame(line.PreviousFilename, line.PreviousHash, line.OriginalLineNumber-1)
+}
+
+// Returns false if there was no previous revision to go back to
+func (self *BlameHelper) GoBack() (bool, error) {
+	entry, ok := self.context().PopHistory()
+	if !ok {
+		return false, nil
+	}
+
+	return true, self.showBlame(entry.Path, entry.Ref, entry.SelectedLineIdx)
+}
+
+func (self *BlameHelper) showBlame(path string, ref string, selectedLineIdx int) error {
+	return self.c.WithWaitingStatus(self.c.Tr.LoadingBlame, func(gocui.Task) error {
+		lines, err := self.c.Git().Blame.Blame(path, ref)
+		if err != nil {
+			return err
+		}
+
+		self.c.OnUIThread(func() error {
+			blameContext := self.context()
+			blameContext.SetBlame(path, ref, lines)
+			blameContext.SetSelection(selectedLineIdx)
+			blameContext.ClearSearchString()
+			blameContext.GetView().ClearSearch()
+			blameContext.SetTitleRef(self.titleRef(path, ref))
+			// The title is normally only set when the context is activated, but
+			// when we re-blame at a parent commit the context is already active
+			blameContext.GetView().Title = blameContext.Title()
+
+			self.c.PostRefreshUpdate(blameContext)
+			self.c.Context().Push(blameContext, types.OnFocusOpts{})
+			return nil
+		})
+
+		return nil
+	})
+}
+
+func (self *BlameHelper) titleRef(path string, ref string) string {
+	if ref == "" {
+		return path
+	}
+
+	// Keep the "^" of parent refs like "<hash>^" visible
+	hash := strings.TrimRight(ref, "^")
+	return path + " @ " + utils.ShortHash(hash) + ref[len(hash):]
+}
+
+// GoToCommit selects the commit that last changed the given line in the
+// commits panel. If the commit isn't reachable from the current branch, we
+// show it in a sub-commits view instead.
+func (self *BlameHelper) GoToCommit(line *models.BlameLine) error {
+	if !line.IsCommitted() {
+		return errors.New(self.c.Tr.BlameLineNotCommittedYet)
+	}
+
+	localCommitsContext := self.c.Contexts().LocalCommits
+	if self.selectLocalCommit(line.Hash) {
+		return nil
+	}
+
+	if !localCommitsContext.GetLimitCommits() {
+		return self.viewCommitInSubCommits(line)
+	}
+
+	// The commit might just be older than the ones we have loaded so far
+	return self.c.WithWaitingStatus(self.c.Tr.LoadingCommits, func(gocui.Task) error {
+		localCommitsContext.SetLimitCommits(false)
+		self.c.Refresh(types.RefreshOptions{Mode: types.SYNC, Scope: []types.RefreshableView{types.COMMITS}})
+
+		self.c.OnUIThread(func() error {
+			if self.selectLocalCommit(line.Hash) {
+				return nil
+			}
+
+			return self.viewCommitInSubCommits(line)
+		})
+
+		return nil
+	})
+}
+
+func (self *BlameHelper) selectLocalCommit(hash string) bool {
+	localCommitsContext := self.c.Contexts().LocalCommits
+	if !localCommitsContext.SelectCommitByHash(hash) {
+		return false
+	}
+
+	self.c.Context().Push(localCommitsContext, types.OnFocusOpts{})
+	return true
+}
+
+func (self *BlameHelper) viewCommitInSubCommits(line *models.BlameLine) error {
+	commit := models.NewCommit(self.c.Model().HashPool, models.NewCommitOpts{
+		Hash:          line.Hash,
+		Name:          line.Summary,
+		AuthorName:    line.AuthorName,
+		AuthorEmail:   line.AuthorEmail,
+		UnixTimestamp: line.UnixTimestamp,
+	})
+
+	return self.subCommitsHelper.ViewSubCommits(ViewSubCommitsOpts{
+		Ref:      commit,
+		TitleRef: commit.RefName(),
+		Context:  self.c.Contexts().LocalCommits,
+	})
+}
+
+func (self *BlameHelper) context() *context.BlameContext {
+	return self.c.Contexts().Blame
+}
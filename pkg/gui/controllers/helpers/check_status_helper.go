@@ -0,0 +1,230 @@
+package helpers
+
+import (
+	"errors"
+	"maps"
+	"time"
+
+	"github.com/jesseduffield/generics/set"
+	"github.com/jesseduffield/gocui"
+	"github.com/jesseduffield/lazygit/pkg/commands/hosting_service"
+	"github.com/jesseduffield/lazygit/pkg/commands/models"
+	"github.com/jesseduffield/lazygit/pkg/gui/presentation"
+	"github.com/jesseduffield/lazygit/pkg/gui/types"
+)
+
+// Loads the CI check status of the commits and branches that are visible in
+// the commits and branches panels. Every commit costs one or two requests to
+// the hosting service, so we only load the ones that are actually on screen,
+// and cache the result per hash. All the state of this helper is only
+// accessed on the UI thread.
+type CheckStatusHelper struct {
+	c            *HelperCommon
+	hostHelper   *HostHelper
+	windowHelper *WindowHelper
+
+	// Hashes whose status we are currently loading
+	loading *set.Set[string]
+	// Set when we failed to reach the hosting service, so that we don't keep
+	// trying (and waiting for timeouts) while offline. We try again after
+	// checkStatusOfflineRetryInterval, or earlier after a successful fetch or
+	// when the user opens the menu of a commit.
+	offlineSince time.Time
+}
+
+const checkStatusOfflineRetryInterval = 2 * time.Minute
+
+func NewCheckStatusHelper(c *HelperCommon, hostHelper *HostHelper, windowHelper *WindowHelper) *CheckStatusHelper {
+	return &CheckStatusHelper{
+		c:            c,
+		hostHelper:   hostHelper,
+		windowHelper: windowHelper,
+		loading:      set.New[string](),
+	}
+}
+
+// Called after every layout, so it needs to be cheap when there's nothing to
+// do
+func (self *CheckStatusHelper) LoadVisible() {
+	if !self.c.UserConfig().HostingServiceAPI.ShowCheckStatus || self.isOffline() {
+		return
+	}
+
+	hashes := []string{}
+	addHash := func(hash string) {
+		if _, ok := self.c.Model().CheckStatuses[hash]; !ok && !self.loading.Includes(hash) {
+			hashes = append(hashes, hash)
+		}
+	}
+
+	for _, commit := range visibleItems(self.windowHelper, self.c.Contexts().LocalCommits, self.c.Contexts().LocalCommits.GetItems()) {
+		if isPushed(commit) {
+			addHash(commit.Hash())
+		}
+	}
+	for _, branch := range visibleItems(self.windowHelper, self.c.Contexts().Branches, self.c.Contexts().Branches.GetItems()) {
+		if isHeadPushed(branch) {
+			addHash(branch.CommitHash)
+		}
+	}
+
+	if len(hashes) > 0 {
+		self.load(hashes)
+	}
+}
+
+// Called after fetching: we now know that we're online, and any checks that
+// were still running may have finished
+func (self *CheckStatusHelper) OnFetched() {
+	self.c.OnUIThread(func() error {
+		self.offlineSince = time.Time{}
+		maps.DeleteFunc(self.c.Model().CheckStatuses, func(_ string, checkStatus *models.CheckStatus) bool {
+			state := checkStatus.State()
+			return state == models.CheckStatePending || state == models.CheckStateNone
+		})
+		self.c.PostRefreshUpdate(self.c.Contexts().LocalCommits)
+		self.c.PostRefreshUpdate(self.c.Contexts().Branches)
+		return nil
+	})
+}
+
+// Shows a menu with the individual checks of the given commit, loading them
+// first if we don't have them yet
+func (self *CheckStatusHelper) OpenMenu(hash string) error {
+	if checkStatus, ok := self.c.Model().CheckStatuses[hash]; ok && checkStatus.State() != models.CheckStatePending {
+		return self.showMenu(checkStatus)
+	}
+
+	return self.c.WithWaitingStatus(self.c.Tr.LoadingCheckStatusStatus, func(gocui.Task) error {
+		checkStatus, err := self.hostHelper.GetCheckStatus(hash)
+		if err != nil {
+			return err
+		}
+
+		self.c.OnUIThread(func() error {
+			// We got through, so the other commits' statuses can be loaded
+			// again too
+			self.offlineSince = time.Time{}
+			self.store(map[string]*models.CheckStatus{hash: checkStatus})
+			return self.showMenu(checkStatus)
+		})
+		return nil
+	})
+}
+
+func (self *CheckStatusHelper) CanOpenMenuForCommit(commit *models.Commit) *types.DisabledReason {
+	if !isPushed(commit) {
+		return &types.DisabledReason{Text: self.c.Tr.CheckStatusNotPushed}
+	}
+	return nil
+}
+
+func (self *CheckStatusHelper) CanOpenMenuForBranch(branch *models.Branch) *types.DisabledReason {
+	if !isHeadPushed(branch) {
+		return &types.DisabledReason{Text: self.c.Tr.CheckStatusNotPushed}
+	}
+	return nil
+}
+
+func (self *CheckStatusHelper) showMenu(checkStatus *models.CheckStatus) error {
+	if len(checkStatus.Checks) == 0 {
+		return errors.New(self.c.Tr.NoCIChecks)
+	}
+
+	menuItems := make([]*types.MenuItem, 0, len(checkStatus.Checks))
+	for _, check := range checkStatus.Checks {
+		var disabledReason *types.DisabledReason
+		if check.URL == "" {
+			disabledReason = &types.DisabledReason{Text: self.c.Tr.CheckHasNoURL}
+		}
+
+		// A single column, because otherwise the "Cancel" item would make
+		// the marker column as wide as itself
+		marker := presentation.CheckStateStr(check.State)
+		if marker == "" {
+			marker = " "
+		}
+
+		menuItems = append(menuItems, &types.MenuItem{
+			Label: marker + " " + check.Name,
+			OnPress: func() error {
+				return self.c.OS().OpenLink(check.URL)
+			},
+			DisabledReason: disabledReason,
+		})
+	}
+
+	return self.c.Menu(types.CreateMenuOptions{Title: self.c.Tr.CIChecks, Items: menuItems})
+}
+
+func (self *CheckStatusHelper) load(hashes []string) {
+	self.loading.Add(hashes...)
+
+	self.c.OnWorker(func(gocui.Task) error {
+		checkStatuses := map[string]*models.CheckStatus{}
+		offline := false
+		for _, hash := range hashes {
+			checkStatus, err := self.hostHelper.GetCheckStatus(hash)
+			if err != nil {
+				// Not worth bothering the user with a popup for this; they can
+				// find out what's wrong by opening the menu for the commit
+				self.c.Log.Error(err)
+				if hosting_service.IsNetworkError(err) {
+					offline = true
+					break
+				}
+				// Don't retry for every layout; we'll try again after the
+				// next fetch
+				checkStatus = &models.CheckStatus{}
+			}
+			checkStatuses[hash] = checkStatus
+		}
+
+		self.c.OnUIThread(func() error {
+			self.loading.RemoveSlice(hashes)
+			if offline {
+				self.offlineSince = time.Now()
+			}
+			self.store(checkStatuses)
+			return nil
+		})
+		return nil
+	})
+}
+
+func (self *CheckStatusHelper) isOffline() bool {
+	return !self.offlineSince.IsZero() && time.Since(self.offlineSince) < checkStatusOfflineRetryInterval
+}
+
+func (self *CheckStatusHelper) store(checkStatuses map[string]*models.CheckStatus) {
+	if self.c.Model().CheckStatuses == nil {
+		self.c.Model().CheckStatuses = map[string]*models.CheckStatus{}
+	}
+	maps.Copy(self.c.Model().CheckStatuses, checkStatuses)
+
+	self.c.PostRefreshUpdate(self.c.Contexts().LocalCommits)
+	self.c.PostRefreshUpdate(self.c.Contexts().Branches)
+}
+
+// The items of the list that are currently scrolled into view, or none if
+// the list isn't shown at all (e.g. because another tab of its window is
+// active)
+func visibleItems[T any](windowHelper *WindowHelper, context types.IListContext, items []T) []T {
+	if windowHelper.GetViewNameForWindow(context.GetWindowName()) != context.GetViewName() {
+		return nil
+	}
+
+	startIdx, length := context.GetViewTrait().ViewPortYBounds()
+	start := min(max(context.ViewIndexToModelIndex(startIdx), 0), len(items))
+	end := min(max(context.ViewIndexToModelIndex(startIdx+length), start), len(items))
+	return items[start:end]
+}
+
+func isPushed(commit *models.Commit) bool {
+	return commit.Status == models.StatusPushed || commit.Status == models.StatusMerged
+}
+
+// Whether the head commit of the branch exists on origin
+func isHeadPushed(branch *models.Branch) bool {
+	return branch.UpstreamRemote == "origin" && !branch.UpstreamGone && !branch.IsAheadForPull() && branch.CommitHash != ""
+}
@@ -123,6 +123,10 @@ type OpenCommitMessagePanelOpts struct {
 	OnConfirm        func(summary string, description string) error
 	OnSwitchToEditor func(string) error
 	InitialMessage   string
+	// Pass the description to OnConfirm without the line breaks that
+	// auto-wrapping inserted, e.g. because it is going to be rendered as
+	// markdown rather than used in a commit message
+	UnwrappedDescription bool
 
 	// The following two fields are only for the display of the "(hooks
 	// disabled)" display in the commit message panel. They have no effect on
@@ -135,6 +139,10 @@ type OpenCommitMessagePanelOpts struct {
 
 func (self *CommitsHelper) OpenCommitMessagePanel(opts *OpenCommitMessagePanelOpts) {
 	onConfirm := func(summary string, description string) error {
+		if opts.UnwrappedDescription {
+			description = self.getUnwrappedCommitDescription()
+		}
+
 		self.CloseCommitMessagePanel()
 
 		return opts.OnConfirm(summary, description)
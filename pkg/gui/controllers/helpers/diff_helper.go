@@ -1,15 +1,20 @@
 package helpers
 
 import (
+	"errors"
+	"fmt"
 	"strings"
 
+	"github.com/jesseduffield/gocui"
 	"github.com/jesseduffield/lazygit/pkg/commands/git_commands"
 	"github.com/jesseduffield/lazygit/pkg/commands/models"
+	"github.com/jesseduffield/lazygit/pkg/commands/oscommands"
 	"github.com/jesseduffield/lazygit/pkg/commands/patch"
 	"github.com/jesseduffield/lazygit/pkg/gui/context"
 	"github.com/jesseduffield/lazygit/pkg/gui/modes/diffing"
 	"github.com/jesseduffield/lazygit/pkg/gui/style"
 	"github.com/jesseduffield/lazygit/pkg/gui/types"
+	"github.com/jesseduffield/lazygit/pkg/utils"
 	"github.com/samber/lo"
 )
 
@@ -98,20 +103,51 @@ func (self *DiffHelper) ExitDiffMode() error {
 	return nil
 }
 
+// Returns the refs to pass to git range-diff when in range-diff mode
+func (self *DiffHelper) RangeDiffRefs() (string, string) {
+	from := self.c.Modes().Diffing.Ref
+	to := self.currentDiffTerminal()
+	if to == "" {
+		to = "HEAD"
+	}
+
+	if self.c.Modes().Diffing.Reverse {
+		return to, from
+	}
+
+	return from, to
+}
+
+// Returns the git command that is used to render the main view in diffing mode
+func (self *DiffHelper) DiffCommandDescription() string {
+	if self.c.Modes().Diffing.RangeDiff {
+		from, to := self.RangeDiffRefs()
+		return "git range-diff " + from + "..." + to
+	}
+
+	return "git diff " + strings.Join(self.DiffArgs(), " ")
+}
+
 func (self *DiffHelper) RenderDiff() {
-	args := self.DiffArgs()
-	cmdObj := self.c.Git().Diff.DiffCmdObj(args)
+	var cmdObj *oscommands.CmdObj
+	title := "Diff"
+	if self.c.Modes().Diffing.RangeDiff {
+		cmdObj = self.c.Git().Diff.RangeDiffCmdObj(self.RangeDiffRefs())
+		title = self.c.Tr.RangeDiffTitle
+	} else {
+		cmdObj = self.c.Git().Diff.DiffCmdObj(self.DiffArgs())
+	}
 	prefix := style.FgMagenta.Sprintf(
 		"%s %s\n\n",
 		self.c.Tr.ShowingGitDiff,
-		"git diff "+strings.Join(args, " "),
+		self.DiffCommandDescription(),
 	)
 	task := types.NewRunPtyTaskWithPrefix(cmdObj.GetCmd(), prefix)
 
 	self.c.RenderToMainViews(types.RefreshMainOpts{
 		Pair: self.c.MainViewPairs().Normal,
 		Main: &types.ViewUpdateOpts{
-			Title:    "Diff",
+			Title:    title,
 			SubTitle: self.IgnoringWhitespaceSubTitle(),
 			Task:     task,
 		},
@@ -174,6 +210,75 @@ func (self *DiffHelper) IgnoringWhitespaceSubTitle() string {
 	return ""
 }
 
+// Renders the diff of the selected file of the given context with its old and
+// new version next to each other in the main and secondary views. The command
+// must produce a plain (uncolored) diff that honors the ignore-whitespace
+// setting, since we show the subtitle for it. Returns false if side-by-side
+// diffs are turned off, in which case the caller should render the diff as
+// usual.
+//
+// Since we need the whole diff before we can lay it out, the command runs on a
+// worker, and we only render the result if the same file is still selected by
+// then. If it turns out that there's nothing to show side-by-side (e.g. because
+// the file is binary), renderNormally is called instead.
+func (self *DiffHelper) RenderSideBySide(listContext types.IListContext, cmdObj *oscommands.CmdObj, title string, renderNormally func()) bool {
+	if !self.c.UserConfig().Git.SideBySideDiffInDiffView {
+		return false
+	}
+
+	selectedItemId := listContext.GetSelectedItemId()
+	stillSelected := func() bool {
+		return self.c.Context().CurrentSide() == listContext && listContext.GetSelectedItemId() == selectedItemId
+	}
+
+	self.c.OnWorker(func(gocui.Task) error {
+		diff, err := cmdObj.DontLog().RunWithOutput()
+		if err != nil {
+			self.c.Log.Error(err)
+		}
+
+		p := patch.Parse(diff)
+		var oldLines, newLines []string
+		if err == nil && p.ContainsChanges() {
+			oldLines, newLines = p.FormatViewSideBySide(patch.FormatViewOpts{
+				WordDiff:           self.c.UserConfig().Git.WordDiffInDiffView,
+				ColorMoved:         self.c.UserConfig().Git.ColorMovedInDiffView,
+				SyntaxHighlighting: self.c.UserConfig().Git.SyntaxHighlightingInDiffView,
+			}, true)
+		}
+
+		self.c.OnUIThread(func() error {
+			if !stillSelected() {
+				return nil
+			}
+
+			if oldLines == nil {
+				renderNormally()
+				return nil
+			}
+
+			self.c.RenderToMainViews(types.RefreshMainOpts{
+				Pair: self.c.MainViewPairs().Normal,
+				Main: &types.ViewUpdateOpts{
+					Title:    fmt.Sprintf(self.c.Tr.SideBySideOldTitle, title),
+					SubTitle: self.IgnoringWhitespaceSubTitle(),
+					Task:     types.NewRenderStringTask(strings.Join(oldLines, "\n")),
+				},
+				Secondary: &types.ViewUpdateOpts{
+					Title:    fmt.Sprintf(self.c.Tr.SideBySideNewTitle, title),
+					SubTitle: self.IgnoringWhitespaceSubTitle(),
+					Task:     types.NewRenderStringTask(strings.Join(newLines, "\n")),
+				},
+				SideBySide: true,
+			})
+			return nil
+		})
+		return nil
+	})
+
+	return true
+}
+
 func (self *DiffHelper) OpenDiffToolForRef(selectedRef models.Ref) error {
 	to := selectedRef.RefName()
 	from, reverse := self.c.Modes().Diffing.GetFromAndReverseArgsForDiff("")
@@ -221,6 +326,56 @@ func (self *DiffHelper) AdjustLineNumber(path string, linenumber int, viewname s
 	return linenumber
 }
 
+// MapLineRangeToHead maps a range of lines (one-based) of the given file to the
+// corresponding lines of the file in HEAD, following renames. ref is the commit
+// whose version of the file the lines refer to; pass an empty string for the
+// version in the index. Returns the path of the file in HEAD, and an error if
+// it doesn't exist there.
+func (self *DiffHelper) MapLineRangeToHead(path string, startLine int, endLine int, ref string) (string, int, int, error) {
+	if ref == "HEAD" {
+		return path, startLine, endLine, nil
+	}
+
+	// In both cases the old side of the diff is the version that the lines
+	// refer to, and the new side is HEAD
+	diffArgs := []string{ref, "HEAD"}
+	if ref == "" {
+		diffArgs = []string{"--cached", "-R"}
+	}
+
+	// Limiting the diff to the path would keep git from detecting renames, so
+	// we need to find the path in HEAD first
+	nameStatus, err := self.c.Git().Diff.GetDiff(false, append([]string{"-M", "--name-status"}, diffArgs...)...)
+	if err != nil {
+		return "", 0, 0, err
+	}
+
+	headPath := path
+	for _, line := range utils.SplitLines(nameStatus) {
+		fields := strings.Split(line, "\t")
+		if len(fields) < 2 || fields[1] != path {
+			continue
+		}
+
+		if strings.HasPrefix(fields[0], "D") {
+			return "", 0, 0, errors.New(self.c.Tr.LineHistoryFileNotInHead)
+		}
+		if strings.HasPrefix(fields[0], "R") && len(fields) == 3 {
+			headPath = fields[2]
+		}
+	}
+
+	diff, err := self.c.Git().Diff.GetDiff(false,
+		append(append([]string{"--unified=0", "-M"}, diffArgs...), "--", path, headPath)...)
+	if err != nil {
+		return "", 0, 0, err
+	}
+	patch := patch.Parse(diff)
+	startLine = patch.AdjustLineNumber(startLine)
+	endLine = max(startLine, patch.AdjustLineNumber(endLine))
+	return headPath, startLine, endLine, nil
+}
+
 func (self *DiffHelper) adjustLineNumber(linenumber int, diffArgs ...string) int {
 	args := append([]string{"--unified=0"}, diffArgs...)
 	diff, err := self.c.Git().Diff.GetDiff(false, args...)
@@ -53,6 +53,11 @@ type Helpers struct {
 	Search            *SearchHelper
 	Worktree          *WorktreeHelper
 	SubCommits        *SubCommitsHelper
+	Blame             *BlameHelper
+	SparseCheckout    *SparseCheckoutHelper
+	PullRequests      *PullRequestsHelper
+	CheckStatus       *CheckStatusHelper
+	IssueLinks        *IssueLinksHelper
 }
 
 func NewStubHelpers() *Helpers {
@@ -90,5 +95,10 @@ func NewStubHelpers() *Helpers {
 		Search:            &SearchHelper{},
 		Worktree:          &WorktreeHelper{},
 		SubCommits:        &SubCommitsHelper{},
+		Blame:             &BlameHelper{},
+		SparseCheckout:    &SparseCheckoutHelper{},
+		PullRequests:      &PullRequestsHelper{},
+		CheckStatus:       &CheckStatusHelper{},
+		IssueLinks:        &IssueLinksHelper{},
 	}
 }
@@ -2,6 +2,7 @@ package helpers
 
 import (
 	"github.com/jesseduffield/lazygit/pkg/commands/hosting_service"
+	"github.com/jesseduffield/lazygit/pkg/commands/models"
 )
 
 // this helper just wraps our hosting_service package
@@ -34,6 +35,40 @@ func (self *HostHelper) GetCommitURL(commitHash string) (string, error) {
 	return mgr.GetCommitURL(commitHash)
 }
 
+func (self *HostHelper) GetPullRequests(headBranches []string) (map[string]*models.PullRequest, error) {
+	mgr, err := self.getHostingServiceMgr()
+	if err != nil {
+		return nil, err
+	}
+	return mgr.GetPullRequests(self.c.UserConfig().HostingServiceAPI, headBranches)
+}
+
+func (self *HostHelper) CreatePullRequest(opts hosting_service.CreatePullRequestOpts) (*models.PullRequest, error) {
+	mgr, err := self.getHostingServiceMgr()
+	if err != nil {
+		return nil, err
+	}
+	return mgr.CreatePullRequest(self.c.UserConfig().HostingServiceAPI, opts)
+}
+
+func (self *HostHelper) GetCheckStatus(hash string) (*models.CheckStatus, error) {
+	mgr, err := self.getHostingServiceMgr()
+	if err != nil {
+		return nil, err
+	}
+	return mgr.GetCheckStatus(self.c.UserConfig().HostingServiceAPI, hash)
+}
+
+// Whether we can create pull requests through the hosting service's API, as
+// opposed to opening the page for creating one in the browser
+func (self *HostHelper) CanCreatePullRequestsViaAPI() bool {
+	mgr, err := self.getHostingServiceMgr()
+	if err != nil {
+		return false
+	}
+	return mgr.HasAPIToken(self.c.UserConfig().HostingServiceAPI)
+}
+
 // getting this on every request rather than storing it in state in case our remoteURL changes
 // from one invocation to the next.
 func (self *HostHelper) getHostingServiceMgr() (*hosting_service.HostingServiceMgr, error) {
@@ -0,0 +1,94 @@
+package helpers
+
+import (
+	"errors"
+
+	"github.com/jesseduffield/lazygit/pkg/commands/hosting_service"
+	"github.com/jesseduffield/lazygit/pkg/commands/models"
+	"github.com/jesseduffield/lazygit/pkg/gui/types"
+	"github.com/samber/lo"
+)
+
+// Opens the issues that commit messages and branch names refer to, according
+// to the issueLinks config
+type IssueLinksHelper struct {
+	c *HelperCommon
+}
+
+func NewIssueLinksHelper(c *HelperCommon) *IssueLinksHelper {
+	return &IssueLinksHelper{
+		c: c,
+	}
+}
+
+func (self *IssueLinksHelper) OpenForCommit(commit *models.Commit) error {
+	message, err := self.c.Git().Commit.GetCommitMessage(commit.Hash())
+	if err != nil {
+		return err
+	}
+
+	return self.open(message)
+}
+
+func (self *IssueLinksHelper) OpenForBranch(branch *models.Branch) error {
+	return self.open(branch.Name)
+}
+
+// For commits we only find out whether there are any links once we have
+// loaded the full message, so all we can check upfront is the config
+func (self *IssueLinksHelper) CanOpenForCommit(*models.Commit) *types.DisabledReason {
+	if self.matcher().IsEmpty() {
+		return &types.DisabledReason{Text: self.c.Tr.NoIssueLinkRules}
+	}
+
+	return nil
+}
+
+func (self *IssueLinksHelper) CanOpenForBranch(branch *models.Branch) *types.DisabledReason {
+	matcher := self.matcher()
+	if matcher.IsEmpty() {
+		return &types.DisabledReason{Text: self.c.Tr.NoIssueLinkRules}
+	}
+
+	if len(matcher.FindLinks(branch.Name)) == 0 {
+		return &types.DisabledReason{Text: self.c.Tr.NoIssueLinks}
+	}
+
+	return nil
+}
+
+func (self *IssueLinksHelper) matcher() *hosting_service.IssueLinkMatcher {
+	return self.c.IssueLinkMatcher()
+}
+
+// Opens the issue that the text refers to, or lets the user choose if there
+// are several
+func (self *IssueLinksHelper) open(text string) error {
+	links := lo.UniqBy(self.matcher().FindLinks(text), func(link hosting_service.IssueLink) string {
+		return link.URL
+	})
+
+	switch len(links) {
+	case 0:
+		return errors.New(self.c.Tr.NoIssueLinks)
+	case 1:
+		return self.openLink(links[0])
+	}
+
+	menuItems := lo.Map(links, func(link hosting_service.IssueLink, _ int) *types.MenuItem {
+		return &types.MenuItem{
+			Label: link.Text,
+			OnPress: func() error {
+				return self.openLink(link)
+			},
+			Tooltip: link.URL,
+		}
+	})
+
+	return self.c.Menu(types.CreateMenuOptions{Title: self.c.Tr.IssueLinks, Items: menuItems})
+}
+
+func (self *IssueLinksHelper) openLink(link hosting_service.IssueLink) error {
+	self.c.LogAction(self.c.Tr.Actions.OpenIssueLink)
+	return self.c.OS().OpenLink(link.URL)
+}
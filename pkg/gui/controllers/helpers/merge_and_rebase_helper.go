@@ -71,6 +71,24 @@ func (self *MergeAndRebaseHelper) ContinueRebase() error {
 	return self.genericMergeCommand(REBASE_OPTION_CONTINUE)
 }
 
+// Called when none of the files of the commit being split have changes left
+// in the working tree, which means that all of its changes have been committed
+// again
+func (self *MergeAndRebaseHelper) FinishSplittingCommit() error {
+	if !self.c.Modes().SplitCommit.Active() {
+		return nil
+	}
+
+	self.c.Modes().SplitCommit.Reset()
+	if !self.c.Git().Status.WorkingTreeState().Rebasing {
+		// The rebase was aborted or continued by the user
+		return nil
+	}
+
+	self.c.Toast(self.c.Tr.FinishedSplittingCommit)
+	return self.ContinueRebase()
+}
+
 func (self *MergeAndRebaseHelper) genericMergeCommand(command string) error {
 	status := self.c.Git().Status.WorkingTreeState()
 
@@ -152,6 +170,10 @@ func isMergeConflictErr(errStr string) bool {
 func (self *MergeAndRebaseHelper) CheckMergeOrRebaseWithRefreshOptions(result error, refreshOptions types.RefreshOptions) error {
 	self.c.Refresh(refreshOptions)
 
+	if result != nil {
+		self.toastReusedRerereResolutions(result.Error())
+	}
+
 	if result == nil {
 		return nil
 	} else if strings.Contains(result.Error(), "No changes - did you forget to use") {
@@ -165,6 +187,41 @@ func (self *MergeAndRebaseHelper) CheckMergeOrRebaseWithRefreshOptions(result er
 	return self.CheckForConflicts(result)
 }
 
+// Rerere resolves conflicts silently, so we let the user know that some of
+// the conflicted files are already resolved and only need to be reviewed
+func (self *MergeAndRebaseHelper) toastReusedRerereResolutions(output string) {
+	paths := git_commands.ReusedRerereResolutions(output)
+	if len(paths) == 0 {
+		return
+	}
+
+	self.c.Toast(utils.ResolvePlaceholderString(self.c.Tr.ReusedRerereResolutions, map[string]string{
+		"paths": strings.Join(paths, ", "),
+	}))
+}
+
+// Returns those of the given paths that rerere resolved using a recorded
+// resolution
+func (self *MergeAndRebaseHelper) RerereResolvedPaths(paths []string) []string {
+	return lo.Filter(paths, func(path string, _ int) bool {
+		return lo.SomeBy(self.c.Model().Files, func(file *models.File) bool {
+			return file.Path == path && file.IsRerereResolved
+		})
+	})
+}
+
+func (self *MergeAndRebaseHelper) ForgetRerereResolutions(paths []string) error {
+	self.c.LogAction(self.c.Tr.Actions.ForgetRerereResolution)
+	for _, path := range paths {
+		if err := self.c.Git().Rerere.Forget(path); err != nil {
+			return err
+		}
+	}
+
+	self.c.Refresh(types.RefreshOptions{Mode: types.SYNC, Scope: []types.RefreshableView{types.FILES}})
+	return nil
+}
+
 func (self *MergeAndRebaseHelper) CheckMergeOrRebase(result error) error {
 	return self.CheckMergeOrRebaseWithRefreshOptions(result, types.RefreshOptions{Mode: types.ASYNC})
 }
@@ -263,16 +320,47 @@ func (self *MergeAndRebaseHelper) PromptToContinueRebase() error {
 }
 
 func (self *MergeAndRebaseHelper) RebaseOntoRef(ref string) error {
+	menuItems, err := self.RebaseMenuItems(ref)
+	if err != nil {
+		return err
+	}
+
+	checkedOutBranchName := self.c.Model().Branches[0].Name
+	title := utils.ResolvePlaceholderString(
+		lo.Ternary(self.c.Modes().MarkedBaseCommit.GetHash() != "",
+			self.c.Tr.RebasingFromBaseCommitTitle,
+			self.c.Tr.RebasingTitle),
+		map[string]string{
+			"checkedOutBranch": checkedOutBranchName,
+		},
+	)
+
+	showMenu := func(prompt string) error {
+		return self.c.Menu(types.CreateMenuOptions{
+			Title:  title,
+			Prompt: prompt,
+			Items:  menuItems,
+		})
+	}
+
+	if checkedOutBranchName == ref {
+		return showMenu("")
+	}
+
+	return self.withConflictPrediction(ref, showMenu)
+}
+
+// Returns the items of the menu for rebasing the checked-out branch onto ref
+func (self *MergeAndRebaseHelper) RebaseMenuItems(ref string) ([]*types.MenuItem, error) {
 	checkedOutBranch := self.c.Model().Branches[0]
-	checkedOutBranchName := checkedOutBranch.Name
 	var disabledReason, baseBranchDisabledReason *types.DisabledReason
-	if checkedOutBranchName == ref {
+	if checkedOutBranch.Name == ref {
 		disabledReason = &types.DisabledReason{Text: self.c.Tr.CantRebaseOntoSelf}
 	}
 
 	baseBranch, err := self.c.Git().Loaders.BranchLoader.GetBaseBranch(checkedOutBranch, self.c.Model().MainBranches)
 	if err != nil {
-		return err
+		return nil, err
 	}
 	if baseBranch == "" {
 		baseBranch = self.c.Tr.CouldNotDetermineBaseBranch
@@ -357,21 +445,50 @@ func (self *MergeAndRebaseHelper) RebaseOntoRef(ref string) error {
 		},
 	}
 
-	title := utils.ResolvePlaceholderString(
-		lo.Ternary(self.c.Modes().MarkedBaseCommit.GetHash() != "",
-			self.c.Tr.RebasingFromBaseCommitTitle,
-			self.c.Tr.RebasingTitle),
-		map[string]string{
-			"checkedOutBranch": checkedOutBranchName,
-		},
-	)
+	return menuItems, nil
+}
 
-	return self.c.Menu(types.CreateMenuOptions{
-		Title: title,
-		Items: menuItems,
+// Calls f on the UI thread with the conflict prediction for merging ref into
+// the checked-out branch. The prediction is usually cached already (see
+// BranchCommands.PredictMergeConflictsForHashes); if it isn't, running the merge
+// can take a while, so we do it on a worker.
+func (self *MergeAndRebaseHelper) withConflictPrediction(ref string, f func(prompt string) error) error {
+	if !self.c.Git().Branch.CanPredictMergeConflicts() {
+		return f("")
+	}
+
+	return self.c.WithWaitingStatus(self.c.Tr.PredictingConflictsStatus, func(gocui.Task) error {
+		prompt := self.conflictPrediction(ref)
+		self.c.OnUIThread(func() error {
+			return f(prompt)
+		})
+		return nil
 	})
 }
 
+// Returns a description of which files would conflict when merging ref into
+// the checked-out branch, or an empty string if we can't tell. We use the same
+// prediction for rebasing, even though a rebase can run into conflicts in
+// intermediate commits that a merge wouldn't have.
+func (self *MergeAndRebaseHelper) conflictPrediction(ref string) string {
+	conflicts, err := self.c.Git().Branch.PredictMergeConflicts("HEAD", ref)
+	if err != nil {
+		self.c.Log.Error(err)
+		return ""
+	}
+
+	if len(conflicts) == 0 {
+		return self.c.Tr.MergeWillBeClean
+	}
+
+	header := lo.Ternary(len(conflicts) == 1,
+		self.c.Tr.OneFileWillConflict,
+		fmt.Sprintf(self.c.Tr.FilesWillConflict, len(conflicts)))
+	return header + "\n" + strings.Join(
+		lo.Map(conflicts, func(path string, _ int) string { return "  " + path }),
+		"\n")
+}
+
 func (self *MergeAndRebaseHelper) MergeRefIntoCheckedOutBranch(refName string) error {
 	if self.c.Git().Branch.IsHeadDetached() {
 		return errors.New("Cannot merge branch in detached head state. You might have checked out a commit directly or a remote branch, in which case you should checkout the local branch you want to be on")
@@ -456,7 +573,7 @@ func (self *MergeAndRebaseHelper) MergeRefIntoCheckedOutBranch(refName string) e
 		}
 	}
 
-	return self.c.Menu(types.CreateMenuOptions{
+	menuOptions := types.CreateMenuOptions{
 		Title: self.c.Tr.Merge,
 		Items: []*types.MenuItem{
 			firstRegularMergeItem,
@@ -485,6 +602,11 @@ func (self *MergeAndRebaseHelper) MergeRefIntoCheckedOutBranch(refName string) e
 				),
 			},
 		},
+	}
+
+	return self.withConflictPrediction(refName, func(prompt string) error {
+		menuOptions.Prompt = prompt
+		return self.c.Menu(menuOptions)
 	})
 }
 
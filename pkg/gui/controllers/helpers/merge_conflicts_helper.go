@@ -112,12 +112,35 @@ func (self *MergeConflictsHelper) Render() {
 		task = types.NewRenderStringWithScrollTask(content, 0, originY)
 	}
 
+	if !self.context().IsShowingSplitView() {
+		self.c.RenderToMainViews(types.RefreshMainOpts{
+			Pair: self.c.MainViewPairs().MergeConflicts,
+			Main: &types.ViewUpdateOpts{
+				Title: self.c.Tr.DiffTitle,
+				Task:  task,
+			},
+		})
+		return
+	}
+
 	self.c.RenderToMainViews(types.RefreshMainOpts{
 		Pair: self.c.MainViewPairs().MergeConflicts,
 		Main: &types.ViewUpdateOpts{
-			Task: task,
+			Title: self.c.Tr.MergeConflictResultTitle,
+			Task:  task,
+		},
+		Secondary: &types.ViewUpdateOpts{
+			Title: self.c.Tr.MergeConflictSidesTitle,
+			Task:  types.NewRenderStringTask(self.context().GetSidesContentToRender()),
 		},
 	})
+
+	// The columns depend on the width of the view, which isn't known until the
+	// secondary window has been laid out
+	self.c.AfterLayout(func() error {
+		self.c.Views().MergeConflictsSides.SetContent(self.context().GetSidesContentToRender())
+		return nil
+	})
 }
 
 func (self *MergeConflictsHelper) RefreshMergeState() error {
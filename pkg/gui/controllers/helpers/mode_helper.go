@@ -2,10 +2,10 @@ package helpers
 
 import (
 	"fmt"
-	"strings"
 
 	"github.com/jesseduffield/lazygit/pkg/gui/style"
 	"github.com/jesseduffield/lazygit/pkg/gui/types"
+	"github.com/jesseduffield/lazygit/pkg/utils"
 	"github.com/samber/lo"
 )
 
@@ -54,7 +54,7 @@ func (self *ModeHelper) Statuses() []ModeStatus {
 					fmt.Sprintf(
 						"%s %s",
 						self.c.Tr.ShowingGitDiff,
-						"git diff "+strings.Join(self.diffHelper.DiffArgs(), " "),
+						self.diffHelper.DiffCommandDescription(),
 					),
 					style.FgMagenta,
 				)
@@ -77,11 +77,11 @@ func (self *ModeHelper) Statuses() []ModeStatus {
 		{
 			IsActive: self.c.Modes().Filtering.Active,
 			InfoLabel: func() string {
-				filterContent := lo.Ternary(self.c.Modes().Filtering.GetPath() != "", self.c.Modes().Filtering.GetPath(), self.c.Modes().Filtering.GetAuthor())
+				filterLabel, filterContent := self.filteringDescription()
 				return self.withResetButton(
 					fmt.Sprintf(
 						"%s '%s'",
-						self.c.Tr.FilteringBy,
+						filterLabel,
 						filterContent,
 					),
 					style.FgRed,
@@ -105,6 +105,29 @@ func (self *ModeHelper) Statuses() []ModeStatus {
 			},
 			Reset: self.mergeAndRebaseHelper.ResetMarkedBaseCommit,
 		},
+		{
+			IsActive: self.c.Modes().HunkReview.Active,
+			InfoLabel: func() string {
+				return self.withResetButton(self.c.Tr.ReviewingHunks, style.FgBlue)
+			},
+			CancelLabel: func() string {
+				return self.c.Tr.ExitHunkReview
+			},
+			Reset: self.ExitHunkReview,
+		},
+		{
+			IsActive: self.c.Modes().SplitCommit.Active,
+			InfoLabel: func() string {
+				return self.withResetButton(
+					fmt.Sprintf(self.c.Tr.SplittingCommitStatus, utils.ShortHash(self.c.Modes().SplitCommit.GetHash())),
+					style.FgYellow,
+				)
+			},
+			CancelLabel: func() string {
+				return self.c.Tr.StopSplittingCommit
+			},
+			Reset: self.ExitSplitCommit,
+		},
 		{
 			IsActive: self.c.Modes().CherryPicking.Active,
 			InfoLabel: func() string {
@@ -158,6 +181,26 @@ func (self *ModeHelper) Statuses() []ModeStatus {
 	}
 }
 
+// Returns the label for the active filter and the value being filtered by
+func (self *ModeHelper) filteringDescription() (string, string) {
+	filtering := self.c.Modes().Filtering
+	switch {
+	case filtering.GetPickaxe() != "":
+		return self.c.Tr.FilteringByPickaxe, filtering.GetPickaxe()
+	case filtering.GetPickaxeRegex() != "":
+		return self.c.Tr.FilteringByPickaxeRegex, filtering.GetPickaxeRegex()
+	case filtering.GetMessage() != "":
+		return self.c.Tr.FilteringByMessage, filtering.GetMessage()
+	case filtering.GetLineRangeArg() != "":
+		startLine, endLine := filtering.GetLineRange()
+		return self.c.Tr.FilteringBy, fmt.Sprintf("%s:%d-%d", filtering.GetPath(), startLine, endLine)
+	case filtering.GetPath() != "":
+		return self.c.Tr.FilteringBy, filtering.GetPath()
+	default:
+		return self.c.Tr.FilteringBy, filtering.GetAuthor()
+	}
+}
+
 func (self *ModeHelper) withResetButton(content string, textStyle style.TextStyle) string {
 	return textStyle.Sprintf(
 		"%s %s",
@@ -178,10 +221,58 @@ func (self *ModeHelper) IsAnyModeActive() bool {
 	})
 }
 
+func (self *ModeHelper) ExitHunkReview() error {
+	self.c.Modes().HunkReview.Reset()
+	self.c.Refresh(types.RefreshOptions{Scope: []types.RefreshableView{types.STAGING}})
+	return nil
+}
+
+// Leaves the rebase stopped at the commit being split, so that the user can
+// take it from there
+func (self *ModeHelper) ExitSplitCommit() error {
+	self.c.Modes().SplitCommit.Reset()
+	self.c.Refresh(types.RefreshOptions{Mode: types.ASYNC, Scope: []types.RefreshableView{types.COMMITS}})
+	return nil
+}
+
 func (self *ModeHelper) ExitFilterMode() error {
 	return self.ClearFiltering()
 }
 
+// Filters the commits by the history of the given lines of a file (git log
+// -L). The lines refer to the version of the file in ref, or in the index if
+// ref is empty; since git log -L starts looking in HEAD, we map them to that.
+func (self *ModeHelper) FilterByLineRange(path string, startLine int, endLine int, ref string) error {
+	headPath, startLine, endLine, err := self.diffHelper.MapLineRangeToHead(path, startLine, endLine, ref)
+	if err != nil {
+		return err
+	}
+
+	self.c.Modes().Filtering.Reset()
+	self.c.Modes().Filtering.SetLineRange(headPath, startLine, endLine)
+	return self.StartFiltering()
+}
+
+// Shows the commits panel with the filter that has been set up in the
+// filtering mode
+func (self *ModeHelper) StartFiltering() error {
+	self.c.Modes().Filtering.SetSelectedCommitHash(self.c.Contexts().LocalCommits.GetSelectedCommitHash())
+
+	repoState := self.c.State().GetRepoState()
+	if repoState.GetScreenMode() == types.SCREEN_NORMAL {
+		repoState.SetScreenMode(types.SCREEN_HALF)
+	}
+
+	self.c.Context().Push(self.c.Contexts().LocalCommits, types.OnFocusOpts{})
+
+	self.c.Refresh(types.RefreshOptions{Scope: ScopesToRefreshWhenFilteringModeChanges(), Then: func() {
+		self.c.Contexts().LocalCommits.SetSelection(0)
+		self.c.Contexts().LocalCommits.HandleFocus(types.OnFocusOpts{})
+	}})
+
+	return nil
+}
+
 func (self *ModeHelper) ClearFiltering() error {
 	selectedCommitHash := self.c.Contexts().LocalCommits.GetSelectedCommitHash()
 	self.c.Modes().Filtering.Reset()
@@ -0,0 +1,248 @@
+package helpers
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"sync/atomic"
+
+	"github.com/jesseduffield/gocui"
+	"github.com/jesseduffield/lazygit/pkg/commands/git_commands"
+	"github.com/jesseduffield/lazygit/pkg/commands/hosting_service"
+	"github.com/jesseduffield/lazygit/pkg/commands/models"
+	"github.com/jesseduffield/lazygit/pkg/gui/context"
+	"github.com/jesseduffield/lazygit/pkg/gui/types"
+	"github.com/samber/lo"
+)
+
+// Keeps the pull requests of the local branches (as shown in the branches
+// panel) up to date. Talking to the hosting service's API is slow, so rather
+// than doing it on every refresh of the branches we only do it when loading
+// the branches for the first time, and after fetching.
+type PullRequestsHelper struct {
+	c                 *HelperCommon
+	hostHelper        *HostHelper
+	suggestionsHelper *SuggestionsHelper
+	commitsHelper     *CommitsHelper
+
+	loading atomic.Bool
+}
+
+func NewPullRequestsHelper(c *HelperCommon, hostHelper *HostHelper, suggestionsHelper *SuggestionsHelper, commitsHelper *CommitsHelper) *PullRequestsHelper {
+	return &PullRequestsHelper{
+		c:                 c,
+		hostHelper:        hostHelper,
+		suggestionsHelper: suggestionsHelper,
+		commitsHelper:     commitsHelper,
+	}
+}
+
+func (self *PullRequestsHelper) Enabled() bool {
+	return self.c.UserConfig().HostingServiceAPI.ShowPullRequests
+}
+
+// Loads the pull requests in the background and re-renders the branches panel
+// once we have them.
+func (self *PullRequestsHelper) Refresh() {
+	if !self.Enabled() || !self.loading.CompareAndSwap(false, true) {
+		return
+	}
+
+	// We're called from refreshes and fetches running in the background, but
+	// the model may only be accessed on the UI thread
+	self.c.OnUIThread(func() error {
+		branches := self.c.Model().Branches
+
+		self.c.OnWorker(func(gocui.Task) error {
+			defer self.loading.Store(false)
+
+			headBranches := lo.Map(branches, func(branch *models.Branch, _ int) string {
+				return headBranchName(branch)
+			})
+
+			pullRequestsByHeadBranch, err := self.hostHelper.GetPullRequests(headBranches)
+			if err != nil {
+				// Not worth bothering the user with a popup for this; they'll
+				// notice that the pull requests are missing. We still store an
+				// empty map so that we don't retry on every refresh.
+				self.c.Log.Error(err)
+			}
+
+			pullRequests := map[string]*models.PullRequest{}
+			for _, branch := range branches {
+				if pullRequest, ok := pullRequestsByHeadBranch[headBranchName(branch)]; ok {
+					pullRequests[branch.Name] = pullRequest
+				}
+			}
+
+			self.c.OnUIThread(func() error {
+				self.c.Model().PullRequests = pullRequests
+				self.c.PostRefreshUpdate(self.c.Contexts().Branches)
+				return nil
+			})
+			return nil
+		})
+		return nil
+	})
+}
+
+// Creates a pull request for the branch through the hosting service's API,
+// after prompting for the target branch (starting with the given one, or the
+// main branch if empty), the title, the description, and whether it's a draft.
+// The branch is pushed first if origin doesn't have all of its commits yet.
+func (self *PullRequestsHelper) Create(branch *models.Branch, to string) error {
+	if to == "" {
+		to = self.defaultTargetBranch()
+	}
+
+	self.c.Prompt(types.PromptOpts{
+		Title:               self.c.Tr.PullRequestTargetBranch,
+		InitialContent:      to,
+		FindSuggestionsFunc: self.suggestionsHelper.GetRemoteBranchesForRemoteSuggestionsFunc("origin"),
+		HandleConfirm: func(to string) error {
+			return self.promptForTitleAndBody(branch, to)
+		},
+	})
+
+	return nil
+}
+
+// Lets the user edit the title and description in the commit message panel,
+// with the title pre-filled. Finding the default title means running git, so
+// we do that with a waiting status.
+func (self *PullRequestsHelper) promptForTitleAndBody(branch *models.Branch, to string) error {
+	return self.c.WithWaitingStatus(self.c.Tr.PreparingPullRequestStatus, func(gocui.Task) error {
+		title := self.defaultTitle(branch, to)
+
+		self.c.OnUIThread(func() error {
+			self.commitsHelper.OpenCommitMessagePanel(&OpenCommitMessagePanelOpts{
+				CommitIndex:      context.NoCommitIndex,
+				InitialMessage:   title,
+				SummaryTitle:     self.c.Tr.PullRequestTitle,
+				DescriptionTitle: self.c.Tr.PullRequestBody,
+				PreserveMessage:  false,
+				// The description is markdown, where line breaks count
+				UnwrappedDescription: true,
+				OnConfirm: func(title string, body string) error {
+					return self.promptForDraft(branch, hosting_service.CreatePullRequestOpts{
+						Title:      title,
+						Body:       body,
+						HeadBranch: headBranchName(branch),
+						BaseBranch: to,
+					})
+				},
+			})
+			return nil
+		})
+		return nil
+	})
+}
+
+func (self *PullRequestsHelper) promptForDraft(branch *models.Branch, opts hosting_service.CreatePullRequestOpts) error {
+	return self.c.Menu(types.CreateMenuOptions{
+		Title: self.c.Tr.CreatePullRequest,
+		Items: []*types.MenuItem{
+			{
+				Label: self.c.Tr.PullRequestReadyForReview,
+				OnPress: func() error {
+					return self.create(branch, opts)
+				},
+			},
+			{
+				Label: self.c.Tr.PullRequestDraft,
+				OnPress: func() error {
+					opts.Draft = true
+					return self.create(branch, opts)
+				},
+			},
+		},
+	})
+}
+
+func (self *PullRequestsHelper) create(branch *models.Branch, opts hosting_service.CreatePullRequestOpts) error {
+	return self.c.WithWaitingStatus(self.c.Tr.CreatingPullRequestStatus, func(task gocui.Task) error {
+		self.c.LogAction(self.c.Tr.Actions.CreatePullRequest)
+
+		if err := self.pushIfNeeded(task, branch); err != nil {
+			return err
+		}
+
+		pullRequest, err := self.hostHelper.CreatePullRequest(opts)
+		if err != nil {
+			return err
+		}
+
+		if err := self.c.OS().CopyToClipboard(pullRequest.URL); err != nil {
+			return err
+		}
+		self.c.Toast(fmt.Sprintf(self.c.Tr.PullRequestCreated, pullRequest.Number))
+
+		self.c.OnUIThread(func() error {
+			if self.c.Model().PullRequests != nil {
+				self.c.Model().PullRequests[branch.Name] = pullRequest
+			}
+			return nil
+		})
+
+		// Pushing may have set the upstream of the branch
+		self.c.Refresh(types.RefreshOptions{Scope: []types.RefreshableView{types.BRANCHES}, Mode: types.SYNC})
+		return nil
+	})
+}
+
+func (self *PullRequestsHelper) pushIfNeeded(task gocui.Task, branch *models.Branch) error {
+	if branch.UpstreamRemote == "origin" && !branch.UpstreamGone && !branch.IsAheadForPull() {
+		return nil
+	}
+
+	return self.c.Git().Sync.Push(task, git_commands.PushOpts{
+		CurrentBranch:  branch.Name,
+		UpstreamRemote: "origin",
+		UpstreamBranch: headBranchName(branch),
+		// Don't change the upstream of a branch that tracks a different remote
+		SetUpstream: !branch.IsTrackingRemote(),
+	})
+}
+
+func (self *PullRequestsHelper) defaultTargetBranch() string {
+	for _, ref := range self.c.Model().MainBranches.Get() {
+		if name, ok := strings.CutPrefix(ref, "refs/remotes/origin/"); ok {
+			return name
+		}
+	}
+	return ""
+}
+
+// The subject of the branch's first commit that isn't on the target branch yet
+// (or any of the main branches)
+func (self *PullRequestsHelper) defaultTitle(branch *models.Branch, to string) string {
+	excludedRefs := slices.Clone(self.c.Model().MainBranches.Get())
+	if to != "" && self.c.Git().Remote.CheckRemoteBranchExists(to) {
+		excludedRefs = append(excludedRefs, "refs/remotes/origin/"+to)
+	}
+
+	hash, err := self.c.Git().Branch.GetFirstCommitHash(branch.Name, excludedRefs)
+	if err != nil {
+		self.c.Log.Error(err)
+		return ""
+	}
+	if hash == "" {
+		return ""
+	}
+
+	title, err := self.c.Git().Commit.GetCommitMessageFirstLine(hash)
+	if err != nil {
+		self.c.Log.Error(err)
+		return ""
+	}
+	return title
+}
+
+// The pull request of a local branch is the one for the branch it pushes to on
+// origin, which is usually, but not necessarily, a branch of the same name
+func headBranchName(branch *models.Branch) string {
+	if branch.UpstreamRemote == "origin" && branch.UpstreamBranch != "" {
+		return branch.UpstreamBranch
+	}
+	return branch.Name
+}
@@ -27,6 +27,7 @@ type RefreshHelper struct {
 	mergeConflictsHelper *MergeConflictsHelper
 	worktreeHelper       *WorktreeHelper
 	searchHelper         *SearchHelper
+	pullRequestsHelper   *PullRequestsHelper
 }
 
 func NewRefreshHelper(
@@ -38,6 +39,7 @@ func NewRefreshHelper(
 	mergeConflictsHelper *MergeConflictsHelper,
 	worktreeHelper *WorktreeHelper,
 	searchHelper *SearchHelper,
+	pullRequestsHelper *PullRequestsHelper,
 ) *RefreshHelper {
 	return &RefreshHelper{
 		c:                    c,
@@ -48,6 +50,7 @@ func NewRefreshHelper(
 		mergeConflictsHelper: mergeConflictsHelper,
 		worktreeHelper:       worktreeHelper,
 		searchHelper:         searchHelper,
+		pullRequestsHelper:   pullRequestsHelper,
 	}
 }
 
@@ -91,6 +94,7 @@ func (self *RefreshHelper) Refresh(options types.RefreshOptions) {
 				types.STATUS,
 				types.BISECT_INFO,
 				types.STAGING,
+				types.SPARSE_CHECKOUT,
 			})
 		} else {
 			scopeSet = set.NewFromSlice(options.Scope)
@@ -155,6 +159,10 @@ func (self *RefreshHelper) Refresh(options types.RefreshOptions) {
 			})
 		}
 
+		if scopeSet.Includes(types.SPARSE_CHECKOUT) {
+			refresh("sparse checkout", self.refreshSparseCheckoutDirs)
+		}
+
 		if scopeSet.Includes(types.STASH) {
 			refresh("stash", func() { self.refreshStashEntries() })
 		}
@@ -222,6 +230,7 @@ func getScopeNames(scopes []types.RefreshableView) []string {
 		types.BISECT_INFO:     "bisect",
 		types.STAGING:         "staging",
 		types.MERGE_CONFLICTS: "mergeConflicts",
+		types.SPARSE_CHECKOUT: "sparseCheckout",
 	}
 
 	return lo.Map(scopes, func(scope types.RefreshableView, _ int) string {
@@ -324,6 +333,10 @@ func (self *RefreshHelper) refreshCommitsWithLimit() error {
 			Limit:                self.c.Contexts().LocalCommits.GetLimitCommits(),
 			FilterPath:           self.c.Modes().Filtering.GetPath(),
 			FilterAuthor:         self.c.Modes().Filtering.GetAuthor(),
+			FilterPickaxe:        self.c.Modes().Filtering.GetPickaxe(),
+			FilterPickaxeRegex:   self.c.Modes().Filtering.GetPickaxeRegex(),
+			FilterMessage:        self.c.Modes().Filtering.GetMessage(),
+			FilterLineRange:      self.c.Modes().Filtering.GetLineRangeArg(),
 			IncludeRebaseCommits: true,
 			RefName:              self.refForLog(),
 			RefForPushedStatus:   checkedOutRef,
@@ -337,6 +350,7 @@ func (self *RefreshHelper) refreshCommitsWithLimit() error {
 	}
 	self.c.Model().Commits = commits
 	self.RefreshAuthors(commits)
+	self.refreshNotedCommitHashes()
 	self.c.Model().WorkingTreeStateAtLastCommitRefresh = self.c.Git().Status.WorkingTreeState()
 	if checkedOutRef != nil {
 		self.c.Model().CheckedOutBranch = checkedOutRef.RefName()
@@ -348,6 +362,16 @@ func (self *RefreshHelper) refreshCommitsWithLimit() error {
 	return nil
 }
 
+func (self *RefreshHelper) refreshNotedCommitHashes() {
+	notedCommitHashes, err := self.c.Git().Notes.NotedCommitHashes()
+	if err != nil {
+		self.c.Log.Error(err)
+		notedCommitHashes = set.New[string]()
+	}
+
+	self.c.Model().NotedCommitHashes = notedCommitHashes
+}
+
 func (self *RefreshHelper) refreshSubCommitsWithLimit() error {
 	if self.c.Contexts().SubCommits.GetRef() == nil {
 		return nil
@@ -361,6 +385,9 @@ func (self *RefreshHelper) refreshSubCommitsWithLimit() error {
 			Limit:                   self.c.Contexts().SubCommits.GetLimitCommits(),
 			FilterPath:              self.c.Modes().Filtering.GetPath(),
 			FilterAuthor:            self.c.Modes().Filtering.GetAuthor(),
+			FilterPickaxe:           self.c.Modes().Filtering.GetPickaxe(),
+			FilterPickaxeRegex:      self.c.Modes().Filtering.GetPickaxeRegex(),
+			FilterMessage:           self.c.Modes().Filtering.GetMessage(),
 			IncludeRebaseCommits:    false,
 			RefName:                 self.c.Contexts().SubCommits.GetRef().FullRefName(),
 			RefToShowDivergenceFrom: self.c.Contexts().SubCommits.GetRefToShowDivergenceFrom(),
@@ -478,6 +505,10 @@ func (self *RefreshHelper) refreshBranches(refreshWorktrees bool, keepBranchSele
 
 	self.c.Model().Branches = branches
 
+	if self.c.Model().PullRequests == nil {
+		self.pullRequestsHelper.Refresh()
+	}
+
 	if refreshWorktrees {
 		self.loadWorktrees()
 		self.refreshView(self.c.Contexts().Worktrees)
@@ -529,6 +560,30 @@ func (self *RefreshHelper) refreshFilesAndSubmodules() error {
 	return nil
 }
 
+func (self *RefreshHelper) refreshSparseCheckoutDirs() {
+	isSparseCheckout := self.c.Git().SparseCheckout.IsConeModeEnabled()
+	var dirs []string
+	if isSparseCheckout {
+		var err error
+		dirs, err = self.c.Git().SparseCheckout.List()
+		if err != nil {
+			self.c.Log.Error(err)
+		}
+	}
+
+	self.c.OnUIThread(func() error {
+		self.c.Model().IsSparseCheckout = isSparseCheckout
+		self.c.Model().SparseCheckoutDirs = dirs
+		// The files outside of the sparse checkout are shown differently
+		self.refreshView(self.c.Contexts().Files)
+		// The status panel lists the sparse checkout directories in the main view
+		if self.c.Context().CurrentSide() == self.c.Contexts().Status {
+			self.c.Contexts().Status.HandleRenderToMain()
+		}
+		return nil
+	})
+}
+
 func (self *RefreshHelper) refreshStateFiles() error {
 	fileTreeViewModel := self.c.Contexts().Files.FileTreeViewModel
 
@@ -546,7 +601,8 @@ func (self *RefreshHelper) refreshStateFiles() error {
 			if file.HasMergeConflicts {
 				prevConflictFileCount++
 			}
-			if file.HasInlineMergeConflicts {
+			// Files resolved by rerere are left for the user to review and stage
+			if file.HasInlineMergeConflicts && !file.IsRerereResolved {
 				hasConflicts, err := mergeconflicts.FileHasConflictMarkers(file.Path)
 				if err != nil {
 					self.c.Log.Error(err)
@@ -580,6 +636,10 @@ func (self *RefreshHelper) refreshStateFiles() error {
 		self.c.OnUIThread(func() error { return self.mergeAndRebaseHelper.PromptToContinueRebase() })
 	}
 
+	if self.c.Modes().SplitCommit.Active() && !self.c.Modes().SplitCommit.HasUncommittedChanges(files) {
+		self.c.OnUIThread(self.mergeAndRebaseHelper.FinishSplittingCommit)
+	}
+
 	fileTreeViewModel.RWMutex.Lock()
 
 	// only taking over the filter if it hasn't already been set by the user.
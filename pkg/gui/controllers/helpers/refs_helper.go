@@ -238,6 +238,13 @@ func (self *RefsHelper) CreateSortOrderMenu(sortOptionsOrder []string, menuPromp
 }
 
 func (self *RefsHelper) CreateGitResetMenu(name string, ref string) error {
+	return self.c.Menu(types.CreateMenuOptions{
+		Title: fmt.Sprintf("%s %s", self.c.Tr.ResetTo, name),
+		Items: self.GitResetMenuItems(name, ref),
+	})
+}
+
+func (self *RefsHelper) GitResetMenuItems(name string, ref string) []*types.MenuItem {
 	type strengthWithKey struct {
 		strength string
 		label    string
@@ -251,7 +258,7 @@ func (self *RefsHelper) CreateGitResetMenu(name string, ref string) error {
 		{strength: "hard", label: "Hard reset", key: 'h', tooltip: self.c.Tr.ResetHardTooltip},
 	}
 
-	menuItems := lo.Map(strengths, func(row strengthWithKey, _ int) *types.MenuItem {
+	return lo.Map(strengths, func(row strengthWithKey, _ int) *types.MenuItem {
 		return &types.MenuItem{
 			LabelColumns: []string{
 				row.label,
@@ -272,11 +279,6 @@ func (self *RefsHelper) CreateGitResetMenu(name string, ref string) error {
 			Tooltip: row.tooltip,
 		}
 	})
-
-	return self.c.Menu(types.CreateMenuOptions{
-		Title: fmt.Sprintf("%s %s", self.c.Tr.ResetTo, name),
-		Items: menuItems,
-	})
 }
 
 func (self *RefsHelper) CreateCheckoutMenu(commit *models.Commit) error {
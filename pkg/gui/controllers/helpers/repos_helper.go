@@ -11,6 +11,7 @@ import (
 	"github.com/jesseduffield/gocui"
 	appTypes "github.com/jesseduffield/lazygit/pkg/app/types"
 	"github.com/jesseduffield/lazygit/pkg/commands"
+	"github.com/jesseduffield/lazygit/pkg/commands/git_commands"
 	"github.com/jesseduffield/lazygit/pkg/commands/models"
 	"github.com/jesseduffield/lazygit/pkg/env"
 	"github.com/jesseduffield/lazygit/pkg/gui/context"
@@ -137,9 +138,180 @@ func (self *ReposHelper) CreateRecentReposMenu() error {
 		}
 	})
 
+	// These come last so that pressing enter still switches to the most recent repo
+	menuItems = append(menuItems,
+		&types.MenuItem{
+			Label:   self.c.Tr.CloneRepository,
+			OnPress: self.promptForCloneURL,
+			Key:     'c',
+			Tooltip: self.c.Tr.CloneRepositoryTooltip,
+		},
+		&types.MenuItem{
+			Label:   self.c.Tr.InitRepository,
+			OnPress: self.promptForInitDirectory,
+			Key:     'n',
+			Tooltip: self.c.Tr.InitRepositoryTooltip,
+		},
+	)
+
 	return self.c.Menu(types.CreateMenuOptions{Title: self.c.Tr.RecentRepos, Items: menuItems})
 }
 
+// The number of lines of git's output that we show while cloning
+const cloneProgressLineCount = 10
+
+func (self *ReposHelper) promptForCloneURL() error {
+	self.c.Prompt(types.PromptOpts{
+		Title: self.c.Tr.CloneRepositoryURLPrompt,
+		HandleConfirm: func(url string) error {
+			url = strings.TrimSpace(url)
+			if url == "" {
+				return nil
+			}
+
+			self.c.Prompt(types.PromptOpts{
+				Title:          self.c.Tr.CloneRepositoryDirectoryPrompt,
+				InitialContent: self.siblingDirectory(git_commands.RepoNameFromCloneURL(url)),
+				HandleConfirm: func(directory string) error {
+					return self.cloneRepository(url, strings.TrimSpace(directory))
+				},
+			})
+
+			return nil
+		},
+	})
+
+	return nil
+}
+
+func (self *ReposHelper) cloneRepository(url string, directory string) error {
+	if directory == "" {
+		return nil
+	}
+
+	return self.c.WithWaitingStatus(self.c.Tr.CloningRepository, func(task gocui.Task) error {
+		self.c.LogAction(self.c.Tr.Actions.CloneRepository)
+
+		self.c.OnUIThread(func() error {
+			self.c.Confirm(types.ConfirmOpts{
+				Title:  self.c.Tr.CloningRepository,
+				Prompt: url,
+				// Allows the credentials prompt to replace this popup if the
+				// remote asks for a username or password
+				HasLoader: true,
+			})
+			return nil
+		})
+
+		progressWriter := &cloneProgressWriter{onUpdate: func(text string) {
+			self.c.OnUIThread(func() error {
+				if self.isShowingCloneProgress() {
+					self.c.Views().Confirmation.SetContent(text)
+				}
+				return nil
+			})
+		}}
+
+		err := self.c.Git().Clone.Clone(task, url, directory, progressWriter)
+
+		self.c.OnUIThread(func() error {
+			if self.isShowingCloneProgress() {
+				self.c.Context().Pop()
+			}
+
+			if err != nil {
+				return err
+			}
+
+			self.c.State().GetRepoPathStack().Clear()
+			return self.DispatchSwitchToRepo(directory, context.NO_CONTEXT)
+		})
+
+		return nil
+	})
+}
+
+// Only the clone progress popup has a loader, so this tells us whether the
+// user is still looking at it
+func (self *ReposHelper) isShowingCloneProgress() bool {
+	opts := self.c.State().GetRepoState().GetCurrentPopupOpts()
+	return opts != nil && opts.HasLoader &&
+		self.c.Context().Current().GetKey() == context.CONFIRMATION_CONTEXT_KEY
+}
+
+func (self *ReposHelper) promptForInitDirectory() error {
+	self.c.Prompt(types.PromptOpts{
+		Title:          self.c.Tr.InitRepositoryDirectoryPrompt,
+		InitialContent: self.siblingDirectory(""),
+		HandleConfirm: func(directory string) error {
+			directory = strings.TrimSpace(directory)
+			if directory == "" {
+				return nil
+			}
+
+			self.c.LogAction(self.c.Tr.Actions.InitRepository)
+			if err := self.c.Git().Clone.Init(directory); err != nil {
+				return err
+			}
+
+			self.c.State().GetRepoPathStack().Clear()
+			return self.DispatchSwitchToRepo(directory, context.NO_CONTEXT)
+		},
+	})
+
+	return nil
+}
+
+// New repos are created next to the current one by default, since creating
+// them inside of it is rarely what you want
+func (self *ReposHelper) siblingDirectory(name string) string {
+	wd, err := os.Getwd()
+	if err != nil {
+		return name
+	}
+
+	return filepath.Join(filepath.Dir(wd), name) + lo.Ternary(name == "", string(os.PathSeparator), "")
+}
+
+// Collects the output of `git clone` and reports the last few lines of it
+// whenever it changes
+type cloneProgressWriter struct {
+	mutex    sync.Mutex
+	output   strings.Builder
+	onUpdate func(string)
+}
+
+func (self *cloneProgressWriter) Write(p []byte) (int, error) {
+	self.mutex.Lock()
+	self.output.Write(p)
+	text := cloneProgressText(self.output.String(), cloneProgressLineCount)
+	self.mutex.Unlock()
+
+	self.onUpdate(text)
+
+	return len(p), nil
+}
+
+// Git redraws its progress lines in place using carriage returns, so we only
+// keep what comes after the last one on each line
+func cloneProgressText(output string, maxLines int) string {
+	lines := []string{}
+	for _, line := range strings.Split(strings.ReplaceAll(output, "\r\n", "\n"), "\n") {
+		segments := lo.Filter(strings.Split(line, "\r"), func(segment string, _ int) bool {
+			return segment != ""
+		})
+		if len(segments) > 0 {
+			lines = append(lines, segments[len(segments)-1])
+		}
+	}
+
+	if len(lines) > maxLines {
+		lines = lines[len(lines)-maxLines:]
+	}
+
+	return strings.Join(lines, "\n")
+}
+
 func (self *ReposHelper) DispatchSwitchToRepo(path string, contextKey types.ContextKey) error {
 	return self.DispatchSwitchTo(path, self.c.Tr.ErrRepositoryMovedOrDeleted, contextKey)
 }
@@ -0,0 +1,47 @@
+package helpers
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCloneProgressText(t *testing.T) {
+	scenarios := []struct {
+		name     string
+		output   string
+		maxLines int
+		expected string
+	}{
+		{
+			name:     "empty",
+			output:   "",
+			maxLines: 3,
+			expected: "",
+		},
+		{
+			name:     "plain lines",
+			output:   "Cloning into 'repo'...\nremote: Enumerating objects: 3, done.\n",
+			maxLines: 3,
+			expected: "Cloning into 'repo'...\nremote: Enumerating objects: 3, done.",
+		},
+		{
+			name:     "progress redrawn with carriage returns",
+			output:   "Cloning into 'repo'...\nReceiving objects:  50% (1/2)\rReceiving objects: 100% (2/2)\rReceiving objects: 100% (2/2), done.\r",
+			maxLines: 3,
+			expected: "Cloning into 'repo'...\nReceiving objects: 100% (2/2), done.",
+		},
+		{
+			name:     "only the last lines are kept",
+			output:   "a\nb\r\nc\nd\n",
+			maxLines: 2,
+			expected: "c\nd",
+		},
+	}
+
+	for _, s := range scenarios {
+		t.Run(s.name, func(t *testing.T) {
+			assert.Equal(t, s.expected, cloneProgressText(s.output, s.maxLines))
+		})
+	}
+}
@@ -0,0 +1,154 @@
+package helpers
+
+import (
+	"slices"
+	"strings"
+
+	"github.com/jesseduffield/gocui"
+	"github.com/jesseduffield/lazygit/pkg/gui/types"
+	"github.com/samber/lo"
+)
+
+type SparseCheckoutHelper struct {
+	c *HelperCommon
+}
+
+func NewSparseCheckoutHelper(c *HelperCommon) *SparseCheckoutHelper {
+	return &SparseCheckoutHelper{
+		c: c,
+	}
+}
+
+func (self *SparseCheckoutHelper) OpenMenu() error {
+	notEnabledReason := self.notEnabledReason()
+
+	removeDirDisabledReason := notEnabledReason
+	if removeDirDisabledReason == nil && len(self.c.Model().SparseCheckoutDirs) == 0 {
+		removeDirDisabledReason = &types.DisabledReason{Text: self.c.Tr.SparseCheckoutNoDirs}
+	}
+
+	return self.c.Menu(types.CreateMenuOptions{
+		Title: self.c.Tr.SparseCheckoutTitle,
+		Items: []*types.MenuItem{
+			{
+				Label:   self.c.Tr.SparseCheckoutAddDir,
+				Tooltip: self.c.Tr.SparseCheckoutAddDirTooltip,
+				OnPress: self.promptForDirToAdd,
+				Key:     'a',
+			},
+			{
+				Label:          self.c.Tr.SparseCheckoutRemoveDir,
+				Tooltip:        self.c.Tr.SparseCheckoutRemoveDirTooltip,
+				OnPress:        self.openRemoveDirMenu,
+				Key:            'd',
+				OpensMenu:      true,
+				DisabledReason: removeDirDisabledReason,
+			},
+			{
+				Label:          self.c.Tr.SparseCheckoutReapply,
+				Tooltip:        self.c.Tr.SparseCheckoutReapplyTooltip,
+				OnPress:        self.reapply,
+				Key:            'r',
+				DisabledReason: notEnabledReason,
+			},
+			{
+				Label:          self.c.Tr.SparseCheckoutDisable,
+				Tooltip:        self.c.Tr.SparseCheckoutDisableTooltip,
+				OnPress:        self.disable,
+				Key:            'x',
+				DisabledReason: notEnabledReason,
+			},
+		},
+	})
+}
+
+// Adds the given directory to the sparse checkout if it isn't part of it yet,
+// or removes it otherwise
+func (self *SparseCheckoutHelper) ToggleDir(dir string) error {
+	if slices.Contains(self.c.Model().SparseCheckoutDirs, dir) {
+		return self.RemoveDir(dir)
+	}
+
+	return self.AddDir(dir)
+}
+
+// Adds a directory to the sparse checkout, turning the working tree into a
+// sparse checkout if it isn't one already
+func (self *SparseCheckoutHelper) AddDir(dir string) error {
+	return self.update(self.c.Tr.Actions.AddToSparseCheckout, func() error {
+		if !self.c.Git().SparseCheckout.IsConeModeEnabled() {
+			return self.c.Git().SparseCheckout.Set([]string{dir})
+		}
+
+		return self.c.Git().SparseCheckout.Add([]string{dir})
+	})
+}
+
+func (self *SparseCheckoutHelper) RemoveDir(dir string) error {
+	remainingDirs := lo.Without(self.c.Model().SparseCheckoutDirs, dir)
+
+	return self.update(self.c.Tr.Actions.RemoveFromSparseCheckout, func() error {
+		return self.c.Git().SparseCheckout.Set(remainingDirs)
+	})
+}
+
+func (self *SparseCheckoutHelper) promptForDirToAdd() error {
+	self.c.Prompt(types.PromptOpts{
+		Title: self.c.Tr.SparseCheckoutEnterDir,
+		HandleConfirm: func(dir string) error {
+			dir = strings.Trim(strings.TrimSpace(dir), "/")
+			if dir == "" {
+				return nil
+			}
+
+			return self.AddDir(dir)
+		},
+	})
+
+	return nil
+}
+
+func (self *SparseCheckoutHelper) openRemoveDirMenu() error {
+	menuItems := lo.Map(self.c.Model().SparseCheckoutDirs, func(dir string, _ int) *types.MenuItem {
+		return &types.MenuItem{
+			Label: dir,
+			OnPress: func() error {
+				return self.RemoveDir(dir)
+			},
+		}
+	})
+
+	return self.c.Menu(types.CreateMenuOptions{
+		Title: self.c.Tr.SparseCheckoutRemoveDir,
+		Items: menuItems,
+	})
+}
+
+func (self *SparseCheckoutHelper) reapply() error {
+	return self.update(self.c.Tr.Actions.ReapplySparseCheckout, self.c.Git().SparseCheckout.Reapply)
+}
+
+func (self *SparseCheckoutHelper) disable() error {
+	return self.update(self.c.Tr.Actions.DisableSparseCheckout, self.c.Git().SparseCheckout.Disable)
+}
+
+func (self *SparseCheckoutHelper) update(action string, f func() error) error {
+	return self.c.WithWaitingStatus(self.c.Tr.UpdatingSparseCheckout, func(gocui.Task) error {
+		self.c.LogAction(action)
+		err := f()
+
+		// Refresh even if the command failed, because it may have updated part
+		// of the working tree already
+		self.c.Refresh(types.RefreshOptions{Mode: types.SYNC, Scope: []types.RefreshableView{types.FILES, types.SPARSE_CHECKOUT}})
+
+		return err
+	})
+}
+
+func (self *SparseCheckoutHelper) notEnabledReason() *types.DisabledReason {
+	if !self.c.Model().IsSparseCheckout {
+		return &types.DisabledReason{Text: self.c.Tr.SparseCheckoutNotEnabled}
+	}
+
+	return nil
+}
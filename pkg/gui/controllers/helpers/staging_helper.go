@@ -1,9 +1,16 @@
 package helpers
 
 import (
+	"errors"
+	"fmt"
+	"regexp"
+
 	"github.com/jesseduffield/lazygit/pkg/commands/models"
+	"github.com/jesseduffield/lazygit/pkg/gui/filetree"
+	"github.com/jesseduffield/lazygit/pkg/gui/modes/hunk_review"
 	"github.com/jesseduffield/lazygit/pkg/gui/patch_exploring"
 	"github.com/jesseduffield/lazygit/pkg/gui/types"
+	"github.com/samber/lo"
 )
 
 type StagingHelper struct {
@@ -43,17 +50,29 @@ func (self *StagingHelper) RefreshStagingPanel(focusOpts types.OnFocusOpts) {
 	secondaryContext := self.c.Contexts().StagingSecondary
 
 	var file *models.File
-	node := self.c.Contexts().Files.GetSelected()
-	if node != nil {
-		file = node.File
-	}
+	var mainDiff string
+	var reviewUnit *hunk_review.Unit
+	if mainFocused && self.c.Modes().HunkReview.Active() {
+		var found bool
+		file, mainDiff, reviewUnit, found = self.nextHunkToReview()
+		if !found {
+			self.finishHunkReview()
+			return
+		}
+	} else {
+		node := self.c.Contexts().Files.GetSelected()
+		if node != nil {
+			file = node.File
+		}
 
-	if file == nil || (!file.HasUnstagedChanges && !file.HasStagedChanges) {
-		self.handleStagingEscape()
-		return
+		if file == nil || (!file.HasUnstagedChanges && !file.HasStagedChanges) {
+			self.handleStagingEscape()
+			return
+		}
+
+		mainDiff = self.c.Git().WorkingTree.WorktreeFileDiff(file, true, false)
 	}
 
-	mainDiff := self.c.Git().WorkingTree.WorktreeFileDiff(file, true, false)
 	secondaryDiff := self.c.Git().WorkingTree.WorktreeFileDiff(file, true, true)
 
 	// grabbing locks here and releasing before we finish the function
@@ -63,17 +82,26 @@ func (self *StagingHelper) RefreshStagingPanel(focusOpts types.OnFocusOpts) {
 	secondaryContext.GetMutex().Lock()
 
 	hunkMode := self.c.UserConfig().Gui.UseHunkModeInStagingView
+	sideBySide := self.c.UserConfig().Git.SideBySideDiffInDiffView
 	mainContext.SetState(
-		patch_exploring.NewState(mainDiff, mainSelectedLineIdx, mainContext.GetView(), mainContext.GetState(), hunkMode),
+		patch_exploring.NewState(mainDiff, mainSelectedLineIdx, mainContext.GetView(), mainContext.GetState(), hunkMode, sideBySide),
 	)
 
 	secondaryContext.SetState(
-		patch_exploring.NewState(secondaryDiff, secondarySelectedLineIdx, secondaryContext.GetView(), secondaryContext.GetState(), hunkMode),
+		patch_exploring.NewState(secondaryDiff, secondarySelectedLineIdx, secondaryContext.GetView(), secondaryContext.GetState(), hunkMode, sideBySide),
 	)
 
 	mainState := mainContext.GetState()
 	secondaryState := secondaryContext.GetState()
 
+	mainTitle := self.c.Tr.UnstagedChanges
+	if reviewUnit != nil && mainState != nil {
+		mainState.SelectPatchRange(reviewUnit.First, reviewUnit.Last)
+		hunk, hunkCount, fileNumber, fileCount := self.c.Modes().HunkReview.Progress()
+		mainTitle = fmt.Sprintf("%s (%s)", mainTitle,
+			fmt.Sprintf(self.c.Tr.HunkReviewProgress, hunk, hunkCount, fileNumber, fileCount))
+	}
+
 	mainContent := mainContext.GetContentToRender()
 	secondaryContent := secondaryContext.GetContentToRender()
 
@@ -105,7 +133,7 @@ func (self *StagingHelper) RefreshStagingPanel(focusOpts types.OnFocusOpts) {
 		Pair: self.c.MainViewPairs().Staging,
 		Main: &types.ViewUpdateOpts{
 			Task:  types.NewRenderStringWithoutScrollTask(mainContent),
-			Title: self.c.Tr.UnstagedChanges,
+			Title: mainTitle,
 		},
 		Secondary: &types.ViewUpdateOpts{
 			Task:  types.NewRenderStringWithoutScrollTask(secondaryContent),
@@ -114,6 +142,99 @@ func (self *StagingHelper) RefreshStagingPanel(focusOpts types.OnFocusOpts) {
 	})
 }
 
+// Starts going through the unstaged hunks of all files, optionally only those
+// with a changed line of the given kind matching the given regex
+func (self *StagingHelper) StartHunkReview(filterStr string, filterLines hunk_review.FilterLines) error {
+	var filter *hunk_review.Filter
+	if filterStr != "" {
+		re, err := regexp.Compile(filterStr)
+		if err != nil {
+			return err
+		}
+		filter = &hunk_review.Filter{Regexp: re, Lines: filterLines}
+	}
+
+	files := self.filesWithUnstagedChanges()
+	paths := lo.Map(files, func(file *models.File, _ int) string { return file.Path })
+	diffs := lo.Map(files, func(file *models.File, _ int) string {
+		return self.c.Git().WorkingTree.WorktreeFileDiff(file, true, false)
+	})
+	if !self.c.Modes().HunkReview.Start(paths, diffs, filter) {
+		return errors.New(self.c.Tr.NoHunksToReview)
+	}
+
+	self.c.Context().Push(self.c.Contexts().Staging, types.OnFocusOpts{})
+	return nil
+}
+
+// Returns the files with unstaged changes in the order they're shown in the
+// files panel
+func (self *StagingHelper) filesWithUnstagedChanges() []*models.File {
+	root := self.c.Contexts().Files.GetRoot()
+	if root == nil {
+		return nil
+	}
+
+	submoduleConfigs := self.c.Model().Submodules
+	return lo.FilterMap(root.GetLeaves(), func(node *filetree.Node[models.File], _ int) (*models.File, bool) {
+		file := node.File
+		return file, file != nil && file.HasUnstagedChanges && !file.HasMergeConflicts && !file.IsSubmodule(submoduleConfigs)
+	})
+}
+
+// Returns the file and the unstaged diff that the next hunk to review is in,
+// moving on to the next file of the review as needed, and selects the file in
+// the files panel
+func (self *StagingHelper) nextHunkToReview() (*models.File, string, *hunk_review.Unit, bool) {
+	review := &self.c.Modes().HunkReview
+	for path := review.CurrentPath(); path != ""; path = review.NextFile() {
+		file := self.c.Contexts().Files.GetFile(path)
+		if file == nil || !file.HasUnstagedChanges {
+			continue
+		}
+
+		diff := self.c.Git().WorkingTree.WorktreeFileDiff(file, true, false)
+		if unit, ok := review.CurrentUnit(diff); ok {
+			self.selectFile(file)
+			return file, diff, &unit, true
+		}
+	}
+
+	return nil, "", nil, false
+}
+
+func (self *StagingHelper) selectFile(file *models.File) {
+	filesContext := self.c.Contexts().Files
+	if filesContext.GetSelectedFile() == file {
+		return
+	}
+
+	path := filetree.InternalTreePathForFilePath(file.Path, self.c.UserConfig().Gui.ShowRootItemInFileTree)
+	filesContext.ExpandToPath(path)
+	filesContext.SetTree()
+	if index, found := filesContext.GetIndexForPath(path); found {
+		filesContext.SetSelection(index)
+	}
+	self.c.PostRefreshUpdate(filesContext)
+}
+
+func (self *StagingHelper) finishHunkReview() {
+	self.c.Modes().HunkReview.Reset()
+	self.c.Toast(self.c.Tr.HunkReviewFinished)
+	self.handleStagingEscape()
+}
+
+// Opens the staging view for the first file with unstaged changes
+func (self *StagingHelper) StageFirstFile() {
+	files := self.filesWithUnstagedChanges()
+	if len(files) == 0 {
+		return
+	}
+
+	self.selectFile(files[0])
+	self.c.Context().Push(self.c.Contexts().Staging, types.OnFocusOpts{})
+}
+
 func (self *StagingHelper) handleStagingEscape() {
 	self.c.Context().Push(self.c.Contexts().Files, types.OnFocusOpts{})
 }
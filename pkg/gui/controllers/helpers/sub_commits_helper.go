@@ -37,6 +37,9 @@ func (self *SubCommitsHelper) ViewSubCommits(opts ViewSubCommitsOpts) error {
 			Limit:                   true,
 			FilterPath:              self.c.Modes().Filtering.GetPath(),
 			FilterAuthor:            self.c.Modes().Filtering.GetAuthor(),
+			FilterPickaxe:           self.c.Modes().Filtering.GetPickaxe(),
+			FilterPickaxeRegex:      self.c.Modes().Filtering.GetPickaxeRegex(),
+			FilterMessage:           self.c.Modes().Filtering.GetMessage(),
 			IncludeRebaseCommits:    false,
 			RefName:                 opts.Ref.FullRefName(),
 			RefForPushedStatus:      opts.Ref,
@@ -52,6 +52,9 @@ type WindowArrangementArgs struct {
 	// Whether the main panel is split (as is the case e.g. when a file has both
 	// staged and unstaged changes)
 	SplitMainPanel bool
+	// Whether the two halves of the split main panel show the old and the new
+	// side of a diff, in which case they always go next to each other
+	MainPanelShowsSideBySideDiff bool
 	// The current screen mode (normal, half, full)
 	ScreenMode types.ScreenMode
 	// The content shown on the bottom left of the screen when showing a loader
@@ -85,20 +88,21 @@ func (self *WindowArrangementHelper) GetWindowDimensions(informationStr string,
 	}
 
 	args := WindowArrangementArgs{
-		Width:             width,
-		Height:            height,
-		UserConfig:        self.c.UserConfig(),
-		CurrentWindow:     self.c.Context().CurrentStatic().GetWindowName(),
-		CurrentSideWindow: self.c.Context().CurrentSide().GetWindowName(),
-		SplitMainPanel:    repoState.GetSplitMainPanel(),
-		ScreenMode:        repoState.GetScreenMode(),
-		AppStatus:         appStatus,
-		InformationStr:    informationStr,
-		ShowExtrasWindow:  self.c.State().GetShowExtrasWindow(),
-		InDemo:            self.c.InDemo(),
-		IsAnyModeActive:   self.modeHelper.IsAnyModeActive(),
-		InSearchPrompt:    repoState.InSearchPrompt(),
-		SearchPrefix:      searchPrefix,
+		Width:                        width,
+		Height:                       height,
+		UserConfig:                   self.c.UserConfig(),
+		CurrentWindow:                self.c.Context().CurrentStatic().GetWindowName(),
+		CurrentSideWindow:            self.c.Context().CurrentSide().GetWindowName(),
+		SplitMainPanel:               repoState.GetSplitMainPanel(),
+		MainPanelShowsSideBySideDiff: repoState.GetMainPanelShowsSideBySideDiff(),
+		ScreenMode:                   repoState.GetScreenMode(),
+		AppStatus:                    appStatus,
+		InformationStr:               informationStr,
+		ShowExtrasWindow:             self.c.State().GetShowExtrasWindow(),
+		InDemo:                       self.c.InDemo(),
+		IsAnyModeActive:              self.modeHelper.IsAnyModeActive(),
+		InSearchPrompt:               repoState.InSearchPrompt(),
+		SearchPrefix:                 searchPrefix,
 	}
 
 	return GetWindowDimensions(args)
@@ -374,6 +378,10 @@ func splitMainPanelSideBySide(args WindowArrangementArgs) bool {
 		return false
 	}
 
+	if args.MainPanelShowsSideBySideDiff {
+		return true
+	}
+
 	mainPanelSplitMode := args.UserConfig.Gui.MainPanelSplitMode
 	switch mainPanelSplitMode {
 	case "vertical":
@@ -441,6 +441,30 @@ func TestGetWindowDimensions(t *testing.T) {
 			C: information
 			`,
 		},
+		{
+			name: "side-by-side diff with vertical mainPanelSplitMode",
+			mutateArgs: func(args *WindowArrangementArgs) {
+				args.Height = 12
+				args.UserConfig.Gui.MainPanelSplitMode = "vertical"
+				args.SplitMainPanel = true
+				args.MainPanelShowsSideBySideDiff = true
+				args.UserConfig.Gui.ShowBottomLine = false
+			},
+			expected: `
+			<status─────>╭main─────────────────────────╮╭secondary────────────────────╮
+			╭files──────╮│                             ││                             │
+			│           ││                             ││                             │
+			│           ││                             ││                             │
+			│           ││                             ││                             │
+			│           ││                             ││                             │
+			│           ││                             ││                             │
+			│           ││                             ││                             │
+			╰───────────╯│                             ││                             │
+			<branches───>│                             ││                             │
+			<commits────>│                             ││                             │
+			<stash──────>╰─────────────────────────────╯╰─────────────────────────────╯
+			`,
+		},
 		{
 			name: "app status present with very long information but without options",
 			mutateArgs: func(args *WindowArrangementArgs) {
@@ -355,6 +355,12 @@ func (self *WorkingTreeHelper) CreateMergeConflictMenu(selectedFilepaths []strin
 		return err
 	}
 
+	rerereResolvedPaths := self.mergeAndRebaseHelper.RerereResolvedPaths(selectedFilepaths)
+	var forgetRerereResolutionDisabledReason *types.DisabledReason
+	if len(rerereResolvedPaths) == 0 {
+		forgetRerereResolutionDisabledReason = &types.DisabledReason{Text: self.c.Tr.NoRerereResolvedFilesSelected}
+	}
+
 	cmdColor := style.FgBlue
 	return self.c.Menu(types.CreateMenuOptions{
 		Title: self.c.Tr.MergeConflictOptionsTitle,
@@ -397,6 +403,18 @@ func (self *WorkingTreeHelper) CreateMergeConflictMenu(selectedFilepaths []strin
 				OnPress: self.OpenMergeTool,
 				Key:     'm',
 			},
+			{
+				LabelColumns: []string{
+					self.c.Tr.ForgetRerereResolution,
+					cmdColor.Sprint("git rerere forget"),
+				},
+				Tooltip: self.c.Tr.ForgetRerereResolutionTooltip,
+				OnPress: func() error {
+					return self.mergeAndRebaseHelper.ForgetRerereResolutions(rerereResolvedPaths)
+				},
+				Key:            'f',
+				DisabledReason: forgetRerereResolutionDisabledReason,
+			},
 		},
 	})
 }
@@ -140,6 +140,19 @@ func (self *ListControllerTrait[T]) withItemsRange(callback func([]T, int, int)
 	}
 }
 
+// Like withItem, but for the GetMenuItems function of a binding
+func (self *ListControllerTrait[T]) withItemMenuItems(callback func(T) []*types.MenuItem) func() []*types.MenuItem {
+	return func() []*types.MenuItem {
+		var zeroValue T
+		item := self.getSelectedItem()
+		if item == zeroValue {
+			return nil
+		}
+
+		return callback(item)
+	}
+}
+
 // Like withItem, but doesn't show an error message if no item is selected.
 // Use this for click actions (it's a no-op to click empty space)
 func (self *ListControllerTrait[T]) withItemGraceful(callback func(T) error) func() error {
@@ -1,6 +1,8 @@
 package controllers
 
 import (
+	"path/filepath"
+	"strconv"
 	"strings"
 
 	"github.com/go-errors/errors"
@@ -244,6 +246,39 @@ func (self *LocalCommitsController) GetKeybindings(opts types.KeybindingsOpts) [
 			Tooltip:     self.c.Tr.OpenLogMenuTooltip,
 			OpensMenu:   true,
 		},
+		{
+			Key:               opts.GetKey(opts.Config.Commits.ViewNoteOptions),
+			Handler:           self.withItem(self.noteOptions),
+			GetDisabledReason: self.require(self.singleItemSelected(self.canAttachNote)),
+			Description:       self.c.Tr.ViewNoteOptions,
+			Tooltip:           self.c.Tr.ViewNoteOptionsTooltip,
+			OpensMenu:         true,
+		},
+		{
+			Key:         opts.GetKey(opts.Config.Commits.ViewPatchFileOptions),
+			Handler:     self.patchFileOptions,
+			Description: self.c.Tr.ViewPatchFileOptions,
+			Tooltip:     self.c.Tr.ViewPatchFileOptionsTooltip,
+			OpensMenu:   true,
+		},
+		{
+			Key:     opts.GetKey(opts.Config.Commits.SplitCommit),
+			Handler: opts.Guards.OutsideFilterMode(self.withItem(self.splitCommit)),
+			GetDisabledReason: self.require(
+				self.notMidRebase(self.c.Tr.AlreadyRebasing),
+				self.singleItemSelected(self.canSplit),
+			),
+			Description: self.c.Tr.SplitCommit,
+			Tooltip:     self.c.Tr.SplitCommitTooltip,
+		},
+		{
+			Key:               opts.GetKey(opts.Config.Commits.ViewCheckStatus),
+			Handler:           self.withItem(self.viewCheckStatus),
+			GetDisabledReason: self.require(self.singleItemSelected(self.c.Helpers().CheckStatus.CanOpenMenuForCommit)),
+			Description:       self.c.Tr.ViewCheckStatus,
+			Tooltip:           self.c.Tr.ViewCheckStatusTooltip,
+			OpensMenu:         true,
+		},
 	}
 
 	return bindings
@@ -836,6 +871,239 @@ func (self *LocalCommitsController) addCoAuthor(start, end int) error {
 	return nil
 }
 
+func (self *LocalCommitsController) noteOptions(commit *models.Commit) error {
+	hasNote := self.c.Model().NotedCommitHashes.Includes(commit.Hash())
+
+	var removeDisabledReason *types.DisabledReason
+	if !hasNote {
+		removeDisabledReason = &types.DisabledReason{Text: self.c.Tr.CommitHasNoNote}
+	}
+
+	return self.c.Menu(types.CreateMenuOptions{
+		Title: self.c.Tr.NoteMenuTitle,
+		Items: []*types.MenuItem{
+			{
+				Label:   lo.Ternary(hasNote, self.c.Tr.EditNote, self.c.Tr.AddNote),
+				OnPress: func() error { return self.editNote(commit, hasNote) },
+				Key:     'e',
+				Tooltip: self.c.Tr.EditNoteTooltip,
+			},
+			{
+				Label:          self.c.Tr.RemoveNote,
+				OnPress:        func() error { return self.removeNote(commit) },
+				Key:            'd',
+				Tooltip:        self.c.Tr.RemoveNoteTooltip,
+				DisabledReason: removeDisabledReason,
+			},
+		},
+	})
+}
+
+func (self *LocalCommitsController) editNote(commit *models.Commit, hasNote bool) error {
+	note := ""
+	if hasNote {
+		var err error
+		note, err = self.c.Git().Notes.Show(commit.Hash())
+		if err != nil {
+			return err
+		}
+	}
+
+	self.c.Helpers().Commits.OpenCommitMessagePanel(
+		&helpers.OpenCommitMessagePanelOpts{
+			CommitIndex:      self.context().GetSelectedLineIdx(),
+			InitialMessage:   note,
+			SummaryTitle:     lo.Ternary(hasNote, self.c.Tr.EditNote, self.c.Tr.AddNote),
+			DescriptionTitle: self.c.Tr.NoteDescriptionTitle,
+			PreserveMessage:  false,
+			OnConfirm: func(summary string, description string) error {
+				self.c.LogAction(self.c.Tr.Actions.EditCommitNote)
+				var err error
+				if hasNote {
+					err = self.c.Git().Notes.Edit(commit.Hash(), summary, description)
+				} else {
+					err = self.c.Git().Notes.Add(commit.Hash(), summary, description)
+				}
+				if err != nil {
+					return err
+				}
+
+				self.c.Refresh(types.RefreshOptions{Mode: types.ASYNC, Scope: []types.RefreshableView{types.COMMITS}})
+				return nil
+			},
+		},
+	)
+
+	return nil
+}
+
+func (self *LocalCommitsController) removeNote(commit *models.Commit) error {
+	self.c.LogAction(self.c.Tr.Actions.RemoveCommitNote)
+	if err := self.c.Git().Notes.Remove(commit.Hash()); err != nil {
+		return err
+	}
+
+	self.c.Refresh(types.RefreshOptions{Mode: types.ASYNC, Scope: []types.RefreshableView{types.COMMITS}})
+	return nil
+}
+
+func (self *LocalCommitsController) canAttachNote(commit *models.Commit) *types.DisabledReason {
+	if commit.IsTODO() {
+		return &types.DisabledReason{Text: self.c.Tr.CannotAttachNoteToTodoCommit}
+	}
+
+	return nil
+}
+
+func (self *LocalCommitsController) canSplit(commit *models.Commit) *types.DisabledReason {
+	if commit.IsMerge() {
+		return &types.DisabledReason{Text: self.c.Tr.CannotSplitMergeCommit}
+	}
+
+	if commit.IsFirstCommit() {
+		return &types.DisabledReason{Text: self.c.Tr.CannotSplitFirstCommit}
+	}
+
+	return nil
+}
+
+func (self *LocalCommitsController) splitCommit(commit *models.Commit) error {
+	message, err := self.c.Git().Commit.GetCommitMessage(commit.Hash())
+	if err != nil {
+		return err
+	}
+
+	idx := self.context().GetSelectedLineIdx()
+	return self.c.WithWaitingStatus(self.c.Tr.RebasingStatus, func(gocui.Task) error {
+		self.c.LogAction(self.c.Tr.Actions.SplitCommit)
+		err := self.c.Git().Rebase.InteractiveRebase(self.c.Model().Commits, idx, idx, todo.Edit)
+		if err != nil {
+			return self.c.Helpers().MergeAndRebase.CheckMergeOrRebase(err)
+		}
+
+		files, err := self.c.Git().Loaders.CommitFileLoader.GetFilesInDiff(commit.ParentRefName(), commit.Hash(), false)
+		if err != nil {
+			return err
+		}
+
+		// Undo the commit, but keep its changes in the working tree (unstaged)
+		if err := self.c.Git().WorkingTree.ResetMixed("HEAD^"); err != nil {
+			return err
+		}
+
+		self.c.Modes().SplitCommit.Start(commit.Hash(), lo.Map(files, func(file *models.CommitFile, _ int) string {
+			return file.Path
+		}))
+		// Pre-fill the message of the first new commit with the original one
+		self.c.Contexts().CommitMessage.SetPreservedMessageAndLogError(message)
+
+		self.c.Refresh(types.RefreshOptions{Mode: types.BLOCK_UI, Then: func() {
+			self.c.Context().Push(self.c.Contexts().Files, types.OnFocusOpts{})
+			self.c.Helpers().Staging.StageFirstFile()
+			self.c.Toast(self.c.Tr.SplitCommitHint)
+		}})
+		return nil
+	})
+}
+
+func (self *LocalCommitsController) patchFileOptions() error {
+	commits, _, _ := self.context().GetSelectedItems()
+
+	var exportDisabledReason *types.DisabledReason
+	if len(commits) == 0 {
+		exportDisabledReason = &types.DisabledReason{Text: self.c.Tr.NoItemSelected}
+	} else if lo.SomeBy(commits, func(commit *models.Commit) bool { return commit.IsTODO() }) {
+		exportDisabledReason = &types.DisabledReason{Text: self.c.Tr.CannotExportTodoCommits}
+	}
+
+	var applyDisabledReason *types.DisabledReason
+	if self.isRebasing() {
+		applyDisabledReason = &types.DisabledReason{Text: self.c.Tr.CannotApplyPatchesMidMergeOrRebase}
+	}
+
+	return self.c.Menu(types.CreateMenuOptions{
+		Title: self.c.Tr.PatchFilesTitle,
+		Items: []*types.MenuItem{
+			{
+				Label:          self.c.Tr.ExportPatches,
+				OnPress:        func() error { return self.exportPatches(commits, false) },
+				Key:            'e',
+				Tooltip:        self.c.Tr.ExportPatchesTooltip,
+				DisabledReason: exportDisabledReason,
+			},
+			{
+				Label:          self.c.Tr.ExportPatchesWithCoverLetter,
+				OnPress:        func() error { return self.exportPatches(commits, true) },
+				Key:            'c',
+				Tooltip:        self.c.Tr.ExportPatchesWithCoverLetterTooltip,
+				DisabledReason: exportDisabledReason,
+			},
+			{
+				Label:          self.c.Tr.ApplyMailbox,
+				OnPress:        self.applyMailbox,
+				Key:            'a',
+				Tooltip:        self.c.Tr.ApplyMailboxTooltip,
+				DisabledReason: applyDisabledReason,
+			},
+		},
+	})
+}
+
+func (self *LocalCommitsController) exportPatches(commits []*models.Commit, coverLetter bool) error {
+	self.c.Prompt(types.PromptOpts{
+		Title:          self.c.Tr.ExportPatchesDirectoryPrompt,
+		InitialContent: "patches",
+		HandleConfirm: func(directory string) error {
+			directory = strings.TrimSpace(directory)
+			if directory == "" {
+				return nil
+			}
+			outputDir := directory
+			if !filepath.IsAbs(outputDir) {
+				outputDir = filepath.Join(self.c.Git().RepoPaths.WorktreePath(), outputDir)
+			}
+
+			return self.c.WithWaitingStatus(self.c.Tr.ExportingPatches, func(gocui.Task) error {
+				self.c.LogAction(self.c.Tr.Actions.ExportPatches)
+				hashes := lo.Map(commits, func(commit *models.Commit, _ int) string { return commit.Hash() })
+				files, err := self.c.Git().Commit.FormatPatch(hashes, outputDir, coverLetter)
+				if err != nil {
+					return err
+				}
+
+				self.c.Toast(utils.ResolvePlaceholderString(self.c.Tr.ExportedPatches, map[string]string{
+					"count":     strconv.Itoa(len(files)),
+					"directory": directory,
+				}))
+				return nil
+			})
+		},
+	})
+
+	return nil
+}
+
+func (self *LocalCommitsController) applyMailbox() error {
+	self.c.Prompt(types.PromptOpts{
+		Title:               self.c.Tr.ApplyMailboxPathPrompt,
+		FindSuggestionsFunc: self.c.Helpers().Suggestions.GetFilePathSuggestionsFunc(),
+		HandleConfirm: func(path string) error {
+			path = strings.TrimSpace(path)
+			if path == "" {
+				return nil
+			}
+
+			self.c.LogAction(self.c.Tr.Actions.ApplyMailbox)
+			return self.c.WithWaitingStatusSync(self.c.Tr.ApplyingPatchesStatus, func() error {
+				result := self.c.Git().Rebase.ApplyMailbox(path)
+				return self.c.Helpers().MergeAndRebase.CheckMergeOrRebaseWithRefreshOptions(result, types.RefreshOptions{Mode: types.SYNC})
+			})
+		},
+	})
+
+	return nil
+}
+
 func (self *LocalCommitsController) revert(commits []*models.Commit, start, end int) error {
 	var promptText string
 	if len(commits) == 1 {
@@ -1485,3 +1753,7 @@ func (self *LocalCommitsController) pickEnabled(selectedCommits []*models.Commit
 
 	return self.midRebaseCommandEnabled(selectedCommits, startIdx, endIdx)
 }
+
+func (self *LocalCommitsController) viewCheckStatus(commit *models.Commit) error {
+	return self.c.Helpers().CheckStatus.OpenMenu(commit.Hash())
+}
@@ -2,6 +2,8 @@ package controllers
 
 import (
 	"os"
+	"path/filepath"
+	"time"
 
 	"github.com/jesseduffield/gocui"
 	"github.com/jesseduffield/lazygit/pkg/gui/context"
@@ -39,6 +41,24 @@ func (self *MergeConflictsController) GetKeybindings(opts types.KeybindingsOpts)
 			Description:     self.c.Tr.PickAllHunks,
 			DisplayOnScreen: true,
 		},
+		{
+			Key:         opts.GetKey(opts.Config.Main.PickBothHunksInOrder),
+			Handler:     self.withRenderAndFocus(self.HandlePickBothHunksInOrder),
+			Description: self.c.Tr.PickBothHunksInOrder,
+			Tooltip:     self.c.Tr.PickBothHunksInOrderTooltip,
+		},
+		{
+			Key:         opts.GetKey(opts.Config.Main.EditSelectHunk),
+			Handler:     self.HandleEditConflictResult,
+			Description: self.c.Tr.EditConflictResult,
+			Tooltip:     self.c.Tr.EditConflictResultTooltip,
+		},
+		{
+			Key:         opts.GetKey(opts.Config.Main.ToggleConflictSplitView),
+			Handler:     self.HandleToggleSplitView,
+			Description: self.c.Tr.ToggleConflictSplitView,
+			Tooltip:     self.c.Tr.ToggleConflictSplitViewTooltip,
+		},
 		{
 			Key:             opts.GetKey(opts.Config.Universal.PrevItem),
 			Handler:         self.withRenderAndFocus(self.PrevConflictHunk),
@@ -267,6 +287,86 @@ func (self *MergeConflictsController) HandlePickAllHunks() error {
 	return self.pickSelection(mergeconflicts.ALL)
 }
 
+func (self *MergeConflictsController) HandlePickBothHunksInOrder() error {
+	return self.pickSelection(mergeconflicts.BOTH)
+}
+
+func (self *MergeConflictsController) HandleToggleSplitView() error {
+	self.context().ToggleSplitView()
+	self.c.Helpers().MergeConflicts.Render()
+
+	return nil
+}
+
+// Lets the user write the resolution of the selected conflict in their editor,
+// starting from the conflict as it appears in the file (markers included).
+func (self *MergeConflictsController) HandleEditConflictResult() error {
+	var conflictText, path string
+	err := self.withLock(func() error {
+		conflictText = self.context().GetState().CurrentConflictText()
+		path = self.context().GetState().GetPath()
+		return nil
+	})()
+	if err != nil || conflictText == "" {
+		return err
+	}
+
+	tempPath := filepath.Join(
+		self.c.OS().GetTempDir(),
+		self.c.Git().RepoPaths.RepoName(),
+		time.Now().Format("Jan _2 15.04.05.000000000")+filepath.Ext(path),
+	)
+	if err := self.c.OS().CreateFileWithContent(tempPath, conflictText); err != nil {
+		return err
+	}
+	defer os.Remove(tempPath)
+
+	// We can't hold the lock while the editor is open, because the files
+	// refresh that happens in the meantime needs it too
+	if err := self.c.Helpers().Files.EditFileAtLineAndWait(tempPath, 1); err != nil {
+		return err
+	}
+
+	resolution, err := os.ReadFile(tempPath)
+	if err != nil {
+		return err
+	}
+
+	return self.withRenderAndFocus(func() error {
+		state := self.context().GetState()
+		// Bail out if the editor was closed without changes, or if the conflict
+		// has changed underneath us while the editor was open
+		if string(resolution) == conflictText || state.CurrentConflictText() != conflictText {
+			return nil
+		}
+
+		return self.replaceConflict(string(resolution))
+	})()
+}
+
+func (self *MergeConflictsController) replaceConflict(replacement string) error {
+	self.context().SetUserScrolling(false)
+
+	state := self.context().GetState()
+	ok, content := state.ContentAfterReplacingConflict(replacement)
+	if !ok {
+		return nil
+	}
+
+	self.c.LogAction("Resolve merge conflict")
+	self.c.LogCommand("Replacing conflict with edited text", false)
+	state.PushContent(content)
+	if err := os.WriteFile(state.GetPath(), []byte(content), 0o644); err != nil {
+		return err
+	}
+
+	if state.AllConflictsResolved() {
+		self.onLastConflictResolved()
+	}
+
+	return nil
+}
+
 func (self *MergeConflictsController) pickSelection(selection mergeconflicts.Selection) error {
 	ok, err := self.resolveConflict(selection)
 	if err != nil {
@@ -308,6 +408,8 @@ func (self *MergeConflictsController) resolveConflict(selection mergeconflicts.S
 		logStr = "Picking bottom hunk"
 	case mergeconflicts.ALL:
 		logStr = "Picking all hunks"
+	case mergeconflicts.BOTH:
+		logStr = "Picking ours and theirs hunks"
 	}
 	self.c.LogAction("Resolve merge conflict")
 	self.c.LogCommand(logStr, false)
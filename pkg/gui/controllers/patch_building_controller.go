@@ -2,6 +2,7 @@ package controllers
 
 import (
 	"github.com/jesseduffield/gocui"
+	"github.com/jesseduffield/lazygit/pkg/commands/models"
 	"github.com/jesseduffield/lazygit/pkg/gui/types"
 	"github.com/samber/lo"
 )
@@ -42,6 +43,12 @@ func (self *PatchBuildingController) GetKeybindings(opts types.KeybindingsOpts)
 			Description:     self.c.Tr.ToggleSelectionForPatch,
 			DisplayOnScreen: true,
 		},
+		{
+			Key:         opts.GetKey(opts.Config.Main.ViewLineHistory),
+			Handler:     self.ViewLineHistory,
+			Description: self.c.Tr.ViewLineHistory,
+			Tooltip:     self.c.Tr.ViewLineHistoryTooltip,
+		},
 		{
 			Key:             opts.GetKey(opts.Config.Universal.Return),
 			Handler:         self.Escape,
@@ -168,6 +175,27 @@ func (self *PatchBuildingController) toggleSelection() error {
 	return nil
 }
 
+func (self *PatchBuildingController) ViewLineHistory() error {
+	self.context().GetMutex().Lock()
+	commitFilesContext := self.c.Contexts().CommitFiles
+	path := commitFilesContext.GetSelectedPath()
+	state := self.context().GetState()
+	if path == "" || state == nil {
+		self.context().GetMutex().Unlock()
+		return nil
+	}
+	// The new side of the patch is the file as it is in the commit
+	startLine, endLine := state.SelectedLineNumberRange(false)
+	self.context().GetMutex().Unlock()
+
+	var ref models.Ref = commitFilesContext.GetRef()
+	if refRange := commitFilesContext.GetRefRange(); refRange != nil {
+		ref = refRange.To
+	}
+
+	return self.c.Helpers().Mode.FilterByLineRange(path, startLine, endLine, ref.RefName())
+}
+
 func (self *PatchBuildingController) Escape() error {
 	context := self.c.Contexts().CustomPatchBuilder
 	state := context.GetState()
@@ -0,0 +1,97 @@
+package controllers
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/commands/git_commands"
+	"github.com/jesseduffield/lazygit/pkg/gui/context"
+	"github.com/jesseduffield/lazygit/pkg/gui/types"
+	"github.com/samber/lo"
+)
+
+// Lets you jump between the commit pairs of a range-diff that is shown in the
+// main view while in diffing mode
+type RangeDiffController struct {
+	baseController
+	c *ControllerCommon
+
+	context *context.MainContext
+}
+
+var _ types.IController = &RangeDiffController{}
+
+func NewRangeDiffController(
+	c *ControllerCommon,
+	context *context.MainContext,
+) *RangeDiffController {
+	return &RangeDiffController{
+		baseController: baseController{},
+		c:              c,
+		context:        context,
+	}
+}
+
+func (self *RangeDiffController) GetKeybindings(opts types.KeybindingsOpts) []*types.Binding {
+	return []*types.Binding{
+		{
+			Key:               opts.GetKey(opts.Config.Main.NextRangeDiffPair),
+			Handler:           func() error { return self.scrollToPair(1) },
+			GetDisabledReason: self.requireRangeDiff,
+			Description:       self.c.Tr.NextRangeDiffPair,
+			Tooltip:           self.c.Tr.NextRangeDiffPairTooltip,
+		},
+		{
+			Key:               opts.GetKey(opts.Config.Main.PrevRangeDiffPair),
+			Handler:           func() error { return self.scrollToPair(-1) },
+			GetDisabledReason: self.requireRangeDiff,
+			Description:       self.c.Tr.PrevRangeDiffPair,
+			Tooltip:           self.c.Tr.PrevRangeDiffPairTooltip,
+		},
+	}
+}
+
+func (self *RangeDiffController) Context() types.Context {
+	return self.context
+}
+
+func (self *RangeDiffController) requireRangeDiff() *types.DisabledReason {
+	if !self.c.Modes().Diffing.Active() || !self.c.Modes().Diffing.RangeDiff {
+		return &types.DisabledReason{Text: self.c.Tr.NotShowingRangeDiff}
+	}
+
+	return nil
+}
+
+func (self *RangeDiffController) scrollToPair(direction int) error {
+	manager := self.c.GetViewBufferManagerForView(self.context.GetView())
+	if manager == nil {
+		return nil
+	}
+
+	// The pair we want to go to might not have been read yet
+	manager.ReadToEnd(func() {
+		self.c.OnUIThread(func() error {
+			view := self.context.GetView()
+			originY := view.OriginY()
+			pairIndices := git_commands.RangeDiffPairHeaderLineIndices(view.ViewBufferLines())
+
+			var target int
+			var found bool
+			if direction > 0 {
+				target, found = lo.Find(pairIndices, func(idx int) bool { return idx > originY })
+			} else {
+				target, _, found = lo.FindLastIndexOf(pairIndices, func(idx int) bool { return idx < originY })
+			}
+			if !found {
+				return nil
+			}
+
+			if target > originY {
+				view.ScrollDown(target - originY)
+			} else {
+				view.ScrollUp(originY - target)
+			}
+			return nil
+		})
+	})
+
+	return nil
+}
@@ -93,6 +93,7 @@ func (self *RemoteBranchesController) GetKeybindings(opts types.KeybindingsOpts)
 			Description:       self.c.Tr.ViewResetOptions,
 			Tooltip:           self.c.Tr.ResetTooltip,
 			OpensMenu:         true,
+			GetMenuItems:      self.withItemMenuItems(self.resetMenuItems),
 		},
 		{
 			Key: opts.GetKey(opts.Config.Universal.OpenDiffTool),
@@ -163,6 +164,10 @@ func (self *RemoteBranchesController) createResetMenu(selectedBranch *models.Rem
 	return self.c.Helpers().Refs.CreateGitResetMenu(selectedBranch.FullName(), selectedBranch.FullRefName())
 }
 
+func (self *RemoteBranchesController) resetMenuItems(selectedBranch *models.RemoteBranch) []*types.MenuItem {
+	return self.c.Helpers().Refs.GitResetMenuItems(selectedBranch.FullName(), selectedBranch.FullRefName())
+}
+
 func (self *RemoteBranchesController) setAsUpstream(selectedBranch *models.RemoteBranch) error {
 	checkedOutBranch := self.c.Helpers().Refs.GetCheckedOutRef()
 
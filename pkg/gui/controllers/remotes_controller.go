@@ -250,6 +250,11 @@ func (self *RemotesController) fetch(remote *models.Remote) error {
 			return err
 		}
 
+		if remote.Name == "origin" {
+			self.c.Helpers().PullRequests.Refresh()
+			self.c.Helpers().CheckStatus.OnFetched()
+		}
+
 		self.c.Refresh(types.RefreshOptions{
 			Scope: []types.RefreshableView{types.BRANCHES, types.REMOTES},
 			Mode:  types.ASYNC,
@@ -1,6 +1,7 @@
 package controllers
 
 import (
+	"errors"
 	"fmt"
 	"strings"
 
@@ -8,6 +9,7 @@ import (
 	"github.com/jesseduffield/lazygit/pkg/commands/git_commands"
 	"github.com/jesseduffield/lazygit/pkg/commands/patch"
 	"github.com/jesseduffield/lazygit/pkg/gui/keybindings"
+	"github.com/jesseduffield/lazygit/pkg/gui/modes/hunk_review"
 	"github.com/jesseduffield/lazygit/pkg/gui/types"
 )
 
@@ -87,6 +89,20 @@ func (self *StagingController) GetKeybindings(opts types.KeybindingsOpts) []*typ
 			Description: self.c.Tr.EditHunk,
 			Tooltip:     self.c.Tr.EditHunkTooltip,
 		},
+		{
+			Key:               opts.GetKey(opts.Config.Main.SkipHunk),
+			Handler:           self.SkipHunk,
+			GetDisabledReason: self.reviewingHunksDisabledReason,
+			Description:       self.c.Tr.SkipHunk,
+			Tooltip:           self.c.Tr.SkipHunkTooltip,
+		},
+		{
+			Key:               opts.GetKey(opts.Config.Main.SplitHunk),
+			Handler:           self.SplitHunk,
+			GetDisabledReason: self.reviewingHunksDisabledReason,
+			Description:       self.c.Tr.SplitHunk,
+			Tooltip:           self.c.Tr.SplitHunkTooltip,
+		},
 		{
 			Key:         opts.GetKey(opts.Config.Files.CommitChanges),
 			Handler:     self.c.Helpers().WorkingTree.HandleCommitPress,
@@ -109,6 +125,12 @@ func (self *StagingController) GetKeybindings(opts types.KeybindingsOpts) []*typ
 			Description: self.c.Tr.FindBaseCommitForFixup,
 			Tooltip:     self.c.Tr.FindBaseCommitForFixupTooltip,
 		},
+		{
+			Key:         opts.GetKey(opts.Config.Main.ViewLineHistory),
+			Handler:     self.ViewLineHistory,
+			Description: self.c.Tr.ViewLineHistory,
+			Tooltip:     self.c.Tr.ViewLineHistoryTooltip,
+		},
 	}
 }
 
@@ -169,7 +191,34 @@ func (self *StagingController) EditFile() error {
 	return self.c.Helpers().Files.EditFileAtLine(path, lineNumber)
 }
 
+func (self *StagingController) ViewLineHistory() error {
+	self.context.GetMutex().Lock()
+	path := self.FilePath()
+	state := self.context.GetState()
+	if path == "" || state == nil {
+		self.context.GetMutex().Unlock()
+		return nil
+	}
+	startLine, endLine := state.SelectedLineNumberRange(true)
+	self.context.GetMutex().Unlock()
+
+	// The old side of the staged diff is HEAD; the old side of the unstaged
+	// diff is the index
+	ref := ""
+	if self.staged {
+		ref = "HEAD"
+	}
+
+	return self.c.Helpers().Mode.FilterByLineRange(path, startLine, endLine, ref)
+}
+
 func (self *StagingController) Escape() error {
+	if self.reviewingHunks() {
+		self.c.Modes().HunkReview.Reset()
+		self.c.Context().Pop()
+		return nil
+	}
+
 	if self.context.GetState().SelectingRange() || self.context.GetState().SelectingHunkEnabledByUser() {
 		self.context.GetState().SetLineSelectMode()
 		self.c.PostRefreshUpdate(self.context)
@@ -181,6 +230,10 @@ func (self *StagingController) Escape() error {
 }
 
 func (self *StagingController) EscapeDescription() string {
+	if self.reviewingHunks() {
+		return self.c.Tr.ExitHunkReview
+	}
+
 	if state := self.context.GetState(); state != nil {
 		if state.SelectingRange() {
 			return self.c.Tr.DismissRangeSelect
@@ -208,7 +261,73 @@ func (self *StagingController) ToggleStaged() error {
 			keybindings.Label(self.c.UserConfig().Keybinding.Universal.IncreaseContextInDiffView))
 	}
 
-	return self.applySelectionAndRefresh(self.staged)
+	if err := self.applySelection(self.staged); err != nil {
+		return err
+	}
+
+	if self.reviewingHunks() {
+		self.c.Modes().HunkReview.MarkStaged()
+	}
+
+	self.c.Refresh(types.RefreshOptions{Scope: []types.RefreshableView{types.FILES, types.STAGING}})
+	return nil
+}
+
+func (self *StagingController) SkipHunk() error {
+	unit, ok := self.currentReviewUnit()
+	if !ok {
+		return nil
+	}
+
+	self.c.Modes().HunkReview.MarkSkipped(unit)
+	self.c.Refresh(types.RefreshOptions{Scope: []types.RefreshableView{types.STAGING}})
+	return nil
+}
+
+func (self *StagingController) SplitHunk() error {
+	unit, ok := self.currentReviewUnit()
+	if !ok {
+		return nil
+	}
+
+	if !self.c.Modes().HunkReview.CanSplit(unit) {
+		return errors.New(self.c.Tr.CannotSplitHunk)
+	}
+
+	self.c.Modes().HunkReview.Split(unit)
+	self.c.Refresh(types.RefreshOptions{Scope: []types.RefreshableView{types.STAGING}})
+	return nil
+}
+
+// Only the unstaged changes are reviewed; the staged ones are left alone
+func (self *StagingController) reviewingHunks() bool {
+	return !self.staged && self.c.Modes().HunkReview.Active()
+}
+
+func (self *StagingController) reviewingHunksDisabledReason() *types.DisabledReason {
+	if !self.reviewingHunks() {
+		return &types.DisabledReason{Text: self.c.Tr.NotReviewingHunks}
+	}
+
+	return nil
+}
+
+// Returns the part of the diff that is being reviewed, or false if we're not
+// reviewing hunks
+func (self *StagingController) currentReviewUnit() (hunk_review.Unit, bool) {
+	if !self.reviewingHunks() {
+		return hunk_review.Unit{}, false
+	}
+
+	self.context.GetMutex().Lock()
+	defer self.context.GetMutex().Unlock()
+
+	state := self.context.GetState()
+	if state == nil {
+		return hunk_review.Unit{}, false
+	}
+
+	return self.c.Modes().HunkReview.CurrentUnit(state.GetDiff())
 }
 
 func (self *StagingController) DiscardSelection() error {
@@ -244,12 +363,11 @@ func (self *StagingController) applySelection(reverse bool) error {
 		return nil
 	}
 
-	firstLineIdx, lastLineIdx := state.SelectedPatchRange()
 	patchToApply := patch.
 		Parse(state.GetDiff()).
 		Transform(patch.TransformOpts{
 			Reverse:             reverse,
-			IncludedLineIndices: patch.ExpandRange(firstLineIdx, lastLineIdx),
+			IncludedLineIndices: state.LineIndicesOfAddedOrDeletedLinesInSelectedPatchRange(),
 			FileNameOverride:    path,
 		}).
 		FormatPlain()
@@ -281,10 +399,17 @@ func (self *StagingController) applySelection(reverse bool) error {
 }
 
 func (self *StagingController) EditHunkAndRefresh() error {
+	unit, reviewing := self.currentReviewUnit()
+
 	if err := self.editHunk(); err != nil {
 		return err
 	}
 
+	if reviewing {
+		// We don't want to visit what's left of the hunk again
+		self.c.Modes().HunkReview.MarkSkipped(unit)
+	}
+
 	self.c.Refresh(types.RefreshOptions{Scope: []types.RefreshableView{types.FILES, types.STAGING}})
 	return nil
 }
@@ -63,6 +63,13 @@ func (self *StatusController) GetKeybindings(opts types.KeybindingsOpts) []*type
 			Handler:     func() error { self.switchToOrRotateAllBranchesLogs(); return nil },
 			Description: self.c.Tr.AllBranchesLogGraph,
 		},
+		{
+			Key:         opts.GetKey(opts.Config.Status.SparseCheckoutOptions),
+			Handler:     self.c.Helpers().SparseCheckout.OpenMenu,
+			Description: self.c.Tr.SparseCheckoutOptions,
+			Tooltip:     self.c.Tr.SparseCheckoutOptionsTooltip,
+			OpensMenu:   true,
+		},
 	}
 
 	return bindings
@@ -216,17 +223,22 @@ func (self *StatusController) showDashboard() {
 		versionStr = fmt.Sprintf("v%d.%d.%d", version.Major, version.Minor, version.Patch)
 	}
 
-	dashboardString := strings.Join(
-		[]string{
-			lazygitTitle(),
-			fmt.Sprintf("Copyright %d Jesse Duffield", time.Now().Year()),
-			fmt.Sprintf("Keybindings: %s", fmt.Sprintf(constants.Links.Docs.Keybindings, versionStr)),
-			fmt.Sprintf("Config Options: %s", fmt.Sprintf(constants.Links.Docs.Config, versionStr)),
-			fmt.Sprintf("Tutorial: %s", constants.Links.Docs.Tutorial),
-			fmt.Sprintf("Raise an Issue: %s", constants.Links.Issues),
-			fmt.Sprintf("Release Notes: %s", constants.Links.Releases),
-			style.FgMagenta.Sprintf("Become a sponsor: %s", constants.Links.Donate), // caffeine ain't free
-		}, "\n\n") + "\n"
+	sections := []string{
+		lazygitTitle(),
+		fmt.Sprintf("Copyright %d Jesse Duffield", time.Now().Year()),
+		fmt.Sprintf("Keybindings: %s", fmt.Sprintf(constants.Links.Docs.Keybindings, versionStr)),
+		fmt.Sprintf("Config Options: %s", fmt.Sprintf(constants.Links.Docs.Config, versionStr)),
+		fmt.Sprintf("Tutorial: %s", constants.Links.Docs.Tutorial),
+		fmt.Sprintf("Raise an Issue: %s", constants.Links.Issues),
+		fmt.Sprintf("Release Notes: %s", constants.Links.Releases),
+		style.FgMagenta.Sprintf("Become a sponsor: %s", constants.Links.Donate), // caffeine ain't free
+	}
+
+	if self.c.Model().IsSparseCheckout {
+		sections = append(sections, self.sparseCheckoutSection())
+	}
+
+	dashboardString := strings.Join(sections, "\n\n") + "\n"
 
 	self.c.RenderToMainViews(types.RefreshMainOpts{
 		Pair: self.c.MainViewPairs().Normal,
@@ -237,6 +249,18 @@ func (self *StatusController) showDashboard() {
 	})
 }
 
+func (self *StatusController) sparseCheckoutSection() string {
+	dirs := self.c.Model().SparseCheckoutDirs
+	if len(dirs) == 0 {
+		return self.c.Tr.SparseCheckoutDirsHeader + "\n  " + self.c.Tr.SparseCheckoutOnlyRootFiles
+	}
+
+	lines := lo.Map(dirs, func(dir string, _ int) string {
+		return "  " + style.FgCyan.Sprint(dir)
+	})
+	return self.c.Tr.SparseCheckoutDirsHeader + "\n" + strings.Join(lines, "\n")
+}
+
 func (self *StatusController) handleCheckForUpdate() error {
 	return self.c.Helpers().Update.CheckForUpdateInForeground()
 }
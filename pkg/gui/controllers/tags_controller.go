@@ -78,6 +78,7 @@ func (self *TagsController) GetKeybindings(opts types.KeybindingsOpts) []*types.
 			Tooltip:           self.c.Tr.ResetTooltip,
 			DisplayOnScreen:   true,
 			OpensMenu:         true,
+			GetMenuItems:      self.withItemMenuItems(self.resetMenuItems),
 		},
 		{
 			Key: opts.GetKey(opts.Config.Universal.OpenDiffTool),
@@ -347,6 +348,10 @@ func (self *TagsController) createResetMenu(tag *models.Tag) error {
 	return self.c.Helpers().Refs.CreateGitResetMenu(tag.Name, tag.FullRefName())
 }
 
+func (self *TagsController) resetMenuItems(tag *models.Tag) []*types.MenuItem {
+	return self.c.Helpers().Refs.GitResetMenuItems(tag.Name, tag.FullRefName())
+}
+
 func (self *TagsController) create() error {
 	// leaving commit hash blank so that we're just creating the tag for the current commit
 	return self.c.Helpers().Tags.OpenCreateTagPrompt("", func() {
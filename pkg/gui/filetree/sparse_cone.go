@@ -0,0 +1,49 @@
+package filetree
+
+import (
+	"path"
+	"strings"
+
+	"github.com/samber/lo"
+)
+
+// In a cone mode sparse checkout, a directory is checked out recursively if it
+// is one of the cone directories or lies inside one of them. In addition, the
+// files directly inside the root and inside any parent of a cone directory are
+// checked out, but not their other subdirectories.
+
+// SparseConeDir returns the directory that decides whether the node is part of
+// the sparse checkout: the node itself for directories, and the containing
+// directory for files. The root directory is returned as an empty string.
+func (self *Node[T]) SparseConeDir() string {
+	if self.IsFile() {
+		return sparseConeDirOfPath(path.Dir(self.GetPath()))
+	}
+
+	return sparseConeDirOfPath(self.GetPath())
+}
+
+// IsInSparseCone returns true if the node is (at least partly) checked out by
+// a cone mode sparse checkout with the given cone directories.
+func (self *Node[T]) IsInSparseCone(coneDirs []string) bool {
+	dir := self.SparseConeDir()
+	if dir == "" {
+		return true
+	}
+
+	return lo.SomeBy(coneDirs, func(coneDir string) bool {
+		return dir == coneDir ||
+			// inside a cone directory
+			strings.HasPrefix(dir, coneDir+"/") ||
+			// a parent of a cone directory
+			strings.HasPrefix(coneDir, dir+"/")
+	})
+}
+
+func sparseConeDirOfPath(p string) string {
+	if p == "." {
+		return ""
+	}
+
+	return p
+}
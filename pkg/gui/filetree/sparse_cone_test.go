@@ -0,0 +1,75 @@
+package filetree
+
+import (
+	"testing"
+
+	"github.com/jesseduffield/lazygit/pkg/commands/models"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIsInSparseCone(t *testing.T) {
+	coneDirs := []string{"services/api", "libs"}
+
+	scenarios := []struct {
+		name            string
+		node            *Node[models.File]
+		expectedConeDir string
+		expectedInCone  bool
+	}{
+		{
+			name:            "file in the root directory",
+			node:            &Node[models.File]{File: &models.File{Path: "README.md"}, path: "README.md"},
+			expectedConeDir: "",
+			expectedInCone:  true,
+		},
+		{
+			name:            "root item",
+			node:            &Node[models.File]{path: "."},
+			expectedConeDir: "",
+			expectedInCone:  true,
+		},
+		{
+			name:            "cone directory",
+			node:            &Node[models.File]{path: "./libs"},
+			expectedConeDir: "libs",
+			expectedInCone:  true,
+		},
+		{
+			name:            "file nested inside a cone directory",
+			node:            &Node[models.File]{File: &models.File{Path: "libs/a/b.go"}, path: "libs/a/b.go"},
+			expectedConeDir: "libs/a",
+			expectedInCone:  true,
+		},
+		{
+			name:            "file directly inside a parent of a cone directory",
+			node:            &Node[models.File]{File: &models.File{Path: "services/go.mod"}, path: "services/go.mod"},
+			expectedConeDir: "services",
+			expectedInCone:  true,
+		},
+		{
+			name:            "sibling of a cone directory",
+			node:            &Node[models.File]{path: "services/web"},
+			expectedConeDir: "services/web",
+			expectedInCone:  false,
+		},
+		{
+			name:            "directory sharing a prefix with a cone directory",
+			node:            &Node[models.File]{path: "libs2"},
+			expectedConeDir: "libs2",
+			expectedInCone:  false,
+		},
+		{
+			name:            "file outside the cone",
+			node:            &Node[models.File]{File: &models.File{Path: "docs/index.md"}, path: "docs/index.md"},
+			expectedConeDir: "docs",
+			expectedInCone:  false,
+		},
+	}
+
+	for _, s := range scenarios {
+		t.Run(s.name, func(t *testing.T) {
+			assert.Equal(t, s.expectedConeDir, s.node.SparseConeDir())
+			assert.Equal(t, s.expectedInCone, s.node.IsInSparseCone(coneDirs))
+		})
+	}
+}
@@ -12,13 +12,16 @@ import (
 	"sort"
 	"strings"
 	"sync"
+	"sync/atomic"
 
+	"github.com/jesseduffield/generics/set"
 	"github.com/jesseduffield/gocui"
 	"github.com/jesseduffield/lazycore/pkg/boxlayout"
 	appTypes "github.com/jesseduffield/lazygit/pkg/app/types"
 	"github.com/jesseduffield/lazygit/pkg/commands"
 	"github.com/jesseduffield/lazygit/pkg/commands/git_commands"
 	"github.com/jesseduffield/lazygit/pkg/commands/git_config"
+	"github.com/jesseduffield/lazygit/pkg/commands/hosting_service"
 	"github.com/jesseduffield/lazygit/pkg/commands/models"
 	"github.com/jesseduffield/lazygit/pkg/commands/oscommands"
 	"github.com/jesseduffield/lazygit/pkg/common"
@@ -29,7 +32,9 @@ import (
 	"github.com/jesseduffield/lazygit/pkg/gui/modes/cherrypicking"
 	"github.com/jesseduffield/lazygit/pkg/gui/modes/diffing"
 	"github.com/jesseduffield/lazygit/pkg/gui/modes/filtering"
+	"github.com/jesseduffield/lazygit/pkg/gui/modes/hunk_review"
 	"github.com/jesseduffield/lazygit/pkg/gui/modes/marked_base_commit"
+	"github.com/jesseduffield/lazygit/pkg/gui/modes/split_commit"
 	"github.com/jesseduffield/lazygit/pkg/gui/popup"
 	"github.com/jesseduffield/lazygit/pkg/gui/presentation"
 	"github.com/jesseduffield/lazygit/pkg/gui/presentation/authors"
@@ -94,6 +99,10 @@ type Gui struct {
 
 	Mutexes types.Mutexes
 
+	// Built from the issueLinks config whenever it is loaded; read by the
+	// workers that render commit messages and branch names
+	issueLinkMatcher atomic.Pointer[hosting_service.IssueLinkMatcher]
+
 	// when you enter into a submodule we'll append the superproject's path to this array
 	// so that you can return to the superproject
 	RepoPathStack *utils.StringStack
@@ -226,6 +235,11 @@ type PrevLayout struct {
 	Information string
 	MainWidth   int
 	MainHeight  int
+
+	// the origin that the main and secondary views were last scrolled to
+	// together when showing a side-by-side diff
+	SideBySideOriginX int
+	SideBySideOriginY int
 }
 
 type GuiRepoState struct {
@@ -235,6 +249,9 @@ type GuiRepoState struct {
 	SplitMainPanel bool
 	LimitCommits   bool
 
+	// true if the main and secondary views show the two sides of a diff
+	MainPanelShowsSideBySideDiff bool
+
 	SearchState  *types.SearchState
 	StartupStage types.StartupStage // Allows us to not load everything at once
 
@@ -306,6 +323,10 @@ func (self *GuiRepoState) GetSplitMainPanel() bool {
 	return self.SplitMainPanel
 }
 
+func (self *GuiRepoState) GetMainPanelShowsSideBySideDiff() bool {
+	return self.MainPanelShowsSideBySideDiff
+}
+
 func (gui *Gui) onNewRepo(startArgs appTypes.StartArgs, contextKey types.ContextKey) error {
 	var err error
 	gui.git, err = commands.NewGitCommand(
@@ -468,6 +489,8 @@ func (gui *Gui) onUserConfigLoaded() error {
 		presentation.SetCustomBranches(userConfig.Gui.BranchColors, false)
 	}
 
+	gui.issueLinkMatcher.Store(hosting_service.NewIssueLinkMatcher(userConfig.IssueLinks))
+
 	return nil
 }
 
@@ -569,12 +592,15 @@ func (gui *Gui) resetState(startArgs appTypes.StartArgs) types.Context {
 			Authors:               map[string]*models.Author{},
 			MainBranches:          git_commands.NewMainBranches(gui.c.Common, gui.os.Cmd),
 			HashPool:              &utils.StringPool{},
+			NotedCommitHashes:     set.New[string](),
 		},
 		Modes: &types.Modes{
 			Filtering:        filtering.New(startArgs.FilterPath, ""),
 			CherryPicking:    cherrypicking.New(),
 			Diffing:          diffing.New(),
 			MarkedBaseCommit: marked_base_commit.New(),
+			HunkReview:       hunk_review.New(),
+			SplitCommit:      split_commit.New(),
 		},
 		ScreenMode: initialScreenMode,
 		// TODO: only use contexts from context manager
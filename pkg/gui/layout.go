@@ -178,6 +178,11 @@ func (gui *Gui) layout(g *gocui.Gui) error {
 		context.HandleRender()
 	}
 
+	gui.syncSideBySideDiffScrolling()
+
+	// Now that we know which commits and branches are visible
+	gui.helpers.CheckStatus.LoadVisible()
+
 	// here is a good place log some stuff
 	// if you run `lazygit --logs`
 	// this will let you see these branches as prettified json
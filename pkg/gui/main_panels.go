@@ -93,7 +93,7 @@ func (gui *Gui) patchBuildingMainContextPair() types.MainContextPair {
 func (gui *Gui) mergingMainContextPair() types.MainContextPair {
 	return types.NewMainContextPair(
 		gui.State.Contexts.MergeConflicts,
-		nil,
+		gui.State.Contexts.MergeConflictsSides,
 	)
 }
 
@@ -130,8 +130,31 @@ func (gui *Gui) refreshMainViews(opts types.RefreshMainOpts) {
 	gui.moveMainContextPairToTop(opts.Pair)
 
 	gui.splitMainPanel(opts.Secondary != nil)
+	gui.State.MainPanelShowsSideBySideDiff = opts.SideBySide && opts.Secondary != nil
 }
 
 func (gui *Gui) splitMainPanel(splitMainPanel bool) {
 	gui.State.SplitMainPanel = splitMainPanel
 }
+
+// When showing a side-by-side diff, the main and secondary views show the two
+// sides of the same diff with their lines aligned, so we keep them scrolled to
+// the same position: whichever of them was scrolled since the last layout
+// determines where the other one goes.
+func (gui *Gui) syncSideBySideDiffScrolling() {
+	if !gui.State.MainPanelShowsSideBySideDiff {
+		return
+	}
+
+	main, secondary := gui.Views.Main, gui.Views.Secondary
+	prevX, prevY := gui.PrevLayout.SideBySideOriginX, gui.PrevLayout.SideBySideOriginY
+
+	x, y := main.Origin()
+	if x == prevX && y == prevY {
+		x, y = secondary.Origin()
+	}
+
+	main.SetOrigin(x, y)
+	secondary.SetOrigin(x, y)
+	gui.PrevLayout.SideBySideOriginX, gui.PrevLayout.SideBySideOriginY = x, y
+}
@@ -29,6 +29,8 @@ const (
 	MIDDLE
 	BOTTOM
 	ALL
+	// ours followed by theirs, without the base
+	BOTH
 )
 
 func (s Selection) isIndexToKeep(conflict *mergeConflict, i int) bool {
@@ -56,7 +58,7 @@ func (s Selection) bounds(c *mergeConflict) (int, int) {
 		return c.ancestor, c.target
 	case BOTTOM:
 		return c.target, c.end
-	case ALL:
+	case ALL, BOTH:
 		return c.start, c.end
 	}
 
@@ -64,6 +66,10 @@ func (s Selection) bounds(c *mergeConflict) (int, int) {
 }
 
 func (s Selection) selected(c *mergeConflict, idx int) bool {
+	if s == BOTH {
+		return TOP.selected(c, idx) || BOTTOM.selected(c, idx)
+	}
+
 	start, end := s.bounds(c)
 	return start < idx && idx < end
 }
@@ -2,10 +2,12 @@ package mergeconflicts
 
 import (
 	"bytes"
+	"strings"
 
 	"github.com/jesseduffield/lazygit/pkg/gui/style"
 	"github.com/jesseduffield/lazygit/pkg/theme"
 	"github.com/jesseduffield/lazygit/pkg/utils"
+	"github.com/samber/lo"
 )
 
 func ColoredConflictFile(state *State) string {
@@ -32,3 +34,67 @@ func ColoredConflictFile(state *State) string {
 func shiftConflict(conflicts []*mergeConflict) (*mergeConflict, []*mergeConflict) {
 	return conflicts[0], conflicts[1:]
 }
+
+const conflictSidesSeparator = " │ "
+
+// Renders the sides of the selected conflict (ours, base if available, and
+// theirs) next to each other in columns that fill the given width. The side
+// that is currently selected has a highlighted header.
+func ColoredConflictSides(state *State, width int) string {
+	conflict := state.currentConflict()
+	if conflict == nil {
+		return ""
+	}
+
+	lines := utils.SplitLines(state.GetContent())
+	selections := availableSelections(conflict)
+	columnWidth := max(1, (width-utils.StringWidth(conflictSidesSeparator)*(len(selections)-1))/len(selections))
+
+	columns := lo.Map(selections, func(selection Selection, _ int) []string {
+		start, end := selection.bounds(conflict)
+		// the label of theirs is on the end marker rather than the one above it
+		labelLine := lo.Ternary(selection == BOTTOM, lines[end], lines[start])
+
+		headerStyle := theme.DefaultTextColor
+		if selection == state.Selection() {
+			headerStyle = style.FgGreen.SetBold()
+		}
+
+		column := []string{
+			utils.WithPadding(headerStyle.Sprint(utils.TruncateWithEllipsis(markerLabel(labelLine), columnWidth)), columnWidth, utils.AlignLeft),
+			strings.Repeat("─", columnWidth),
+		}
+		for _, line := range lines[start+1 : end] {
+			column = append(column, formatConflictSideCell(line, columnWidth))
+		}
+		return column
+	})
+
+	rowCount := lo.Max(lo.Map(columns, func(column []string, _ int) int { return len(column) }))
+
+	var outputBuffer bytes.Buffer
+	for row := range rowCount {
+		cells := lo.Map(columns, func(column []string, _ int) string {
+			if row < len(column) {
+				return column[row]
+			}
+			return strings.Repeat(" ", columnWidth)
+		})
+		outputBuffer.WriteString(strings.TrimRight(strings.Join(cells, conflictSidesSeparator), " ") + "\n")
+	}
+	return outputBuffer.String()
+}
+
+func formatConflictSideCell(text string, width int) string {
+	text = strings.ReplaceAll(text, "\t", "    ")
+	return utils.WithPadding(utils.TruncateWithEllipsis(text, width), width, utils.AlignLeft)
+}
+
+// Returns what follows a conflict marker, e.g. "HEAD" for "<<<<<<< HEAD"
+func markerLabel(line string) string {
+	line = strings.TrimPrefix(line, "++")
+	if index := strings.Index(line, " "); index != -1 {
+		return line[index+1:]
+	}
+	return ""
+}
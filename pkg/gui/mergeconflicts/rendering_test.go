@@ -0,0 +1,69 @@
+package mergeconflicts
+
+import (
+	"testing"
+
+	"github.com/jesseduffield/lazygit/pkg/utils"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestColoredConflictSides(t *testing.T) {
+	type scenario struct {
+		name     string
+		content  string
+		width    int
+		expected string
+	}
+
+	scenarios := []scenario{
+		{
+			name:     "no conflicts",
+			content:  "foo\nbar\n",
+			width:    30,
+			expected: "",
+		},
+		{
+			name: "without base",
+			content: `before
+<<<<<<< HEAD
+ours 1
+ours 2
+=======
+theirs
+>>>>>>> branch
+after
+`,
+			width: 23,
+			expected: `HEAD       │ branch
+────────── │ ──────────
+ours 1     │ theirs
+ours 2     │
+`,
+		},
+		{
+			name: "with base, truncating long lines",
+			content: `<<<<<<< HEAD
+a long line of ours
+||||||| base
+base
+=======
+theirs
+>>>>>>> branch
+`,
+			width: 24,
+			expected: `HEAD   │ base   │ branch
+────── │ ────── │ ──────
+a lon… │ base   │ theirs
+`,
+		},
+	}
+
+	for _, s := range scenarios {
+		t.Run(s.name, func(t *testing.T) {
+			state := NewState()
+			state.SetContent(s.content, "file")
+
+			assert.Equal(t, s.expected, utils.Decolorise(ColoredConflictSides(state, s.width)))
+		})
+	}
+}
@@ -188,6 +188,33 @@ func (s *State) ContentAfterConflictResolve(selection Selection) (bool, string,
 	return true, content, nil
 }
 
+// Returns the lines of the selected conflict, including its markers
+func (s *State) CurrentConflictText() string {
+	conflict := s.currentConflict()
+	if conflict == nil {
+		return ""
+	}
+
+	lines := strings.SplitAfter(s.GetContent(), "\n")
+	return strings.Join(lines[conflict.start:conflict.end+1], "")
+}
+
+// Returns the content of the file with the selected conflict, including its
+// markers, replaced by the given text
+func (s *State) ContentAfterReplacingConflict(replacement string) (bool, string) {
+	conflict := s.currentConflict()
+	if conflict == nil {
+		return false, ""
+	}
+
+	if replacement != "" && !strings.HasSuffix(replacement, "\n") {
+		replacement += "\n"
+	}
+
+	lines := strings.SplitAfter(s.GetContent(), "\n")
+	return true, strings.Join(lines[:conflict.start], "") + replacement + strings.Join(lines[conflict.end+1:], "")
+}
+
 func (s *State) GetSelectedLine() int {
 	conflict := s.currentConflict()
 	if conflict == nil {
@@ -120,3 +120,72 @@ baz
 		})
 	}
 }
+
+func TestReplaceConflict(t *testing.T) {
+	content := `before
+<<<<<<< HEAD
+foo
+||||||| base
+bar
+=======
+baz
+>>>>>>> branch
+after
+`
+
+	type scenario struct {
+		name        string
+		replacement string
+		expected    string
+	}
+
+	scenarios := []scenario{
+		{
+			name:        "replacement with trailing newline",
+			replacement: "foo\nbaz\n",
+			expected:    "before\nfoo\nbaz\nafter\n",
+		},
+		{
+			name:        "replacement without trailing newline",
+			replacement: "resolved",
+			expected:    "before\nresolved\nafter\n",
+		},
+		{
+			name:        "empty replacement",
+			replacement: "",
+			expected:    "before\nafter\n",
+		},
+	}
+
+	for _, s := range scenarios {
+		t.Run(s.name, func(t *testing.T) {
+			state := NewState()
+			state.SetContent(content, "file")
+
+			assert.Equal(t, "<<<<<<< HEAD\nfoo\n||||||| base\nbar\n=======\nbaz\n>>>>>>> branch\n", state.CurrentConflictText())
+
+			ok, result := state.ContentAfterReplacingConflict(s.replacement)
+			assert.True(t, ok)
+			assert.Equal(t, s.expected, result)
+		})
+	}
+}
+
+func TestSelectionIsIndexToKeep(t *testing.T) {
+	conflictWithAncestor := &mergeConflict{start: 1, ancestor: 3, target: 5, end: 7}
+	conflictWithoutAncestor := &mergeConflict{start: 1, ancestor: -1, target: 3, end: 5}
+
+	keptIndices := func(selection Selection, conflict *mergeConflict, lineCount int) []int {
+		result := []int{}
+		for i := range lineCount {
+			if selection.isIndexToKeep(conflict, i) {
+				result = append(result, i)
+			}
+		}
+		return result
+	}
+
+	assert.Equal(t, []int{0, 2, 4, 6, 8}, keptIndices(ALL, conflictWithAncestor, 9))
+	assert.Equal(t, []int{0, 2, 6, 8}, keptIndices(BOTH, conflictWithAncestor, 9))
+	assert.Equal(t, []int{0, 2, 4, 6}, keptIndices(BOTH, conflictWithoutAncestor, 7))
+}
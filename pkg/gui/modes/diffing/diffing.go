@@ -4,6 +4,9 @@ package diffing
 type Diffing struct {
 	Ref     string
 	Reverse bool
+	// If true, we show a range-diff between the commits unique to Ref and the
+	// ones unique to the selected ref, instead of a diff of their trees
+	RangeDiff bool
 }
 
 func New() Diffing {
@@ -1,8 +1,15 @@
 package filtering
 
+import "fmt"
+
 type Filtering struct {
 	path               string // the filename that gets passed to git log
 	author             string // the author that gets passed to git log
+	pickaxe            string // the string that gets passed to git log -S
+	pickaxeRegex       string // the regex that gets passed to git log -G
+	message            string // the pattern that gets passed to git log --grep
+	startLine          int    // if non-zero, the first line of path that gets passed to git log -L
+	endLine            int    // the last line of path that gets passed to git log -L
 	selectedCommitHash string // the commit that was selected before we entered filtering mode
 }
 
@@ -11,12 +18,17 @@ func New(path string, author string) Filtering {
 }
 
 func (m *Filtering) Active() bool {
-	return m.path != "" || m.author != ""
+	return m.path != "" || m.author != "" || m.pickaxe != "" || m.pickaxeRegex != "" || m.message != ""
 }
 
 func (m *Filtering) Reset() {
 	m.path = ""
 	m.author = ""
+	m.pickaxe = ""
+	m.pickaxeRegex = ""
+	m.message = ""
+	m.startLine = 0
+	m.endLine = 0
 }
 
 func (m *Filtering) SetPath(path string) {
@@ -35,6 +47,54 @@ func (m *Filtering) GetAuthor() string {
 	return m.author
 }
 
+func (m *Filtering) SetPickaxe(pickaxe string) {
+	m.pickaxe = pickaxe
+}
+
+func (m *Filtering) GetPickaxe() string {
+	return m.pickaxe
+}
+
+func (m *Filtering) SetPickaxeRegex(pickaxeRegex string) {
+	m.pickaxeRegex = pickaxeRegex
+}
+
+func (m *Filtering) GetPickaxeRegex() string {
+	return m.pickaxeRegex
+}
+
+func (m *Filtering) SetMessage(message string) {
+	m.message = message
+}
+
+func (m *Filtering) GetMessage() string {
+	return m.message
+}
+
+// Filters by a range of lines (one-based, inclusive) of the file as it is in
+// HEAD. Since git log -L can't be combined with a pathspec, this takes
+// precedence over the path when loading commits, but everything else that is
+// filtered by path (e.g. the diffs in the main view) still is.
+func (m *Filtering) SetLineRange(path string, startLine int, endLine int) {
+	m.path = path
+	m.startLine = startLine
+	m.endLine = endLine
+}
+
+func (m *Filtering) GetLineRange() (int, int) {
+	return m.startLine, m.endLine
+}
+
+// Returns the line range in the "<start>,<end>:<file>" format of git log -L,
+// or an empty string if we're not filtering by line range
+func (m *Filtering) GetLineRangeArg() string {
+	if m.startLine == 0 {
+		return ""
+	}
+
+	return fmt.Sprintf("%d,%d:%s", m.startLine, m.endLine, m.path)
+}
+
 func (m *Filtering) SetSelectedCommitHash(hash string) {
 	m.selectedCommitHash = hash
 }
@@ -0,0 +1,244 @@
+package hunk_review
+
+import (
+	"maps"
+	"regexp"
+
+	"github.com/jesseduffield/lazygit/pkg/commands/patch"
+	"github.com/samber/lo"
+)
+
+// HunkReview is for going through the unstaged hunks of all files one at a
+// time (like `git add -p` does), deciding for each of them whether to stage it
+// or not.
+//
+// We don't remember hunks by their position in the diff, because that changes
+// whenever another hunk of the same file is staged. Instead, we remember the
+// changed lines of the hunks that were skipped or split, and recognise the
+// hunks by those.
+type HunkReview struct {
+	active bool
+	// if not nil, we only visit hunks that have a changed line matching it
+	filter *Filter
+	// the files that have hunks to review, in the order we visit them
+	paths   []string
+	fileIdx int
+	// number of hunks reviewed so far, and the number of hunks in total
+	reviewed int
+	total    int
+	// changed lines (e.g. "+foo") of the current file's hunks that were
+	// skipped, or split into their blocks of consecutive changes, with the
+	// number of times they occur
+	skippedLines map[string]int
+	splitLines   map[string]int
+}
+
+// Which of the changed lines a filter is matched against
+type FilterLines int
+
+const (
+	AddedOrRemovedLines FilterLines = iota
+	AddedLines
+	RemovedLines
+)
+
+type Filter struct {
+	// matched against the lines without their "+" or "-" prefix, so that
+	// patterns can be anchored at the start of the line
+	Regexp *regexp.Regexp
+	Lines  FilterLines
+}
+
+// A Unit is the part of a diff that is reviewed in one go; this is a hunk,
+// or one block of consecutive changes of a hunk that was split
+type Unit struct {
+	// patch line indices of the first and last changed line
+	First int
+	Last  int
+	// the changed lines, including their "+" or "-" prefix
+	lines []string
+	// the blocks of consecutive changes that the unit consists of; only set
+	// if the unit is a whole hunk
+	blocks []Unit
+}
+
+func New() HunkReview {
+	return HunkReview{}
+}
+
+func (self *HunkReview) Active() bool {
+	return self.active
+}
+
+func (self *HunkReview) Reset() {
+	*self = New()
+}
+
+// Starts reviewing the hunks of the given files, whose unstaged diffs are
+// given in the same order. Returns false (and stays inactive) if there is no
+// hunk to review.
+func (self *HunkReview) Start(paths []string, diffs []string, filter *Filter) bool {
+	*self = HunkReview{
+		filter:       filter,
+		skippedLines: map[string]int{},
+		splitLines:   map[string]int{},
+	}
+
+	for i, diff := range diffs {
+		if count := len(self.units(diff)); count > 0 {
+			self.paths = append(self.paths, paths[i])
+			self.total += count
+		}
+	}
+
+	self.active = self.total > 0
+	return self.active
+}
+
+// Returns the path of the file we're currently reviewing, or "" if we're done
+func (self *HunkReview) CurrentPath() string {
+	if self.fileIdx >= len(self.paths) {
+		return ""
+	}
+	return self.paths[self.fileIdx]
+}
+
+// Moves on to the next file and returns its path, or "" if there is none left
+func (self *HunkReview) NextFile() string {
+	self.fileIdx++
+	self.skippedLines = map[string]int{}
+	self.splitLines = map[string]int{}
+	return self.CurrentPath()
+}
+
+// Returns the unit to review next in the given diff of the current file, or
+// false if all of its hunks have been reviewed
+func (self *HunkReview) CurrentUnit(diff string) (Unit, bool) {
+	units := self.units(diff)
+	if len(units) == 0 {
+		return Unit{}, false
+	}
+	return units[0], true
+}
+
+func (self *HunkReview) MarkStaged() {
+	self.reviewed++
+}
+
+// Also used for units that were edited, so that we don't visit what's left of
+// them again
+func (self *HunkReview) MarkSkipped(unit Unit) {
+	addLines(self.skippedLines, unit.lines)
+	self.reviewed++
+}
+
+func (self *HunkReview) CanSplit(unit Unit) bool {
+	return len(unit.blocks) > 1
+}
+
+func (self *HunkReview) Split(unit Unit) {
+	addLines(self.splitLines, unit.lines)
+	self.total += lo.CountBy(unit.blocks, self.matchesFilter) - 1
+}
+
+// Returns the (1-based) number of the current hunk and the number of hunks in
+// total, and the same for files
+func (self *HunkReview) Progress() (int, int, int, int) {
+	// The total can be off if the files were changed in the meantime
+	return self.reviewed + 1, max(self.total, self.reviewed+1), self.fileIdx + 1, len(self.paths)
+}
+
+// Returns the units of the diff that still need to be reviewed, in order
+func (self *HunkReview) units(diff string) []Unit {
+	p := patch.Parse(diff)
+	lines := p.Lines()
+	skippedLines := maps.Clone(self.skippedLines)
+	splitLines := maps.Clone(self.splitLines)
+
+	result := []Unit{}
+	for hunkIdx := range p.HunkCount() {
+		blocks := blocksOfChanges(lines, p.HunkStartIdx(hunkIdx), p.HunkEndIdx(hunkIdx))
+		if len(blocks) == 0 {
+			continue
+		}
+
+		hunk := mergeBlocks(blocks)
+		candidates := []Unit{hunk}
+		if takeLines(splitLines, hunk.lines) {
+			candidates = blocks
+		}
+
+		for _, unit := range candidates {
+			if takeLines(skippedLines, unit.lines) || !self.matchesFilter(unit) {
+				continue
+			}
+			result = append(result, unit)
+		}
+	}
+
+	return result
+}
+
+func (self *HunkReview) matchesFilter(unit Unit) bool {
+	if self.filter == nil {
+		return true
+	}
+
+	for _, line := range unit.lines {
+		if self.filter.Lines == AddedLines && line[0] != '+' ||
+			self.filter.Lines == RemovedLines && line[0] != '-' {
+			continue
+		}
+		if self.filter.Regexp.MatchString(line[1:]) {
+			return true
+		}
+	}
+	return false
+}
+
+func blocksOfChanges(lines []*patch.PatchLine, start int, end int) []Unit {
+	blocks := []Unit{}
+	for i := start; i <= end; i++ {
+		if !lines[i].IsChange() {
+			continue
+		}
+		if i == start || !lines[i-1].IsChange() {
+			blocks = append(blocks, Unit{First: i})
+		}
+		block := &blocks[len(blocks)-1]
+		block.Last = i
+		block.lines = append(block.lines, lines[i].Content)
+	}
+	return blocks
+}
+
+func mergeBlocks(blocks []Unit) Unit {
+	result := Unit{First: blocks[0].First, Last: blocks[len(blocks)-1].Last, blocks: blocks}
+	for _, block := range blocks {
+		result.lines = append(result.lines, block.lines...)
+	}
+	return result
+}
+
+func addLines(counts map[string]int, lines []string) {
+	for _, line := range lines {
+		counts[line]++
+	}
+}
+
+// If all the given lines are in counts, removes them from it and returns true;
+// otherwise leaves counts alone and returns false
+func takeLines(counts map[string]int, lines []string) bool {
+	needed := map[string]int{}
+	addLines(needed, lines)
+	for line, count := range needed {
+		if counts[line] < count {
+			return false
+		}
+	}
+
+	for line, count := range needed {
+		counts[line] -= count
+	}
+	return true
+}
@@ -0,0 +1,196 @@
+package hunk_review
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+)
+
+const fileDiff = `diff --git a/file.go b/file.go
+index 1234567..89abcde 100644
+--- a/file.go
++++ b/file.go
+@@ -1,5 +1,5 @@
+ a
+-b
++B
+ c
+-d
++D
+@@ -10,3 +10,2 @@
+ x
+-print("debug")
+ y
+`
+
+// fileDiff after staging its first hunk
+const fileDiffWithoutFirstHunk = `diff --git a/file.go b/file.go
+index 1234567..89abcde 100644
+--- a/file.go
++++ b/file.go
+@@ -10,3 +10,2 @@
+ x
+-print("debug")
+ y
+`
+
+const otherFileDiff = `diff --git a/other.go b/other.go
+index 1234567..89abcde 100644
+--- a/other.go
++++ b/other.go
+@@ -1 +1 @@
+-foo
++print("foo")
+`
+
+func startReview(t *testing.T, filter *Filter) *HunkReview {
+	review := New()
+	assert.True(t, review.Start([]string{"file.go", "empty.go", "other.go"}, []string{fileDiff, "", otherFileDiff}, filter))
+	return &review
+}
+
+func assertCurrentUnit(t *testing.T, review *HunkReview, diff string, first int, last int) Unit {
+	unit, ok := review.CurrentUnit(diff)
+	assert.True(t, ok)
+	assert.Equal(t, first, unit.First)
+	assert.Equal(t, last, unit.Last)
+	return unit
+}
+
+func assertProgress(t *testing.T, review *HunkReview, hunk int, hunkCount int, file int, fileCount int) {
+	actualHunk, actualHunkCount, actualFile, actualFileCount := review.Progress()
+	assert.Equal(t, []int{hunk, hunkCount, file, fileCount}, []int{actualHunk, actualHunkCount, actualFile, actualFileCount})
+}
+
+func TestStartWithoutHunks(t *testing.T) {
+	review := New()
+	assert.False(t, review.Start([]string{"file.go"}, []string{fileDiff}, &Filter{Regexp: regexp.MustCompile("nothing")}))
+	assert.False(t, review.Active())
+}
+
+func TestStageAndSkip(t *testing.T) {
+	review := startReview(t, nil)
+	assert.True(t, review.Active())
+	assert.Equal(t, "file.go", review.CurrentPath())
+	assertProgress(t, review, 1, 3, 1, 2)
+
+	assertCurrentUnit(t, review, fileDiff, 6, 10)
+	review.MarkStaged()
+	assertProgress(t, review, 2, 3, 1, 2)
+
+	unit := assertCurrentUnit(t, review, fileDiffWithoutFirstHunk, 6, 6)
+	review.MarkSkipped(unit)
+	_, ok := review.CurrentUnit(fileDiffWithoutFirstHunk)
+	assert.False(t, ok)
+
+	assert.Equal(t, "other.go", review.NextFile())
+	assertProgress(t, review, 3, 3, 2, 2)
+	assertCurrentUnit(t, review, otherFileDiff, 5, 6)
+	review.MarkStaged()
+
+	assert.Equal(t, "", review.NextFile())
+}
+
+func TestSkippedHunksAreRecognisedByTheirContent(t *testing.T) {
+	review := startReview(t, nil)
+
+	unit := assertCurrentUnit(t, review, fileDiff, 6, 10)
+	review.MarkSkipped(unit)
+	assertCurrentUnit(t, review, fileDiff, 13, 13)
+
+	// The skipped hunk stays skipped when the one after it moves
+	unit = assertCurrentUnit(t, review, fileDiffWithoutFirstHunk, 6, 6)
+	review.MarkSkipped(unit)
+	_, ok := review.CurrentUnit(fileDiff)
+	assert.False(t, ok)
+}
+
+func TestSplit(t *testing.T) {
+	review := startReview(t, nil)
+
+	unit := assertCurrentUnit(t, review, fileDiff, 6, 10)
+	assert.True(t, review.CanSplit(unit))
+	review.Split(unit)
+	assertProgress(t, review, 1, 4, 1, 2)
+
+	unit = assertCurrentUnit(t, review, fileDiff, 6, 7)
+	assert.False(t, review.CanSplit(unit))
+	review.MarkSkipped(unit)
+	assertCurrentUnit(t, review, fileDiff, 9, 10)
+
+	// Other files' hunks aren't affected by the split
+	review.NextFile()
+	unit = assertCurrentUnit(t, review, otherFileDiff, 5, 6)
+	assert.False(t, review.CanSplit(unit))
+}
+
+func TestFilter(t *testing.T) {
+	review := startReview(t, &Filter{Regexp: regexp.MustCompile(`^print\(`)})
+	assertProgress(t, review, 1, 2, 1, 2)
+
+	assertCurrentUnit(t, review, fileDiff, 13, 13)
+	assert.Equal(t, "other.go", review.NextFile())
+	assertCurrentUnit(t, review, otherFileDiff, 5, 6)
+}
+
+func TestFilterByKindOfChange(t *testing.T) {
+	diff := `diff --git a/main.go b/main.go
+index 1234567..89abcde 100644
+--- a/main.go
++++ b/main.go
+@@ -1,4 +1,4 @@
+ func main() {
+-	fmt.Println("a")
++	log.Println("a")
+ }
+@@ -10,2 +10,3 @@
+ func other() {
++	fmt.Println("b")
+ }
+`
+
+	scenarios := []struct {
+		testName      string
+		lines         FilterLines
+		expectedFirst []int
+	}{
+		{
+			testName:      "added or removed lines",
+			lines:         AddedOrRemovedLines,
+			expectedFirst: []int{6, 11},
+		},
+		{
+			testName:      "added lines",
+			lines:         AddedLines,
+			expectedFirst: []int{11},
+		},
+		{
+			testName:      "removed lines",
+			lines:         RemovedLines,
+			expectedFirst: []int{6},
+		},
+	}
+
+	for _, s := range scenarios {
+		t.Run(s.testName, func(t *testing.T) {
+			// The change prefix isn't part of what's matched, so the pattern
+			// can be anchored
+			filter := &Filter{Regexp: regexp.MustCompile(`^\s*fmt\.Println`), Lines: s.lines}
+			review := New()
+			assert.True(t, review.Start([]string{"main.go"}, []string{diff}, filter))
+			assert.Equal(t, s.expectedFirst, lo.Map(review.units(diff), func(unit Unit, _ int) int { return unit.First }))
+		})
+	}
+
+	review := New()
+	assert.False(t, review.Start([]string{"main.go"}, []string{diff}, &Filter{Regexp: regexp.MustCompile(`^[-+]`)}))
+}
+
+func TestReset(t *testing.T) {
+	review := startReview(t, nil)
+	review.Reset()
+	assert.False(t, review.Active())
+	assert.Equal(t, "", review.CurrentPath())
+}
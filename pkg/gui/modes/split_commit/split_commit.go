@@ -0,0 +1,49 @@
+package split_commit
+
+import (
+	"slices"
+
+	"github.com/jesseduffield/lazygit/pkg/commands/models"
+	"github.com/samber/lo"
+)
+
+// While a commit is being split, the rebase is stopped at it and it has been
+// reset, so that its changes can be committed again in several parts. Once
+// none of the files it touched have changes left, the rebase is continued.
+type SplitCommit struct {
+	hash string // the hash of the commit being split; empty string when not splitting
+	// the paths of the files that the commit touched
+	paths []string
+}
+
+func New() SplitCommit {
+	return SplitCommit{}
+}
+
+func (m *SplitCommit) Active() bool {
+	return m.hash != ""
+}
+
+func (m *SplitCommit) Reset() {
+	*m = New()
+}
+
+func (m *SplitCommit) Start(hash string, paths []string) {
+	m.hash = hash
+	m.paths = paths
+}
+
+func (m *SplitCommit) GetHash() string {
+	return m.hash
+}
+
+// Returns true if any of the given files of the working tree is one that the
+// commit touched, i.e. some of its changes haven't been committed again yet.
+// Other files (e.g. untracked ones that were there before) don't count.
+func (m *SplitCommit) HasUncommittedChanges(files []*models.File) bool {
+	return lo.SomeBy(files, func(file *models.File) bool {
+		return lo.SomeBy(file.Names(), func(name string) bool {
+			return slices.Contains(m.paths, name)
+		})
+	})
+}
@@ -1,11 +1,13 @@
 package patch_exploring
 
 import (
+	"slices"
 	"strings"
 
 	"github.com/jesseduffield/generics/set"
 	"github.com/jesseduffield/gocui"
 	"github.com/jesseduffield/lazygit/pkg/commands/patch"
+	"github.com/jesseduffield/lazygit/pkg/gui/style"
 	"github.com/jesseduffield/lazygit/pkg/utils"
 	"github.com/samber/lo"
 )
@@ -34,6 +36,13 @@ type State struct {
 	// on by default.
 	// this makes a difference for whether we want to escape out of hunk mode
 	userEnabledHunkMode bool
+
+	// if true, the old and the new side of the patch are rendered next to each
+	// other, with deleted lines aligned to the added lines replacing them. A
+	// view line can then show two patch lines, so sideBySideRows tells which
+	// ones; lines are never wrapped in this case.
+	sideBySide     bool
+	sideBySideRows []patch.SideBySideRow
 }
 
 // these represent what select mode we're in
@@ -45,8 +54,8 @@ const (
 	HUNK
 )
 
-func NewState(diff string, selectedLineIdx int, view *gocui.View, oldState *State, useHunkModeByDefault bool) *State {
-	if oldState != nil && diff == oldState.diff && selectedLineIdx == -1 {
+func NewState(diff string, selectedLineIdx int, view *gocui.View, oldState *State, useHunkModeByDefault bool, sideBySide bool) *State {
+	if oldState != nil && diff == oldState.diff && sideBySide == oldState.sideBySide && selectedLineIdx == -1 {
 		// if we're here then we can return the old state. If selectedLineIdx was not -1
 		// then that would mean we were trying to click and potentially drag a range, which
 		// is why in that case we continue below
@@ -59,7 +68,7 @@ func NewState(diff string, selectedLineIdx int, view *gocui.View, oldState *Stat
 		return nil
 	}
 
-	viewLineIndices, patchLineIndices := wrapPatchLines(diff, view)
+	viewLineIndices, patchLineIndices, sideBySideRows := layoutPatchLines(patch, diff, view, sideBySide)
 
 	rangeStartLineIdx := 0
 	if oldState != nil {
@@ -104,11 +113,13 @@ func NewState(diff string, selectedLineIdx int, view *gocui.View, oldState *Stat
 		viewLineIndices:     viewLineIndices,
 		patchLineIndices:    patchLineIndices,
 		userEnabledHunkMode: userEnabledHunkMode,
+		sideBySide:          sideBySide,
+		sideBySideRows:      sideBySideRows,
 	}
 }
 
 func (s *State) OnViewWidthChanged(view *gocui.View) {
-	if !view.Wrap {
+	if !view.Wrap || s.sideBySide {
 		return
 	}
 
@@ -221,6 +232,14 @@ func (s *State) SelectNewLineForRange(newSelectedLineIdx int) {
 	s.selectLineWithoutRangeCheck(newSelectedLineIdx)
 }
 
+// Selects the given range of patch lines (inclusive), e.g. to show which part
+// of the patch is being reviewed
+func (s *State) SelectPatchRange(firstPatchLineIdx int, lastPatchLineIdx int) {
+	s.selectMode = RANGE
+	s.rangeIsSticky = false
+	s.rangeStartLineIdx, s.selectedLineIdx = s.viewRangeOfPatchRange(firstPatchLineIdx, lastPatchLineIdx)
+}
+
 func (s *State) DragSelectLine(newSelectedLineIdx int) {
 	s.selectMode = RANGE
 
@@ -323,7 +342,19 @@ func (s *State) selectionRangeForCurrentBlockOfChanges() (int, int) {
 		patchEnd++
 	}
 
-	viewStart, viewEnd := s.viewLineIndices[patchStart], s.viewLineIndices[patchEnd]
+	return s.viewRangeOfPatchRange(patchStart, patchEnd)
+}
+
+// Returns the first and last view line showing any of the given patch lines
+func (s *State) viewRangeOfPatchRange(firstPatchLineIdx int, lastPatchLineIdx int) (int, int) {
+	// Usually these are the view lines of the first and last patch line, but
+	// in the side-by-side view a block of deleted lines is shown next to the
+	// added lines, so the rows of the block aren't in the order of the patch
+	viewStart, viewEnd := s.viewLineIndices[firstPatchLineIdx], s.viewLineIndices[lastPatchLineIdx]
+	for _, viewLineIdx := range s.viewLineIndices[firstPatchLineIdx : lastPatchLineIdx+1] {
+		viewStart = min(viewStart, viewLineIdx)
+		viewEnd = max(viewEnd, viewLineIdx)
+	}
 
 	// Increase viewEnd in case the last patch line is wrapped to more than one view line.
 	for viewEnd < len(s.patchLineIndices)-1 && s.patchLineIndices[viewEnd] == s.patchLineIndices[viewEnd+1] {
@@ -333,6 +364,25 @@ func (s *State) selectionRangeForCurrentBlockOfChanges() (int, int) {
 	return viewStart, viewEnd
 }
 
+// Returns the indices of the patch lines shown in the given range of view
+// lines, in ascending order
+func (s *State) patchLineIndicesOfViewRange(viewStart int, viewEnd int) []int {
+	if s.sideBySideRows == nil {
+		return patch.ExpandRange(s.patchLineIndices[viewStart], s.patchLineIndices[viewEnd])
+	}
+
+	indices := []int{}
+	for _, row := range s.sideBySideRows[viewStart : viewEnd+1] {
+		first, last := row.PatchLineRange()
+		indices = append(indices, first)
+		if last != first {
+			indices = append(indices, last)
+		}
+	}
+	slices.Sort(indices)
+	return indices
+}
+
 func (s *State) SelectedViewRange() (int, int) {
 	switch s.selectMode {
 	case HUNK:
@@ -350,23 +400,34 @@ func (s *State) SelectedViewRange() (int, int) {
 	}
 }
 
+// Returns the first and last selected patch line. Note that in the
+// side-by-side view, not all patch lines in between need to be selected; use
+// LineIndicesOfAddedOrDeletedLinesInSelectedPatchRange to get the ones that are.
 func (s *State) SelectedPatchRange() (int, int) {
-	start, end := s.SelectedViewRange()
-	return s.patchLineIndices[start], s.patchLineIndices[end]
+	indices := s.patchLineIndicesOfViewRange(s.SelectedViewRange())
+	return indices[0], indices[len(indices)-1]
 }
 
-// Returns the line indices of the selected patch range that are changes (i.e. additions or deletions)
+// Returns the line indices of the selected patch lines that are changes (i.e. additions or deletions)
 func (s *State) LineIndicesOfAddedOrDeletedLinesInSelectedPatchRange() []int {
-	viewStart, viewEnd := s.SelectedViewRange()
-	patchStart, patchEnd := s.patchLineIndices[viewStart], s.patchLineIndices[viewEnd]
 	lines := s.patch.Lines()
-	indices := []int{}
-	for i := patchStart; i <= patchEnd; i++ {
-		if lines[i].IsChange() {
-			indices = append(indices, i)
-		}
+	return lo.Filter(s.patchLineIndicesOfViewRange(s.SelectedViewRange()), func(i int, _ int) bool {
+		return lines[i].IsChange()
+	})
+}
+
+// Returns the first and last line numbers (1-based) of the selection, either in
+// the old or in the new version of the file
+func (s *State) SelectedLineNumberRange(old bool) (int, int) {
+	start, end := s.SelectedPatchRange()
+	lineNumberOfLine := s.patch.LineNumberOfLine
+	if old {
+		lineNumberOfLine = s.patch.OldLineNumberOfLine
 	}
-	return indices
+	startLineNumber, endLineNumber := lineNumberOfLine(start), lineNumberOfLine(end)
+	// This can happen if the selection ends with a line that only exists on
+	// the other side
+	return startLineNumber, max(startLineNumber, endLineNumber)
 }
 
 func (s *State) CurrentLineNumber() int {
@@ -378,11 +439,30 @@ func (s *State) AdjustSelectedLineIdx(change int) {
 	s.SelectLine(s.selectedLineIdx + change)
 }
 
-func (s *State) RenderForLineIndices(includedLineIndices []int) string {
-	includedLineIndicesSet := set.NewFromSlice(includedLineIndices)
-	return s.patch.FormatView(patch.FormatViewOpts{
-		IncLineIndices: includedLineIndicesSet,
-	})
+// Renders the patch with the given options, with the lines with the given
+// indices marked as included. The width is the width of the view, which we
+// need to know for side-by-side rendering.
+func (s *State) RenderForLineIndices(includedLineIndices []int, opts patch.FormatViewOpts, width int, tabWidth int) string {
+	opts.IncLineIndices = set.NewFromSlice(includedLineIndices)
+	if !s.sideBySide {
+		return s.patch.FormatView(opts)
+	}
+
+	oldLines, newLines := s.patch.FormatViewSideBySide(opts, true)
+	oldWidth := max(0, (width-1)/2)
+	newWidth := max(0, width-oldWidth-1)
+	result := &strings.Builder{}
+	for i := range oldLines {
+		result.WriteString(utils.FitColoredStringToWidth(oldLines[i], oldWidth, tabWidth))
+		result.WriteString(style.FgBlack.SetBold().Sprint("│"))
+		result.WriteString(utils.FitColoredStringToWidth(newLines[i], newWidth, tabWidth))
+		result.WriteString("\n")
+	}
+	return result.String()
+}
+
+func (s *State) IsSideBySide() bool {
+	return s.sideBySide
 }
 
 func (s *State) PlainRenderSelected() string {
@@ -406,6 +486,26 @@ func (s *State) CalculateOrigin(currentOrigin int, bufferHeight int, numLines in
 	return calculateOrigin(currentOrigin, bufferHeight, numLines, firstLineIdx, lastLineIdx, s.GetSelectedViewLineIdx(), s.selectMode)
 }
 
+func layoutPatchLines(p *patch.Patch, diff string, view *gocui.View, sideBySide bool) ([]int, []int, []patch.SideBySideRow) {
+	if !sideBySide {
+		viewLineIndices, patchLineIndices := wrapPatchLines(diff, view)
+		return viewLineIndices, patchLineIndices, nil
+	}
+
+	rows := p.SideBySideRows(true)
+	viewLineIndices := make([]int, p.LineCount())
+	patchLineIndices := make([]int, len(rows))
+	for rowIdx, row := range rows {
+		patchLineIndices[rowIdx], _ = row.PatchLineRange()
+		for _, patchLineIdx := range []int{row.Old, row.New} {
+			if patchLineIdx != -1 {
+				viewLineIndices[patchLineIdx] = rowIdx
+			}
+		}
+	}
+	return viewLineIndices, patchLineIndices, rows
+}
+
 func wrapPatchLines(diff string, view *gocui.View) ([]int, []int) {
 	_, viewLineIndices, patchLineIndices := utils.WrapViewLinesToWidth(
 		view.Wrap, view.Editable, strings.TrimSuffix(diff, "\n"), view.InnerWidth(), view.TabWidth)
@@ -112,6 +112,7 @@ func (self *PopupHandler) Confirm(opts types.ConfirmOpts) {
 		Prompt:        opts.Prompt,
 		HandleConfirm: opts.HandleConfirm,
 		HandleClose:   opts.HandleClose,
+		HasLoader:     opts.HasLoader,
 	})
 }
 
@@ -0,0 +1,59 @@
+package presentation
+
+import (
+	"hash/fnv"
+	"strconv"
+
+	"github.com/jesseduffield/lazygit/pkg/commands/models"
+	"github.com/jesseduffield/lazygit/pkg/gui/presentation/authors"
+	"github.com/jesseduffield/lazygit/pkg/gui/style"
+	"github.com/jesseduffield/lazygit/pkg/i18n"
+	"github.com/jesseduffield/lazygit/pkg/theme"
+	"github.com/jesseduffield/lazygit/pkg/utils"
+	"github.com/samber/lo"
+)
+
+// Lines that were changed by the same commit get the same color, so that it's
+// easy to see which lines belong together
+var blameHashColors = []style.TextStyle{
+	style.FgBlue,
+	style.FgGreen,
+	style.FgCyan,
+	style.FgMagenta,
+	style.FgYellow,
+}
+
+func GetBlameLineListDisplayStrings(lines []*models.BlameLine, authorLength int, tr *i18n.TranslationSet) [][]string {
+	return lo.Map(lines, func(line *models.BlameLine, _ int) []string {
+		return getBlameLineDisplayStrings(line, authorLength, tr)
+	})
+}
+
+func getBlameLineDisplayStrings(line *models.BlameLine, authorLength int, tr *i18n.TranslationSet) []string {
+	lineNumber := style.FgBlackLighter.Sprint(strconv.Itoa(line.LineNumber))
+	content := theme.DefaultTextColor.Sprint(line.Content)
+
+	if !line.IsCommitted() {
+		return []string{
+			style.FgRed.Sprint(line.ShortHash()),
+			"",
+			style.FgRed.Sprint(utils.TruncateWithEllipsis(tr.NotCommittedYet, authorLength)),
+			lineNumber,
+			content,
+		}
+	}
+
+	return []string{
+		blameHashColor(line.Hash).Sprint(line.ShortHash()),
+		style.FgBlue.Sprint(utils.UnixToTimeAgo(line.UnixTimestamp)),
+		authors.AuthorWithLength(line.AuthorName, authorLength),
+		lineNumber,
+		content,
+	}
+}
+
+func blameHashColor(hash string) style.TextStyle {
+	h := fnv.New32a()
+	_, _ = h.Write([]byte(hash))
+	return blameHashColors[h.Sum32()%uint32(len(blameHashColors))]
+}
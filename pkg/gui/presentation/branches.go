@@ -7,6 +7,7 @@ import (
 	"time"
 
 	"github.com/jesseduffield/lazygit/pkg/commands/git_commands"
+	"github.com/jesseduffield/lazygit/pkg/commands/hosting_service"
 	"github.com/jesseduffield/lazygit/pkg/commands/models"
 	"github.com/jesseduffield/lazygit/pkg/config"
 	"github.com/jesseduffield/lazygit/pkg/gui/presentation/icons"
@@ -35,10 +36,24 @@ func GetBranchListDisplayStrings(
 	tr *i18n.TranslationSet,
 	userConfig *config.UserConfig,
 	worktrees []*models.Worktree,
+	pullRequests map[string]*models.PullRequest,
+	checkStatuses map[string]*models.CheckStatus,
+	issueLinkMatcher *hosting_service.IssueLinkMatcher,
 ) [][]string {
+	// The pull request column is as wide as the widest entry, so we need to know
+	// that width for deciding how much space is left for the branch names
+	pullRequestColumnWidth := 0
+	for _, pullRequest := range pullRequests {
+		pullRequestColumnWidth = max(pullRequestColumnWidth, utils.StringWidth(utils.Decolorise(pullRequestStr(pullRequest))))
+	}
+
+	showCheckStatusColumn := lo.SomeBy(branches, func(branch *models.Branch) bool {
+		return checkStatusStr(checkStatuses[branch.CommitHash]) != ""
+	})
+
 	return lo.Map(branches, func(branch *models.Branch, _ int) []string {
 		diffed := branch.Name == diffName
-		return getBranchDisplayStrings(branch, getItemOperation(branch), fullDescription, diffed, viewWidth, tr, userConfig, worktrees, time.Now())
+		return getBranchDisplayStrings(branch, getItemOperation(branch), fullDescription, diffed, viewWidth, tr, userConfig, worktrees, pullRequests[branch.Name], pullRequestColumnWidth, checkStatuses[branch.CommitHash], showCheckStatusColumn, issueLinkMatcher, time.Now())
 	})
 }
 
@@ -52,6 +67,11 @@ func getBranchDisplayStrings(
 	tr *i18n.TranslationSet,
 	userConfig *config.UserConfig,
 	worktrees []*models.Worktree,
+	pullRequest *models.PullRequest,
+	pullRequestColumnWidth int,
+	checkStatus *models.CheckStatus,
+	showCheckStatusColumn bool,
+	issueLinkMatcher *hosting_service.IssueLinkMatcher,
 	now time.Time,
 ) []string {
 	checkedOutByWorkTree := git_commands.CheckedOutByOtherWorktree(b, worktrees)
@@ -63,7 +83,7 @@ func getBranchDisplayStrings(
 	// Recency is always three characters, plus one for the space
 	availableWidth := viewWidth - 4
 	if len(divergence) > 0 {
-		availableWidth -= utils.StringWidth(divergence) + 1
+		availableWidth -= utils.StringWidth(utils.Decolorise(divergence)) + 1
 	}
 	if icons.IsIconEnabled() {
 		availableWidth -= 2 // one for the icon, one for the space
@@ -71,6 +91,12 @@ func getBranchDisplayStrings(
 	if showCommitHash {
 		availableWidth -= utils.COMMIT_HASH_SHORT_SIZE + 1
 	}
+	if showCheckStatusColumn {
+		availableWidth -= 2
+	}
+	if pullRequestColumnWidth > 0 {
+		availableWidth -= pullRequestColumnWidth + 1
+	}
 	paddingNeededForDivergence := availableWidth
 
 	if checkedOutByWorkTree {
@@ -97,7 +123,7 @@ func getBranchDisplayStrings(
 		len := max(availableWidth, 4)
 		displayName = runewidth.Truncate(displayName, len, "…")
 	}
-	coloredName := nameTextStyle.Sprint(displayName)
+	coloredName := highlightIssueLinks(issueLinkMatcher, displayName, nameTextStyle)
 	if checkedOutByWorkTree {
 		coloredName = fmt.Sprintf("%s %s", coloredName, style.FgDefault.Sprint(worktreeIcon))
 	}
@@ -121,11 +147,19 @@ func getBranchDisplayStrings(
 		res = append(res, utils.ShortHash(b.CommitHash))
 	}
 
+	if showCheckStatusColumn {
+		res = append(res, checkStatusStr(checkStatus))
+	}
+
+	if pullRequestColumnWidth > 0 {
+		res = append(res, pullRequestStr(pullRequest))
+	}
+
 	if divergence != "" {
 		paddingNeededForDivergence -= utils.StringWidth(utils.Decolorise(coloredName)) - 1
 		if paddingNeededForDivergence > 0 {
 			coloredName += strings.Repeat(" ", paddingNeededForDivergence)
-			coloredName += style.FgCyan.Sprint(divergence)
+			coloredName += divergence
 		}
 	}
 	res = append(res, coloredName)
@@ -202,25 +236,65 @@ func BranchStatus(
 	return result
 }
 
+// e.g. "#123✓" for an approved pull request, colored by its state
+func pullRequestStr(pullRequest *models.PullRequest) string {
+	if pullRequest == nil {
+		return ""
+	}
+
+	var textStyle style.TextStyle
+	switch pullRequest.State {
+	case models.PullRequestStateOpen:
+		textStyle = style.FgGreen
+	case models.PullRequestStateDraft:
+		textStyle = style.FgDefault
+	case models.PullRequestStateMerged:
+		textStyle = style.FgMagenta
+	case models.PullRequestStateClosed:
+		textStyle = style.FgRed
+	}
+
+	result := textStyle.Sprintf("#%d", pullRequest.Number)
+	switch pullRequest.ReviewStatus {
+	case models.PullRequestReviewStatusApproved:
+		result += style.FgGreen.Sprint("✓")
+	case models.PullRequestReviewStatusChangesRequested:
+		result += style.FgRed.Sprint("✗")
+	case models.PullRequestReviewStatusNone:
+	}
+	return result
+}
+
 func divergenceStr(
 	branch *models.Branch,
 	itemOperation types.ItemOperation,
 	tr *i18n.TranslationSet,
 	userConfig *config.UserConfig,
 ) string {
-	result := ""
-	if ItemOperationToString(itemOperation, tr) == "" && userConfig.Gui.ShowDivergenceFromBaseBranch != "none" {
+	if ItemOperationToString(itemOperation, tr) != "" {
+		return ""
+	}
+
+	parts := []string{}
+	if userConfig.Gui.ShowDivergenceFromBaseBranch != "none" {
 		behind := branch.BehindBaseBranch.Load()
 		if behind != 0 {
 			if userConfig.Gui.ShowDivergenceFromBaseBranch == "arrowAndNumber" {
-				result += fmt.Sprintf("↓%d", behind)
+				parts = append(parts, style.FgCyan.Sprintf("↓%d", behind))
 			} else {
-				result += "↓"
+				parts = append(parts, style.FgCyan.Sprint("↓"))
 			}
 		}
 	}
 
-	return result
+	if userConfig.Gui.ShowConflictsWithBaseBranch {
+		conflicts := branch.ConflictsWithBaseBranch.Load()
+		if conflicts != 0 {
+			parts = append(parts, style.FgRed.Sprintf("✗%d", conflicts))
+		}
+	}
+
+	return strings.Join(parts, " ")
 }
 
 func SetCustomBranches(customBranchColors map[string]string, isRegex bool) {
@@ -7,6 +7,7 @@ import (
 	"time"
 
 	"github.com/gookit/color"
+	"github.com/jesseduffield/lazygit/pkg/commands/hosting_service"
 	"github.com/jesseduffield/lazygit/pkg/commands/models"
 	"github.com/jesseduffield/lazygit/pkg/common"
 	"github.com/jesseduffield/lazygit/pkg/gui/presentation/icons"
@@ -31,6 +32,11 @@ func Test_getBranchDisplayStrings(t *testing.T) {
 		useIcons             bool
 		checkedOutByWorktree bool
 		showDivergenceCfg    string
+		showConflictsCfg     bool
+		pullRequest          *models.PullRequest
+		pullRequestColWidth  int
+		checkStatus          *models.CheckStatus
+		showCheckStatusCol   bool
 		expected             []string
 	}{
 		// First some tests for when the view is wide enough so that everything fits:
@@ -154,6 +160,37 @@ func Test_getBranchDisplayStrings(t *testing.T) {
 			showDivergenceCfg:    "arrowAndNumber",
 			expected:             []string{"1m", "branch_name ↓5↑3    ↓2"},
 		},
+		{
+			branch: &models.Branch{
+				Name:                    "branch_name",
+				Recency:                 "1m",
+				ConflictsWithBaseBranch: *makeAtomic(3),
+			},
+			itemOperation:        types.ItemOperationNone,
+			fullDescription:      false,
+			viewWidth:            20,
+			useIcons:             false,
+			checkedOutByWorktree: false,
+			showDivergenceCfg:    "none",
+			showConflictsCfg:     true,
+			expected:             []string{"1m", "branch_name   ✗3"},
+		},
+		{
+			branch: &models.Branch{
+				Name:                    "branch_name",
+				Recency:                 "1m",
+				BehindBaseBranch:        *makeAtomic(2),
+				ConflictsWithBaseBranch: *makeAtomic(3),
+			},
+			itemOperation:        types.ItemOperationNone,
+			fullDescription:      false,
+			viewWidth:            26,
+			useIcons:             false,
+			checkedOutByWorktree: false,
+			showDivergenceCfg:    "arrowAndNumber",
+			showConflictsCfg:     true,
+			expected:             []string{"1m", "branch_name      ↓2 ✗3"},
+		},
 		{
 			branch:               &models.Branch{Name: "branch_name", Recency: "1m"},
 			itemOperation:        types.ItemOperationPushing,
@@ -333,6 +370,80 @@ func Test_getBranchDisplayStrings(t *testing.T) {
 			showDivergenceCfg:    "none",
 			expected:             []string{"1m", "12345678", "bran… ✓", "origin branch_name", "commit title"},
 		},
+		{
+			branch:               &models.Branch{Name: "branch_name", Recency: "1m"},
+			itemOperation:        types.ItemOperationNone,
+			fullDescription:      false,
+			viewWidth:            100,
+			useIcons:             false,
+			checkedOutByWorktree: false,
+			showDivergenceCfg:    "none",
+			pullRequest:          &models.PullRequest{Number: 12, State: models.PullRequestStateOpen, ReviewStatus: models.PullRequestReviewStatusApproved},
+			pullRequestColWidth:  4,
+			expected:             []string{"1m", "#12✓", "branch_name"},
+		},
+		{
+			branch:               &models.Branch{Name: "branch_name", Recency: "1m"},
+			itemOperation:        types.ItemOperationNone,
+			fullDescription:      false,
+			viewWidth:            100,
+			useIcons:             false,
+			checkedOutByWorktree: false,
+			showDivergenceCfg:    "none",
+			pullRequest:          nil,
+			pullRequestColWidth:  4,
+			expected:             []string{"1m", "", "branch_name"},
+		},
+		{
+			branch:               &models.Branch{Name: "branch_name", Recency: "1m"},
+			itemOperation:        types.ItemOperationNone,
+			fullDescription:      false,
+			viewWidth:            14,
+			useIcons:             false,
+			checkedOutByWorktree: false,
+			showDivergenceCfg:    "none",
+			pullRequest:          &models.PullRequest{Number: 123, State: models.PullRequestStateMerged},
+			pullRequestColWidth:  4,
+			expected:             []string{"1m", "#123", "bran…"},
+		},
+		{
+			branch:               &models.Branch{Name: "branch_name", Recency: "1m"},
+			itemOperation:        types.ItemOperationNone,
+			fullDescription:      false,
+			viewWidth:            100,
+			useIcons:             false,
+			checkedOutByWorktree: false,
+			showDivergenceCfg:    "none",
+			pullRequest:          &models.PullRequest{Number: 12, State: models.PullRequestStateOpen},
+			pullRequestColWidth:  3,
+			checkStatus:          &models.CheckStatus{Checks: []*models.Check{{State: models.CheckStateFailure}}},
+			showCheckStatusCol:   true,
+			expected:             []string{"1m", "✗", "#12", "branch_name"},
+		},
+		{
+			branch:               &models.Branch{Name: "branch_name", Recency: "1m"},
+			itemOperation:        types.ItemOperationNone,
+			fullDescription:      false,
+			viewWidth:            100,
+			useIcons:             false,
+			checkedOutByWorktree: false,
+			showDivergenceCfg:    "none",
+			checkStatus:          nil,
+			showCheckStatusCol:   true,
+			expected:             []string{"1m", "", "branch_name"},
+		},
+		{
+			branch:               &models.Branch{Name: "branch_name", Recency: "1m"},
+			itemOperation:        types.ItemOperationNone,
+			fullDescription:      false,
+			viewWidth:            11,
+			useIcons:             false,
+			checkedOutByWorktree: false,
+			showDivergenceCfg:    "none",
+			checkStatus:          &models.CheckStatus{Checks: []*models.Check{{State: models.CheckStateSuccess}}},
+			showCheckStatusCol:   true,
+			expected:             []string{"1m", "✓", "bran…"},
+		},
 	}
 
 	oldColorLevel := color.ForceSetColorLevel(terminfo.ColorLevelNone)
@@ -344,6 +455,7 @@ func Test_getBranchDisplayStrings(t *testing.T) {
 	for i, s := range scenarios {
 		icons.SetNerdFontsVersion(lo.Ternary(s.useIcons, "3", ""))
 		c.UserConfig().Gui.ShowDivergenceFromBaseBranch = s.showDivergenceCfg
+		c.UserConfig().Gui.ShowConflictsWithBaseBranch = s.showConflictsCfg
 
 		worktrees := []*models.Worktree{}
 		if s.checkedOutByWorktree {
@@ -351,7 +463,7 @@ func Test_getBranchDisplayStrings(t *testing.T) {
 		}
 
 		t.Run(fmt.Sprintf("getBranchDisplayStrings_%d", i), func(t *testing.T) {
-			strings := getBranchDisplayStrings(s.branch, s.itemOperation, s.fullDescription, false, s.viewWidth, c.Tr, c.UserConfig(), worktrees, time.Time{})
+			strings := getBranchDisplayStrings(s.branch, s.itemOperation, s.fullDescription, false, s.viewWidth, c.Tr, c.UserConfig(), worktrees, s.pullRequest, s.pullRequestColWidth, s.checkStatus, s.showCheckStatusCol, hosting_service.NewIssueLinkMatcher(nil), time.Time{})
 			assert.Equal(t, s.expected, strings)
 		})
 	}
@@ -0,0 +1,28 @@
+package presentation
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/commands/models"
+	"github.com/jesseduffield/lazygit/pkg/gui/style"
+)
+
+// The marker that we show for the CI checks of a commit or branch, or for an
+// individual check
+func CheckStateStr(state models.CheckState) string {
+	switch state {
+	case models.CheckStateSuccess:
+		return style.FgGreen.Sprint("✓")
+	case models.CheckStateFailure:
+		return style.FgRed.Sprint("✗")
+	case models.CheckStatePending:
+		return style.FgYellow.Sprint("●")
+	case models.CheckStateNone:
+	}
+	return ""
+}
+
+func checkStatusStr(checkStatus *models.CheckStatus) string {
+	if checkStatus == nil {
+		return ""
+	}
+	return CheckStateStr(checkStatus.State())
+}
@@ -7,6 +7,7 @@ import (
 
 	"github.com/jesseduffield/generics/set"
 	"github.com/jesseduffield/lazygit/pkg/commands/git_commands"
+	"github.com/jesseduffield/lazygit/pkg/commands/hosting_service"
 	"github.com/jesseduffield/lazygit/pkg/commands/models"
 	"github.com/jesseduffield/lazygit/pkg/common"
 	"github.com/jesseduffield/lazygit/pkg/gui/presentation/authors"
@@ -45,6 +46,8 @@ func GetCommitListDisplayStrings(
 	hasRebaseUpdateRefsConfig bool,
 	fullDescription bool,
 	cherryPickedCommitHashSet *set.Set[string],
+	notedCommitHashSet *set.Set[string],
+	checkStatuses map[string]*models.CheckStatus,
 	diffName string,
 	markedBaseCommit string,
 	timeFormat string,
@@ -56,6 +59,7 @@ func GetCommitListDisplayStrings(
 	endIdx int,
 	showGraph bool,
 	bisectInfo *git_commands.BisectInfo,
+	issueLinkMatcher *hosting_service.IssueLinkMatcher,
 ) [][]string {
 	mutex.Lock()
 	defer mutex.Unlock()
@@ -193,6 +197,8 @@ func GetCommitListDisplayStrings(
 			branchHeadsToVisualize,
 			hasRebaseUpdateRefsConfig,
 			cherryPickedCommitHashSet,
+			notedCommitHashSet.Includes(commit.Hash()),
+			checkStatuses[commit.Hash()],
 			isMarkedBaseCommit,
 			willBeRebased,
 			diffName,
@@ -204,6 +210,7 @@ func GetCommitListDisplayStrings(
 			fullDescription,
 			bisectStatus,
 			bisectInfo,
+			issueLinkMatcher,
 		))
 	}
 	return lines
@@ -344,6 +351,8 @@ func displayCommit(
 	branchHeadsToVisualize *set.Set[string],
 	hasRebaseUpdateRefsConfig bool,
 	cherryPickedCommitHashSet *set.Set[string],
+	hasNote bool,
+	checkStatus *models.CheckStatus,
 	isMarkedBaseCommit bool,
 	willBeRebased bool,
 	diffName string,
@@ -355,6 +364,7 @@ func displayCommit(
 	fullDescription bool,
 	bisectStatus BisectStatus,
 	bisectInfo *git_commands.BisectInfo,
+	issueLinkMatcher *hosting_service.IssueLinkMatcher,
 ) []string {
 	bisectString := getBisectStatusText(bisectStatus, bisectInfo)
 
@@ -376,6 +386,13 @@ func displayCommit(
 		divergenceString = hashColor.Sprint(icons.IconForCommit(commit))
 	}
 
+	noteString := ""
+	if hasNote {
+		noteString = style.FgYellow.Sprint("✎")
+	}
+
+	checkStatusString := checkStatusStr(checkStatus)
+
 	descriptionString := ""
 	if fullDescription {
 		descriptionString = style.FgBlue.Sprint(
@@ -434,16 +451,18 @@ func displayCommit(
 	}
 	author := authors.AuthorWithLength(commit.AuthorName, authorLength)
 
-	cols := make([]string, 0, 7)
+	cols := make([]string, 0, 9)
 	cols = append(
 		cols,
 		divergenceString,
 		hashString,
+		noteString,
+		checkStatusString,
 		bisectString,
 		descriptionString,
 		actionString,
 		author,
-		graphLine+mark+tagString+theme.DefaultTextColor.Sprint(name),
+		graphLine+mark+tagString+highlightIssueLinks(issueLinkMatcher, name, theme.DefaultTextColor),
 	)
 
 	return cols
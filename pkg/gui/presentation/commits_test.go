@@ -9,6 +9,7 @@ import (
 	"github.com/gookit/color"
 	"github.com/jesseduffield/generics/set"
 	"github.com/jesseduffield/lazygit/pkg/commands/git_commands"
+	"github.com/jesseduffield/lazygit/pkg/commands/hosting_service"
 	"github.com/jesseduffield/lazygit/pkg/commands/models"
 	"github.com/jesseduffield/lazygit/pkg/common"
 	"github.com/jesseduffield/lazygit/pkg/utils"
@@ -31,6 +32,8 @@ func TestGetCommitListDisplayStrings(t *testing.T) {
 		hasUpdateRefConfig        bool
 		fullDescription           bool
 		cherryPickedCommitHashSet *set.Set[string]
+		notedCommitHashSet        *set.Set[string]
+		checkStatuses             map[string]*models.CheckStatus
 		markedBaseCommit          string
 		diffName                  string
 		timeFormat                string
@@ -73,6 +76,50 @@ func TestGetCommitListDisplayStrings(t *testing.T) {
 		hash2 commit2
 						`),
 		},
+		{
+			testName: "commits with notes",
+			commitOpts: []models.NewCommitOpts{
+				{Name: "commit1", Hash: "hash1"},
+				{Name: "commit2", Hash: "hash2"},
+			},
+			startIdx:                  0,
+			endIdx:                    2,
+			showGraph:                 false,
+			bisectInfo:                git_commands.NewNullBisectInfo(),
+			cherryPickedCommitHashSet: set.New[string](),
+			notedCommitHashSet:        set.NewFromSlice([]string{"hash2"}),
+			now:                       time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC),
+			expected: formatExpected(`
+		hash1   commit1
+		hash2 ✎ commit2
+						`),
+		},
+		{
+			testName: "commits with check status",
+			commitOpts: []models.NewCommitOpts{
+				{Name: "commit1", Hash: "hash1"},
+				{Name: "commit2", Hash: "hash2"},
+				{Name: "commit3", Hash: "hash3"},
+				{Name: "commit4", Hash: "hash4"},
+			},
+			startIdx:                  0,
+			endIdx:                    4,
+			showGraph:                 false,
+			bisectInfo:                git_commands.NewNullBisectInfo(),
+			cherryPickedCommitHashSet: set.New[string](),
+			checkStatuses: map[string]*models.CheckStatus{
+				"hash2": {Checks: []*models.Check{{State: models.CheckStateSuccess}, {State: models.CheckStatePending}}},
+				"hash3": {Checks: []*models.Check{{State: models.CheckStateSuccess}, {State: models.CheckStateFailure}}},
+				"hash4": {Checks: []*models.Check{{State: models.CheckStateSuccess}, {State: models.CheckStateNone}}},
+			},
+			now: time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC),
+			expected: formatExpected(`
+		hash1   commit1
+		hash2 ● commit2
+		hash3 ✗ commit3
+		hash4 ✓ commit4
+						`),
+		},
 		{
 			testName: "commit with tags",
 			commitOpts: []models.NewCommitOpts{
@@ -550,6 +597,11 @@ func TestGetCommitListDisplayStrings(t *testing.T) {
 				commits := lo.Map(s.commitOpts,
 					func(opts models.NewCommitOpts, _ int) *models.Commit { return models.NewCommit(hashPool, opts) })
 
+				notedCommitHashSet := s.notedCommitHashSet
+				if notedCommitHashSet == nil {
+					notedCommitHashSet = set.New[string]()
+				}
+
 				result := GetCommitListDisplayStrings(
 					common,
 					commits,
@@ -558,6 +610,8 @@ func TestGetCommitListDisplayStrings(t *testing.T) {
 					s.hasUpdateRefConfig,
 					s.fullDescription,
 					s.cherryPickedCommitHashSet,
+					notedCommitHashSet,
+					s.checkStatuses,
 					s.diffName,
 					s.markedBaseCommit,
 					s.timeFormat,
@@ -569,6 +623,7 @@ func TestGetCommitListDisplayStrings(t *testing.T) {
 					s.endIdx,
 					s.showGraph,
 					s.bisectInfo,
+					hosting_service.NewIssueLinkMatcher(nil),
 				)
 
 				renderedLines, _ := utils.RenderDisplayStrings(result, nil)
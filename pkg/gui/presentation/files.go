@@ -26,12 +26,15 @@ func RenderFileTree(
 	showNumstat bool,
 	customIconsConfig *config.CustomIconsConfig,
 	showRootItem bool,
+	isSparseCheckout bool,
+	sparseCheckoutDirs []string,
 ) []string {
 	collapsedPaths := tree.CollapsedPaths()
 	return renderAux(tree.GetRoot().Raw(), collapsedPaths, -1, -1, func(node *filetree.Node[models.File], treeDepth int, visualDepth int, isCollapsed bool) string {
 		fileNode := filetree.NewFileNode(node)
+		isOutsideSparseCone := isSparseCheckout && !node.IsInSparseCone(sparseCheckoutDirs)
 
-		return getFileLine(isCollapsed, fileNode.GetHasUnstagedChanges(), fileNode.GetHasStagedChanges(), treeDepth, visualDepth, showNumstat, showFileIcons, submoduleConfigs, node, customIconsConfig, showRootItem)
+		return getFileLine(isCollapsed, fileNode.GetHasUnstagedChanges(), fileNode.GetHasStagedChanges(), treeDepth, visualDepth, showNumstat, showFileIcons, submoduleConfigs, node, customIconsConfig, showRootItem, isOutsideSparseCone)
 	})
 }
 
@@ -121,6 +124,7 @@ func getFileLine(
 	node *filetree.Node[models.File],
 	customIconsConfig *config.CustomIconsConfig,
 	showRootItem bool,
+	isOutsideSparseCone bool,
 ) string {
 	name := fileNameAtDepth(node, treeDepth, showRootItem)
 	output := ""
@@ -172,6 +176,18 @@ func getFileLine(
 		output += theme.DefaultTextColor.Sprint(" (submodule)")
 	}
 
+	if file != nil && file.IsLfs {
+		output += theme.DefaultTextColor.Sprint(" (LFS)")
+	}
+
+	if file != nil && file.IsRerereResolved {
+		output += style.FgGreen.Sprint(" (resolved by rerere)")
+	}
+
+	if isOutsideSparseCone {
+		output += style.FgMagenta.Sprint(" (outside sparse checkout)")
+	}
+
 	if file != nil && showNumstat {
 		if lineChanges := formatLineChanges(file.LinesAdded, file.LinesDeleted); lineChanges != "" {
 			output += " " + lineChanges
@@ -27,6 +27,7 @@ func TestRenderFileTree(t *testing.T) {
 		collapsedPaths  []string
 		showLineChanges bool
 		showRootItem    bool
+		sparseDirs      []string
 		expected        []string
 	}{
 		{
@@ -109,6 +110,52 @@ M  file1
 			),
 			collapsedPaths: []string{"dir1"},
 		},
+		{
+			name: "LFS files",
+			files: []*models.File{
+				{Path: "image.psd", ShortStatus: " M", HasUnstagedChanges: true, IsLfs: true},
+				{Path: "notes.txt", ShortStatus: " M", HasUnstagedChanges: true},
+			},
+			showRootItem: false,
+			expected: []string{
+				" M image.psd (LFS)",
+				" M notes.txt",
+			},
+		},
+		{
+			name: "files resolved by rerere",
+			files: []*models.File{
+				{Path: "resolved.txt", ShortStatus: "UU", HasUnstagedChanges: true, HasMergeConflicts: true, HasInlineMergeConflicts: true, IsRerereResolved: true},
+				{Path: "unresolved.txt", ShortStatus: "UU", HasUnstagedChanges: true, HasMergeConflicts: true, HasInlineMergeConflicts: true},
+			},
+			showRootItem: false,
+			expected: []string{
+				"UU resolved.txt (resolved by rerere)",
+				"UU unresolved.txt",
+			},
+		},
+		{
+			name: "sparse checkout",
+			files: []*models.File{
+				{Path: "dir1/file2", ShortStatus: "M ", HasUnstagedChanges: true},
+				{Path: "dir2/dir3/file3", ShortStatus: "??", HasUnstagedChanges: true},
+				{Path: "dir2/file4", ShortStatus: "M ", HasUnstagedChanges: true},
+				{Path: "file1", ShortStatus: "M ", HasUnstagedChanges: true},
+			},
+			showRootItem: false,
+			sparseDirs:   []string{"dir1", "dir2/dir4"},
+			expected: toStringSlice(
+				`
+▼ dir1
+  M  file2
+▼ dir2
+  ▼ dir3 (outside sparse checkout)
+    ?? file3 (outside sparse checkout)
+  M  file4
+M  file1
+`,
+			),
+		},
 	}
 
 	oldColorLevel := color.ForceSetColorLevel(terminfo.ColorLevelNone)
@@ -123,7 +170,7 @@ M  file1
 			for _, path := range s.collapsedPaths {
 				viewModel.ToggleCollapsed(path)
 			}
-			result := RenderFileTree(viewModel, nil, false, s.showLineChanges, &config.CustomIconsConfig{}, s.showRootItem)
+			result := RenderFileTree(viewModel, nil, false, s.showLineChanges, &config.CustomIconsConfig{}, s.showRootItem, s.sparseDirs != nil, s.sparseDirs)
 			assert.EqualValues(t, s.expected, result)
 		})
 	}
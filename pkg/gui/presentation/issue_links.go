@@ -0,0 +1,56 @@
+package presentation
+
+import (
+	"bytes"
+	"strings"
+
+	"github.com/jesseduffield/lazygit/pkg/commands/hosting_service"
+	"github.com/jesseduffield/lazygit/pkg/gui/style"
+	"github.com/jesseduffield/lazygit/pkg/utils"
+)
+
+// Renders the text in the given style, with references to issues underlined
+func highlightIssueLinks(matcher *hosting_service.IssueLinkMatcher, text string, textStyle style.TextStyle) string {
+	linkStyle := textStyle.SetUnderline()
+	return matcher.Highlight(
+		text,
+		func(s string) string { return textStyle.Sprint(s) },
+		func(s string) string { return linkStyle.Sprint(s) },
+	)
+}
+
+// Returns a function that underlines references to issues in the output of a
+// command like 'git show', or nil if there are no issue link rules. Only the
+// lines before the diff are touched (that's where the commit message is), and
+// only those that git didn't color, so that we don't mess up its escape
+// sequences. Since it keeps track of whether the diff has started, it can only
+// be used for the output of a single command.
+func NewIssueLinkLineHighlighter(matcher *hosting_service.IssueLinkMatcher) func([]byte) []byte {
+	if matcher.IsEmpty() {
+		return nil
+	}
+
+	linkStyle := style.New().SetUnderline()
+	inDiff := false
+	return func(line []byte) []byte {
+		if inDiff {
+			return line
+		}
+
+		if bytes.IndexByte(line, '\x1b') >= 0 {
+			inDiff = strings.HasPrefix(utils.Decolorise(string(line)), "diff ")
+			return line
+		}
+
+		if bytes.HasPrefix(line, []byte("diff ")) {
+			inDiff = true
+			return line
+		}
+
+		return []byte(matcher.Highlight(
+			string(line),
+			func(s string) string { return s },
+			func(s string) string { return linkStyle.Sprint(s) },
+		))
+	}
+}
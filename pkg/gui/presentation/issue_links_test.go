@@ -0,0 +1,47 @@
+package presentation
+
+import (
+	"testing"
+
+	"github.com/gookit/color"
+	"github.com/jesseduffield/lazygit/pkg/commands/hosting_service"
+	"github.com/jesseduffield/lazygit/pkg/config"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+	"github.com/xo/terminfo"
+)
+
+func TestIssueLinkLineHighlighter(t *testing.T) {
+	oldColorLevel := color.ForceSetColorLevel(terminfo.ColorLevelBasic)
+	defer color.ForceSetColorLevel(oldColorLevel)
+
+	assert.Nil(t, NewIssueLinkLineHighlighter(hosting_service.NewIssueLinkMatcher(nil)))
+
+	matcher := hosting_service.NewIssueLinkMatcher([]config.IssueLinkConfig{
+		{Pattern: `\bPROJ-\d+\b`, URLTemplate: "https://jira.example.com/browse/{{.match}}"},
+	})
+
+	input := []string{
+		"\x1b[33mcommit 1234567 (PROJ-1)\x1b[m",
+		"Author: Jesse <jesse@example.com>",
+		"",
+		"    PROJ-1234: fix the thing",
+		"",
+		"\x1b[1mdiff --git a/PROJ-5.txt b/PROJ-5.txt\x1b[m",
+		"+PROJ-6",
+	}
+	expected := []string{
+		"\x1b[33mcommit 1234567 (PROJ-1)\x1b[m",
+		"Author: Jesse <jesse@example.com>",
+		"",
+		"    \x1b[4mPROJ-1234\x1b[0m: fix the thing",
+		"",
+		"\x1b[1mdiff --git a/PROJ-5.txt b/PROJ-5.txt\x1b[m",
+		"+PROJ-6",
+	}
+
+	highlight := NewIssueLinkLineHighlighter(matcher)
+	assert.Equal(t, expected, lo.Map(input, func(line string, _ int) string {
+		return string(highlight([]byte(line)))
+	}))
+}
@@ -96,7 +96,7 @@ func (gui *Gui) newPtyTask(view *gocui.View, cmd *exec.Cmd, prefix string) error
 		}
 
 		linesToRead := gui.linesToReadFromCmdTask(view)
-		return manager.NewTask(manager.NewCmdTask(start, prefix, linesToRead, onClose), cmdStr)
+		return manager.NewTask(manager.NewCmdTask(start, prefix, linesToRead, nil, onClose), cmdStr)
 	})
 
 	return nil
@@ -6,6 +6,8 @@ import (
 	"strings"
 
 	"github.com/jesseduffield/gocui"
+	"github.com/jesseduffield/lazygit/pkg/gui/presentation"
+	"github.com/jesseduffield/lazygit/pkg/syntax"
 	"github.com/jesseduffield/lazygit/pkg/tasks"
 )
 
@@ -43,13 +45,41 @@ func (gui *Gui) newCmdTask(view *gocui.View, cmd *exec.Cmd, prefix string) error
 	}
 
 	linesToRead := gui.linesToReadFromCmdTask(view)
-	if err := manager.NewTask(manager.NewCmdTask(start, prefix, linesToRead, onClose), cmdStr); err != nil {
+	if err := manager.NewTask(manager.NewCmdTask(start, prefix, linesToRead, gui.lineTransform(), onClose), cmdStr); err != nil {
 		gui.c.Log.Error(err)
 	}
 
 	return nil
 }
 
+// Returns the function to apply to each line of a command's output in the
+// main view, or nil if there's nothing to do.
+func (gui *Gui) lineTransform() func([]byte) []byte {
+	highlightIssueLinks := presentation.NewIssueLinkLineHighlighter(gui.c.IssueLinkMatcher())
+	highlightDiff := gui.diffLineTransform()
+	if highlightIssueLinks == nil {
+		return highlightDiff
+	}
+	if highlightDiff == nil {
+		return highlightIssueLinks
+	}
+	return func(line []byte) []byte {
+		return highlightDiff(highlightIssueLinks(line))
+	}
+}
+
+// Lines that aren't part of a diff are left alone, so this is fine to use for
+// any command.
+func (gui *Gui) diffLineTransform() func([]byte) []byte {
+	gitConfig := gui.c.UserConfig().Git
+	// word diffs and moved lines are colored by git, and we'd lose that
+	if !gitConfig.SyntaxHighlightingInDiffView || gitConfig.WordDiffInDiffView || gitConfig.ColorMovedInDiffView {
+		return nil
+	}
+
+	return syntax.NewDiffHighlighter().HighlightLine
+}
+
 func (gui *Gui) newStringTask(view *gocui.View, str string) error {
 	// using str so that if rendering the exact same thing we don't reset the origin
 	return gui.newStringTaskWithKey(view, str, str)
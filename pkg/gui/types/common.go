@@ -1,9 +1,11 @@
 package types
 
 import (
+	"github.com/jesseduffield/generics/set"
 	"github.com/jesseduffield/gocui"
 	"github.com/jesseduffield/lazygit/pkg/commands"
 	"github.com/jesseduffield/lazygit/pkg/commands/git_commands"
+	"github.com/jesseduffield/lazygit/pkg/commands/hosting_service"
 	"github.com/jesseduffield/lazygit/pkg/commands/models"
 	"github.com/jesseduffield/lazygit/pkg/commands/oscommands"
 	"github.com/jesseduffield/lazygit/pkg/common"
@@ -114,6 +116,10 @@ type IGuiCommon interface {
 
 	// Returns true if we're in a demo recording/playback
 	InDemo() bool
+
+	// Returns the matcher for the issueLinks config. It is rebuilt whenever
+	// the user config is reloaded, and is safe to use from any goroutine.
+	IssueLinkMatcher() *hosting_service.IssueLinkMatcher
 }
 
 type IModeMgr interface {
@@ -157,6 +163,7 @@ type CreateMenuOptions struct {
 	ColumnAlignment           []utils.Alignment
 	AllowFilteringKeybindings bool
 	KeepConfirmKeybindings    bool // if true, the keybindings that match the confirm binding will not be removed from menu items
+	AlwaysUseFuzzySearch      bool // if true, filtering the menu uses fuzzy search regardless of the gui.filterMode config
 }
 
 type CreatePopupPanelOpts struct {
@@ -182,6 +189,9 @@ type ConfirmOpts struct {
 	FindSuggestionsFunc func(string) []*Suggestion
 	Editable            bool
 	Mask                bool
+	// If true, the popup may be replaced by another one while it is showing,
+	// e.g. by a credentials prompt of the command whose progress it displays
+	HasLoader bool
 }
 
 type PromptOpts struct {
@@ -319,6 +329,23 @@ type Model struct {
 	Authors map[string]*models.Author
 
 	HashPool *utils.StringPool
+
+	// Hashes of the commits that have a note attached in the configured notes ref
+	NotedCommitHashes *set.Set[string]
+
+	// Whether the working tree is a cone mode sparse checkout, and if so, which
+	// directories are checked out (in addition to the files in the root)
+	IsSparseCheckout   bool
+	SparseCheckoutDirs []string
+
+	// Pull requests of the local branches, keyed by branch name. nil until
+	// loaded for the first time, and only loaded if enabled in the config.
+	PullRequests map[string]*models.PullRequest
+
+	// CI check status of commits, keyed by hash. Only contains the commits
+	// (and branch heads) that we have loaded it for so far; see
+	// CheckStatusHelper.
+	CheckStatuses map[string]*models.CheckStatus
 }
 
 type Mutexes struct {
@@ -384,6 +411,7 @@ type IRepoStateAccessor interface {
 	GetSearchState() *SearchState
 	SetSplitMainPanel(bool)
 	GetSplitMainPanel() bool
+	GetMainPanelShowsSideBySideDiff() bool
 }
 
 // startup stages so we don't need to load everything at once
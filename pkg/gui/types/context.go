@@ -305,6 +305,7 @@ type IContextMgr interface {
 	NextInStack(context Context) Context
 	IsCurrent(c Context) bool
 	IsCurrentOrParent(c Context) bool
+	WithPretendedCurrent(c Context, f func())
 	ForEach(func(Context))
 	AllList() []IListContext
 	AllFilterable() []IFilterableContext
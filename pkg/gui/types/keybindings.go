@@ -49,6 +49,12 @@ type Binding struct {
 	// invoke it. When left nil, the command is always enabled. Note that this
 	// function must not do expensive calls.
 	GetDisabledReason func() *DisabledReason
+
+	// For bindings that open a menu: returns the items of that menu, so that
+	// the command palette can offer them directly. Only called when the
+	// binding is enabled and the command palette is opened, so unlike
+	// GetDisabledReason it may run a quick git command.
+	GetMenuItems func() []*MenuItem
 }
 
 func (b *Binding) IsDisabled() bool {
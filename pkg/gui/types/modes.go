@@ -4,7 +4,9 @@ import (
 	"github.com/jesseduffield/lazygit/pkg/gui/modes/cherrypicking"
 	"github.com/jesseduffield/lazygit/pkg/gui/modes/diffing"
 	"github.com/jesseduffield/lazygit/pkg/gui/modes/filtering"
+	"github.com/jesseduffield/lazygit/pkg/gui/modes/hunk_review"
 	"github.com/jesseduffield/lazygit/pkg/gui/modes/marked_base_commit"
+	"github.com/jesseduffield/lazygit/pkg/gui/modes/split_commit"
 )
 
 type Modes struct {
@@ -12,4 +14,6 @@ type Modes struct {
 	CherryPicking    *cherrypicking.CherryPicking
 	Diffing          diffing.Diffing
 	MarkedBaseCommit marked_base_commit.MarkedBaseCommit
+	HunkReview       hunk_review.HunkReview
+	SplitCommit      split_commit.SplitCommit
 }
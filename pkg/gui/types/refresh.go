@@ -22,6 +22,9 @@ const (
 	COMMIT_FILES
 	// not actually a view. Will refactor this later
 	BISECT_INFO
+	// the directories of a sparse checkout; also not a view. They rarely
+	// change, so we don't reload them with the files
+	SPARSE_CHECKOUT
 )
 
 type RefreshMode int
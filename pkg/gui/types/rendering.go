@@ -31,6 +31,10 @@ type RefreshMainOpts struct {
 	Pair      MainContextPair
 	Main      *ViewUpdateOpts
 	Secondary *ViewUpdateOpts
+
+	// If true, Main and Secondary show the old and the new side of the same
+	// diff, so they are placed next to each other and scrolled together
+	SideBySide bool
 }
 
 type UpdateTask interface {
@@ -22,6 +22,8 @@ type Views struct {
 	PatchBuilding          *gocui.View
 	PatchBuildingSecondary *gocui.View
 	MergeConflicts         *gocui.View
+	MergeConflictsSides    *gocui.View
+	Blame                  *gocui.View
 
 	Options           *gocui.View
 	Confirmation      *gocui.View
@@ -46,6 +46,8 @@ func (gui *Gui) orderedViewNameMappings() []viewNameMapping {
 		{viewPtr: &gui.Views.PatchBuilding, name: "patchBuilding"},
 		{viewPtr: &gui.Views.PatchBuildingSecondary, name: "patchBuildingSecondary"},
 		{viewPtr: &gui.Views.MergeConflicts, name: "mergeConflicts"},
+		{viewPtr: &gui.Views.MergeConflictsSides, name: "mergeConflictsSides"},
+		{viewPtr: &gui.Views.Blame, name: "blame"},
 		{viewPtr: &gui.Views.Secondary, name: "secondary"},
 		{viewPtr: &gui.Views.Main, name: "main"},
 
@@ -112,6 +114,7 @@ func (gui *Gui) createAllViews() error {
 	gui.Views.PatchBuilding.Wrap = true
 	gui.Views.PatchBuildingSecondary.Wrap = true
 	gui.Views.MergeConflicts.Wrap = false
+	gui.Views.MergeConflictsSides.Wrap = false
 	gui.Views.Limit.Wrap = true
 
 	gui.Views.AppStatus.BgColor = gocui.ColorDefault
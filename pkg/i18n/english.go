@@ -23,6 +23,7 @@ type TranslationSet struct {
 	StagedChanges                         string
 	StagingTitle                          string
 	MergingTitle                          string
+	BlameTitle                            string
 	NormalTitle                           string
 	LogTitle                              string
 	LogXOfYTitle                          string
@@ -267,6 +268,10 @@ type TranslationSet struct {
 	RegularMergeFastForward               string
 	RegularMergeFastForwardTooltip        string
 	CannotFastForwardMerge                string
+	MergeWillBeClean                      string
+	OneFileWillConflict                   string
+	FilesWillConflict                     string
+	PredictingConflictsStatus             string
 	RegularMergeNonFastForward            string
 	RegularMergeNonFastForwardTooltip     string
 	SquashMergeUncommitted                string
@@ -277,6 +282,7 @@ type TranslationSet struct {
 	SwitchRepo                            string
 	AllBranchesLogGraph                   string
 	UnsupportedGitService                 string
+	UnsupportedHostingServiceAPI          string
 	CopyPullRequestURL                    string
 	NoBranchOnRemote                      string
 	Fetch                                 string
@@ -310,12 +316,21 @@ type TranslationSet struct {
 	AbortMenuItem                         string
 	PickHunk                              string
 	PickAllHunks                          string
+	PickBothHunksInOrder                  string
+	PickBothHunksInOrderTooltip           string
+	EditConflictResult                    string
+	EditConflictResultTooltip             string
+	ToggleConflictSplitView               string
+	ToggleConflictSplitViewTooltip        string
+	MergeConflictResultTitle              string
+	MergeConflictSidesTitle               string
 	ViewMergeRebaseOptions                string
 	ViewMergeRebaseOptionsTooltip         string
 	ViewMergeOptions                      string
 	ViewRebaseOptions                     string
 	ViewCherryPickOptions                 string
 	ViewRevertOptions                     string
+	ViewApplyPatchesOptions               string
 	NotMergingOrRebasing                  string
 	AlreadyRebasing                       string
 	RecentRepos                           string
@@ -323,6 +338,7 @@ type TranslationSet struct {
 	RebaseOptionsTitle                    string
 	CherryPickOptionsTitle                string
 	RevertOptionsTitle                    string
+	ApplyPatchesOptionsTitle              string
 	CommitSummaryTitle                    string
 	CommitDescriptionTitle                string
 	CommitDescriptionSubTitle             string
@@ -355,6 +371,22 @@ type TranslationSet struct {
 	FwdNoLocalUpstream                    string
 	FwdCommitsToPush                      string
 	PullRequestNoUpstream                 string
+	PullRequestTargetBranch               string
+	PullRequestTitle                      string
+	PullRequestBody                       string
+	PullRequestReadyForReview             string
+	PullRequestDraft                      string
+	ViewCheckStatus                       string
+	ViewCheckStatusTooltip                string
+	CIChecks                              string
+	NoCIChecks                            string
+	CheckStatusNotPushed                  string
+	CheckHasNoURL                         string
+	OpenIssueLink                         string
+	OpenIssueLinkTooltip                  string
+	IssueLinks                            string
+	NoIssueLinks                          string
+	NoIssueLinkRules                      string
 	ErrorOccurred                         string
 	ConflictLabel                         string
 	PendingRebaseTodosSectionHeader       string
@@ -398,6 +430,9 @@ type TranslationSet struct {
 	DropMergeCommitPrompt                 string
 	PullingStatus                         string
 	PushingStatus                         string
+	CreatingPullRequestStatus             string
+	PreparingPullRequestStatus            string
+	LoadingCheckStatusStatus              string
 	FetchingStatus                        string
 	SquashingStatus                       string
 	FixingStatus                          string
@@ -410,6 +445,7 @@ type TranslationSet struct {
 	LowercaseMergingStatus                string
 	LowercaseCherryPickingStatus          string
 	LowercaseRevertingStatus              string
+	LowercaseApplyingPatchesStatus        string
 	AmendingStatus                        string
 	CherryPickingStatus                   string
 	UndoingStatus                         string
@@ -418,6 +454,7 @@ type TranslationSet struct {
 	CommittingStatus                      string
 	RewordingStatus                       string
 	RevertingStatus                       string
+	ApplyingPatchesStatus                 string
 	CreatingFixupCommitStatus             string
 	MovingCommitsToNewBranchStatus        string
 	CommitFiles                           string
@@ -608,6 +645,9 @@ type TranslationSet struct {
 	NewGitFlowBranchPrompt                string
 	RenameBranchWarning                   string
 	OpenKeybindingsMenu                   string
+	OpenCommandPalette                    string
+	OpenCommandPaletteTooltip             string
+	CommandPalette                        string
 	ResetCherryPick                       string
 	ResetCherryPickShort                  string
 	NextTab                               string
@@ -720,6 +760,7 @@ type TranslationSet struct {
 	SuggestionsSubtitle                      string
 	ExtrasTitle                              string
 	PullRequestURLCopiedToClipboard          string
+	PullRequestCreated                       string
 	CommitDiffCopiedToClipboard              string
 	CommitURLCopiedToClipboard               string
 	CommitMessageCopiedToClipboard           string
@@ -756,6 +797,22 @@ type TranslationSet struct {
 	DecreaseRenameSimilarityThreshold        string
 	DecreaseRenameSimilarityThresholdTooltip string
 	RenameSimilarityThresholdChanged         string
+	DiffOptionsMenu                          string
+	DiffOptionsMenuTooltip                   string
+	DiffOptionsMenuTitle                     string
+	DefaultDiffAlgorithm                     string
+	DefaultDiffAlgorithmTooltip              string
+	MyersDiffAlgorithm                       string
+	MyersDiffAlgorithmTooltip                string
+	MinimalDiffAlgorithm                     string
+	MinimalDiffAlgorithmTooltip              string
+	PatienceDiffAlgorithm                    string
+	PatienceDiffAlgorithmTooltip             string
+	HistogramDiffAlgorithm                   string
+	HistogramDiffAlgorithmTooltip            string
+	DetectCopies                             string
+	DetectCopiesTooltip                      string
+	CantChangeDiffAlgorithmError             string
 	CreatePullRequestOptions                 string
 	DefaultBranch                            string
 	SelectBranch                             string
@@ -911,6 +968,175 @@ type TranslationSet struct {
 	UseCurrentChanges                        string
 	UseIncomingChanges                       string
 	UseBothChanges                           string
+	OpenBlame                                string
+	OpenBlameTooltip                         string
+	BlameDynamicTitle                        string
+	LoadingBlame                             string
+	NotCommittedYet                          string
+	BlameAtParent                            string
+	BlameAtParentTooltip                     string
+	BlameGoToCommit                          string
+	BlameGoToCommitTooltip                   string
+	BlameReturn                              string
+	BlameReturnTooltip                       string
+	BlameLineNotCommittedYet                 string
+	BlameLineHasNoParent                     string
+	CannotBlameDirectory                     string
+	CannotBlameUntrackedFile                 string
+	ViewLineHistory                          string
+	ViewLineHistoryTooltip                   string
+	LineHistoryFileNotInHead                 string
+	FilterPickaxeOption                      string
+	FilterPickaxeOptionTooltip               string
+	FilterPickaxeRegexOption                 string
+	FilterPickaxeRegexOptionTooltip          string
+	FilterMessageOption                      string
+	FilterMessageOptionTooltip               string
+	EnterPickaxeString                       string
+	EnterPickaxeRegex                        string
+	EnterMessagePattern                      string
+	FilteringByPickaxe                       string
+	FilteringByPickaxeRegex                  string
+	FilteringByMessage                       string
+	ViewNoteOptions                          string
+	ViewNoteOptionsTooltip                   string
+	NoteMenuTitle                            string
+	AddNote                                  string
+	EditNote                                 string
+	EditNoteTooltip                          string
+	RemoveNote                               string
+	RemoveNoteTooltip                        string
+	NoteDescriptionTitle                     string
+	CommitHasNoNote                          string
+	CannotAttachNoteToTodoCommit             string
+	RangeDiffTitle                           string
+	RangeDiffAgainst                         string
+	RangeDiffAgainstTooltip                  string
+	ShowRangeDiff                            string
+	ShowRegularDiff                          string
+	ShowWordDiff                             string
+	HideWordDiff                             string
+	WordDiffTooltip                          string
+	ShowMovedLines                           string
+	HideMovedLines                           string
+	MovedLinesTooltip                        string
+	ShowSideBySideDiff                       string
+	HideSideBySideDiff                       string
+	SideBySideDiffTooltip                    string
+	ShowSyntaxHighlighting                   string
+	HideSyntaxHighlighting                   string
+	SyntaxHighlightingTooltip                string
+	SideBySideOldTitle                       string
+	SideBySideNewTitle                       string
+	NextRangeDiffPair                        string
+	NextRangeDiffPairTooltip                 string
+	PrevRangeDiffPair                        string
+	PrevRangeDiffPairTooltip                 string
+	NotShowingRangeDiff                      string
+	SparseCheckoutOptions                    string
+	SparseCheckoutOptionsTooltip             string
+	SparseCheckoutTitle                      string
+	SparseCheckoutDirsHeader                 string
+	SparseCheckoutOnlyRootFiles              string
+	SparseCheckoutAddDir                     string
+	SparseCheckoutAddDirTooltip              string
+	SparseCheckoutRemoveDir                  string
+	SparseCheckoutRemoveDirTooltip           string
+	SparseCheckoutReapply                    string
+	SparseCheckoutReapplyTooltip             string
+	SparseCheckoutDisable                    string
+	SparseCheckoutDisableTooltip             string
+	SparseCheckoutEnterDir                   string
+	SparseCheckoutNotEnabled                 string
+	SparseCheckoutNoDirs                     string
+	ToggleSparseCheckoutDir                  string
+	ToggleSparseCheckoutDirTooltip           string
+	SparseCheckoutRootDirAlwaysIncluded      string
+	SparseCheckoutDirInsideConeDir           string
+	UpdatingSparseCheckout                   string
+	LfsFileTitle                             string
+	LfsFileSummary                           string
+	LfsCommitted                             string
+	LfsStaged                                string
+	LfsWorkingTree                           string
+	LfsNotPresent                            string
+	LfsLocks                                 string
+	LfsLocksHint                             string
+	LfsShowLocks                             string
+	LfsShowLocksTooltip                      string
+	ViewLfsLockOptions                       string
+	ViewLfsLockOptionsTooltip                string
+	LfsLockTitle                             string
+	LfsLock                                  string
+	LfsLockTooltip                           string
+	LfsUnlock                                string
+	LfsUnlockTooltip                         string
+	LfsForceUnlock                           string
+	LfsForceUnlockTooltip                    string
+	LfsForceUnlockPrompt                     string
+	NotAnLfsFile                             string
+	LockingLfsFile                           string
+	UnlockingLfsFile                         string
+	ForgetRerereResolution                   string
+	ForgetRerereResolutionTooltip            string
+	NoRerereResolvedFilesSelected            string
+	ReusedRerereResolutions                  string
+	ViewPatchFileOptions                     string
+	ViewPatchFileOptionsTooltip              string
+	PatchFilesTitle                          string
+	ExportPatches                            string
+	ExportPatchesWithCoverLetter             string
+	ExportPatchesTooltip                     string
+	ExportPatchesWithCoverLetterTooltip      string
+	ExportPatchesDirectoryPrompt             string
+	ExportingPatches                         string
+	ExportedPatches                          string
+	CannotExportTodoCommits                  string
+	ApplyMailbox                             string
+	ApplyMailboxTooltip                      string
+	ApplyMailboxPathPrompt                   string
+	CannotApplyPatchesMidMergeOrRebase       string
+	CloneRepository                          string
+	CloneRepositoryTooltip                   string
+	CloneRepositoryURLPrompt                 string
+	CloneRepositoryDirectoryPrompt           string
+	CloningRepository                        string
+	InitRepository                           string
+	InitRepositoryTooltip                    string
+	InitRepositoryDirectoryPrompt            string
+	StashSelectedPaths                       string
+	StashSelectedPathsTooltip                string
+	RestoreFileFromStash                     string
+	RestoreFileFromStashTooltip              string
+	RestoreFileFromStashPrompt               string
+	CanOnlyRestoreFromStash                  string
+	CannotRestoreDirectoryFromStash          string
+	ReviewHunks                              string
+	ReviewHunksTooltip                       string
+	ReviewHunksFilterPrompt                  string
+	ReviewAllHunks                           string
+	ReviewHunksWithAddedLine                 string
+	ReviewHunksWithRemovedLine               string
+	ReviewHunksWithChangedLine               string
+	NoHunksToReview                          string
+	ReviewingHunks                           string
+	ExitHunkReview                           string
+	HunkReviewProgress                       string
+	HunkReviewFinished                       string
+	SkipHunk                                 string
+	SkipHunkTooltip                          string
+	SplitHunk                                string
+	SplitHunkTooltip                         string
+	CannotSplitHunk                          string
+	NotReviewingHunks                        string
+	SplitCommit                              string
+	SplitCommitTooltip                       string
+	CannotSplitFirstCommit                   string
+	CannotSplitMergeCommit                   string
+	SplittingCommitStatus                    string
+	StopSplittingCommit                      string
+	SplitCommitHint                          string
+	FinishedSplittingCommit                  string
 }
 
 type Bisect struct {
@@ -929,6 +1155,11 @@ type Bisect struct {
 	CompletePrompt              string
 	CompletePromptIndeterminate string
 	Bisecting                   string
+	RunOption                   string
+	RunTooltip                  string
+	RunPrompt                   string
+	Running                     string
+	RunRequiresMarkedCommits    string
 }
 
 type Log struct {
@@ -1056,12 +1287,31 @@ type Actions struct {
 	CopyPullRequestURL               string
 	OpenMergeTool                    string
 	OpenCommitInBrowser              string
+	OpenIssueLink                    string
 	OpenPullRequest                  string
+	CreatePullRequest                string
 	StartBisect                      string
 	ResetBisect                      string
 	BisectSkip                       string
 	BisectMark                       string
+	BisectRun                        string
 	AddWorktree                      string
+	EditCommitNote                   string
+	RemoveCommitNote                 string
+	AddToSparseCheckout              string
+	RemoveFromSparseCheckout         string
+	ReapplySparseCheckout            string
+	DisableSparseCheckout            string
+	LockLfsFile                      string
+	UnlockLfsFile                    string
+	ForgetRerereResolution           string
+	ExportPatches                    string
+	ApplyMailbox                     string
+	CloneRepository                  string
+	InitRepository                   string
+	StashSelectedPaths               string
+	RestoreFileFromStash             string
+	SplitCommit                      string
 }
 
 const englishIntroPopupMessage = `
@@ -1113,6 +1363,7 @@ func EnglishTranslationSet() *TranslationSet {
 		StagedChanges:                        "Staged changes",
 		StagingTitle:                         "Main panel (staging)",
 		MergingTitle:                         "Main panel (merging)",
+		BlameTitle:                           "Main panel (blame)",
 		NormalTitle:                          "Main panel (normal)",
 		LogTitle:                             "Log",
 		LogXOfYTitle:                         "Log (%d of %d)",
@@ -1289,6 +1540,14 @@ func EnglishTranslationSet() *TranslationSet {
 		Error:                                "Error",
 		PickHunk:                             "Pick hunk",
 		PickAllHunks:                         "Pick all hunks",
+		PickBothHunksInOrder:                 "Pick ours and theirs",
+		PickBothHunksInOrderTooltip:          "Resolve the selected conflict by keeping our side followed by their side, dropping the base.",
+		EditConflictResult:                   "Edit conflict resolution",
+		EditConflictResultTooltip:            "Open the selected conflict in your editor and replace it with whatever you save.",
+		ToggleConflictSplitView:              "Toggle split view",
+		ToggleConflictSplitViewTooltip:       "Show ours, base and theirs of the selected conflict next to each other, with the file below.",
+		MergeConflictResultTitle:             "Result",
+		MergeConflictSidesTitle:              "Conflict",
 		Undo:                                 "Undo",
 		UndoReflog:                           "Undo",
 		RedoReflog:                           "Redo",
@@ -1357,6 +1616,10 @@ func EnglishTranslationSet() *TranslationSet {
 		RegularMergeFastForward:              "Regular merge (fast-forward)",
 		RegularMergeFastForwardTooltip:       "Fast-forward '{{.checkedOutBranch}}' to '{{.selectedBranch}}' without creating a merge commit.",
 		CannotFastForwardMerge:               "Cannot fast-forward '{{.checkedOutBranch}}' to '{{.selectedBranch}}'",
+		MergeWillBeClean:                     "Clean: no files will conflict",
+		OneFileWillConflict:                  "1 file will conflict:",
+		FilesWillConflict:                    "%d files will conflict:",
+		PredictingConflictsStatus:            "Checking for conflicts",
 		RegularMergeNonFastForward:           "Regular merge (with merge commit)",
 		RegularMergeNonFastForwardTooltip:    "Merge '{{.selectedBranch}}' into '{{.checkedOutBranch}}', creating a merge commit.",
 		SquashMergeUncommitted:               "Squash merge and leave uncommitted",
@@ -1367,6 +1630,7 @@ func EnglishTranslationSet() *TranslationSet {
 		SwitchRepo:                           `Switch to a recent repo`,
 		AllBranchesLogGraph:                  `Show/cycle all branch logs`,
 		UnsupportedGitService:                `Unsupported git service`,
+		UnsupportedHostingServiceAPI:         `Talking to the API of this git service is not supported. Only GitHub, GitLab and Gitea are supported`,
 		CreatePullRequest:                    `Create pull request`,
 		CopyPullRequestURL:                   `Copy pull request URL to clipboard`,
 		NoBranchOnRemote:                     `This branch doesn't exist on remote. You need to push it to remote first.`,
@@ -1407,6 +1671,7 @@ func EnglishTranslationSet() *TranslationSet {
 		ViewRebaseOptions:                    "View rebase options",
 		ViewCherryPickOptions:                "View cherry-pick options",
 		ViewRevertOptions:                    "View revert options",
+		ViewApplyPatchesOptions:              "View patch application options",
 		NotMergingOrRebasing:                 "You are currently neither rebasing nor merging",
 		AlreadyRebasing:                      "Can't perform this action during a rebase",
 		RecentRepos:                          "Recent repositories",
@@ -1414,6 +1679,7 @@ func EnglishTranslationSet() *TranslationSet {
 		RebaseOptionsTitle:                   "Rebase options",
 		CherryPickOptionsTitle:               "Cherry-pick options",
 		RevertOptionsTitle:                   "Revert options",
+		ApplyPatchesOptionsTitle:             "Patch application options",
 		CommitSummaryTitle:                   "Commit summary",
 		CommitDescriptionTitle:               "Commit description",
 		CommitDescriptionSubTitle:            "Press {{.togglePanelKeyBinding}} to toggle focus, {{.commitMenuKeybinding}} to open menu",
@@ -1451,6 +1717,22 @@ func EnglishTranslationSet() *TranslationSet {
 		FwdNoLocalUpstream:                   "Cannot fast-forward a branch whose remote is not registered locally",
 		FwdCommitsToPush:                     "Cannot fast-forward a branch with commits to push",
 		PullRequestNoUpstream:                "Cannot open a pull request for a branch with no upstream",
+		PullRequestTargetBranch:              "Target branch",
+		PullRequestTitle:                     "Pull request title",
+		PullRequestBody:                      "Pull request description (optional)",
+		PullRequestReadyForReview:            "Ready for review",
+		PullRequestDraft:                     "Draft",
+		ViewCheckStatus:                      "View CI checks",
+		ViewCheckStatusTooltip:               "View the individual CI checks of the selected commit (or the head commit of the selected branch), and open them in the browser.",
+		CIChecks:                             "CI checks",
+		NoCIChecks:                           "There are no CI checks for this commit",
+		CheckStatusNotPushed:                 "CI checks are only available for commits that have been pushed to origin",
+		CheckHasNoURL:                        "This check has no link",
+		OpenIssueLink:                        "Open linked issue",
+		OpenIssueLinkTooltip:                 "Open the issue that the selected commit's message (or the selected branch's name) refers to in the browser. If it refers to several issues, you can choose which one to open.\n\nReferences to issues are recognized by the patterns in the issueLinks config.",
+		IssueLinks:                           "Linked issues",
+		NoIssueLinks:                         "No references to issues found",
+		NoIssueLinkRules:                     "No issue link patterns configured; see issueLinks in the config",
 		ErrorOccurred:                        "An error occurred! Please create an issue at",
 		ConflictLabel:                        "CONFLICT",
 		PendingRebaseTodosSectionHeader:      "Pending rebase todos",
@@ -1494,6 +1776,9 @@ func EnglishTranslationSet() *TranslationSet {
 		DropUpdateRefPrompt:                  "Are you sure you want to delete the selected update-ref todo(s)? This is irreversible except by aborting the rebase.",
 		PullingStatus:                        "Pulling",
 		PushingStatus:                        "Pushing",
+		CreatingPullRequestStatus:            "Creating pull request",
+		PreparingPullRequestStatus:           "Preparing pull request",
+		LoadingCheckStatusStatus:             "Loading CI checks",
 		FetchingStatus:                       "Fetching",
 		SquashingStatus:                      "Squashing",
 		FixingStatus:                         "Fixing up",
@@ -1502,10 +1787,11 @@ func EnglishTranslationSet() *TranslationSet {
 		MovingStatus:                         "Moving",
 		RebasingStatus:                       "Rebasing",
 		MergingStatus:                        "Merging",
-		LowercaseRebasingStatus:              "rebasing",       // lowercase because it shows up in parentheses
-		LowercaseMergingStatus:               "merging",        // lowercase because it shows up in parentheses
-		LowercaseCherryPickingStatus:         "cherry-picking", // lowercase because it shows up in parentheses
-		LowercaseRevertingStatus:             "reverting",      // lowercase because it shows up in parentheses
+		LowercaseRebasingStatus:              "rebasing",         // lowercase because it shows up in parentheses
+		LowercaseMergingStatus:               "merging",          // lowercase because it shows up in parentheses
+		LowercaseCherryPickingStatus:         "cherry-picking",   // lowercase because it shows up in parentheses
+		LowercaseRevertingStatus:             "reverting",        // lowercase because it shows up in parentheses
+		LowercaseApplyingPatchesStatus:       "applying patches", // lowercase because it shows up in parentheses
 		AmendingStatus:                       "Amending",
 		CherryPickingStatus:                  "Cherry-picking",
 		UndoingStatus:                        "Undoing",
@@ -1514,6 +1800,7 @@ func EnglishTranslationSet() *TranslationSet {
 		CommittingStatus:                     "Committing",
 		RewordingStatus:                      "Rewording",
 		RevertingStatus:                      "Reverting",
+		ApplyingPatchesStatus:                "Applying patches",
 		CreatingFixupCommitStatus:            "Creating fixup commit",
 		MovingCommitsToNewBranchStatus:       "Moving commits to new branch",
 		CommitFiles:                          "Commit files",
@@ -1703,6 +1990,9 @@ func EnglishTranslationSet() *TranslationSet {
 		NewBranchNamePrompt:              "Enter new branch name for branch",
 		RenameBranchWarning:              "This branch is tracking a remote. This action will only rename the local branch name, not the name of the remote branch. Continue?",
 		OpenKeybindingsMenu:              "Open keybindings menu",
+		OpenCommandPalette:               "Open command palette",
+		OpenCommandPaletteTooltip:        "Search the actions of all panels (including custom commands) by name, and run the selected one. If it belongs to a different panel, that panel is focused first. Actions that can't be run right now are struck through, and the tooltip tells you why.",
+		CommandPalette:                   "Command palette",
 		ResetCherryPick:                  "Reset copied (cherry-picked) commits selection",
 		ResetCherryPickShort:             "Reset copied commits",
 		NextTab:                          "Next tab",
@@ -1815,6 +2105,7 @@ func EnglishTranslationSet() *TranslationSet {
 		SuggestionsSubtitle:                      "(press %s to delete, %s to edit)",
 		ExtrasTitle:                              "Command log",
 		PullRequestURLCopiedToClipboard:          "Pull request URL copied to clipboard",
+		PullRequestCreated:                       "Created pull request #%d; URL copied to clipboard",
 		CommitDiffCopiedToClipboard:              "Commit diff copied to clipboard",
 		CommitURLCopiedToClipboard:               "Commit URL copied to clipboard",
 		CommitMessageCopiedToClipboard:           "Commit message copied to clipboard",
@@ -1851,6 +2142,22 @@ func EnglishTranslationSet() *TranslationSet {
 		DecreaseRenameSimilarityThresholdTooltip: "Decrease the similarity threshold for a deletion and addition pair to be treated as a rename.\n\nThe default can be changed in the config file with the key 'git.renameSimilarityThreshold'.",
 		DecreaseRenameSimilarityThreshold:        "Decrease rename similarity threshold",
 		RenameSimilarityThresholdChanged:         "Changed rename similarity threshold to %d%%",
+		DiffOptionsMenu:                          "View diff options",
+		DiffOptionsMenuTooltip:                   "View options for how diffs are computed, such as the diff algorithm and whether to detect copied files. The changes apply to all diffs until you quit lazygit.",
+		DiffOptionsMenuTitle:                     "Diff options",
+		DefaultDiffAlgorithm:                     "Default diff algorithm",
+		DefaultDiffAlgorithmTooltip:              "Use whichever algorithm is configured in git's 'diff.algorithm' setting.\n\nThe default can be changed in the config file with the key 'git.diffAlgorithm'.",
+		MyersDiffAlgorithm:                       "Myers diff algorithm",
+		MyersDiffAlgorithmTooltip:                "The basic greedy diff algorithm, which is what git uses unless configured otherwise.",
+		MinimalDiffAlgorithm:                     "Minimal diff algorithm",
+		MinimalDiffAlgorithmTooltip:              "Spend extra time to make sure the smallest possible diff is produced.",
+		PatienceDiffAlgorithm:                    "Patience diff algorithm",
+		PatienceDiffAlgorithmTooltip:             "Match up unique lines first, which tends to produce more readable diffs when code was moved around or restructured.",
+		HistogramDiffAlgorithm:                   "Histogram diff algorithm",
+		HistogramDiffAlgorithmTooltip:            "An extension of the patience algorithm that also handles lines that aren't unique well. Usually the best choice for refactorings.",
+		DetectCopies:                             "Detect copied files",
+		DetectCopiesTooltip:                      "Show files that were created by copying another file as copies rather than as new files. Uses the same similarity threshold as rename detection.\n\nThe default can be changed in the config file with the key 'git.findCopiesInDiffView'.",
+		CantChangeDiffAlgorithmError:             "Cannot change the diff algorithm while building a custom patch, because the patch's lines depend on it.",
 		CreatePullRequestOptions:                 "View create pull request options",
 		DefaultBranch:                            "Default branch",
 		SelectBranch:                             "Select branch",
@@ -1996,6 +2303,175 @@ func EnglishTranslationSet() *TranslationSet {
 		UseCurrentChanges:                        "Use current changes",
 		UseIncomingChanges:                       "Use incoming changes",
 		UseBothChanges:                           "Use both",
+		OpenBlame:                                "Blame file",
+		OpenBlameTooltip:                         "Show, for each line of the selected file, the commit that last changed it. From there you can jump to that commit, or re-blame the file at the commit's parent to walk back through the file's history.",
+		BlameDynamicTitle:                        "Blame (%s)",
+		LoadingBlame:                             "Loading blame",
+		NotCommittedYet:                          "Not committed yet",
+		BlameAtParent:                            "Blame at parent",
+		BlameAtParentTooltip:                     "Re-blame the file as it was before the commit that last changed the selected line. Press `{{.return}}` to go back.",
+		BlameGoToCommit:                          "Go to commit",
+		BlameGoToCommitTooltip:                   "Select the commit that last changed the selected line in the commits panel.",
+		BlameReturn:                              "Return",
+		BlameReturnTooltip:                       "Go back to the previously blamed revision, or close the blame view if there is none.",
+		BlameLineNotCommittedYet:                 "The selected line has not been committed yet",
+		BlameLineHasNoParent:                     "The selected line was introduced by a root commit, so there is no parent to blame",
+		CannotBlameDirectory:                     "Cannot blame a directory, please select a file",
+		CannotBlameUntrackedFile:                 "Cannot blame an untracked file",
+		ViewLineHistory:                          "View history of selected lines",
+		ViewLineHistoryTooltip:                   "Filter the commits panel to show only the commits that touched the selected lines (using git log -L), following them as they move around in the file.",
+		LineHistoryFileNotInHead:                 "This file doesn't exist in HEAD, so there is no history to show for these lines.",
+		FilterPickaxeOption:                      "Enter string to search for in diffs (-S)",
+		FilterPickaxeOptionTooltip:               "Show only commits that change the number of occurrences of the given string, e.g. commits that added or removed a call to a function.",
+		FilterPickaxeRegexOption:                 "Enter regex to search for in diffs (-G)",
+		FilterPickaxeRegexOptionTooltip:          "Show only commits whose diff contains an added or removed line matching the given regular expression.",
+		FilterMessageOption:                      "Enter pattern to search for in commit messages (--grep)",
+		FilterMessageOptionTooltip:               "Show only commits whose message matches the given regular expression.",
+		EnterPickaxeString:                       "Enter string:",
+		EnterPickaxeRegex:                        "Enter regex:",
+		EnterMessagePattern:                      "Enter pattern:",
+		FilteringByPickaxe:                       "Filtering by content",
+		FilteringByPickaxeRegex:                  "Filtering by content regex",
+		FilteringByMessage:                       "Filtering by message",
+		ViewNoteOptions:                          "View note options",
+		ViewNoteOptionsTooltip:                   "Add, edit, or remove the git note attached to the selected commit. Notes are shown below the commit message in the main view.",
+		NoteMenuTitle:                            "Commit note",
+		AddNote:                                  "Add note",
+		EditNote:                                 "Edit note",
+		EditNoteTooltip:                          "Edit the note of the selected commit in the commit message panel.",
+		RemoveNote:                               "Remove note",
+		RemoveNoteTooltip:                        "Remove the note from the selected commit.",
+		NoteDescriptionTitle:                     "Note description",
+		CommitHasNoNote:                          "The selected commit has no note",
+		CannotAttachNoteToTodoCommit:             "Notes can't be attached to rebase todo entries",
+		RangeDiffTitle:                           "Range-diff",
+		RangeDiffAgainst:                         "Range-diff against",
+		RangeDiffAgainstTooltip:                  "Compare the commits that are only reachable from this ref with the ones only reachable from the ref you select next (using git range-diff). Useful for seeing what changed between the old and new version of a rebased branch, e.g. by selecting the branch's old head in the reflog first.",
+		ShowRangeDiff:                            "Show as range-diff",
+		ShowRegularDiff:                          "Show as regular diff",
+		ShowWordDiff:                             "Highlight changed words",
+		HideWordDiff:                             "Stop highlighting changed words",
+		WordDiffTooltip:                          "Emphasise the words that changed within a line, rather than just showing the old and new line. Applies to all diffs until you turn it off again.",
+		ShowMovedLines:                           "Highlight moved lines",
+		HideMovedLines:                           "Stop highlighting moved lines",
+		MovedLinesTooltip:                        "Show lines that were moved rather than changed in different colors. Applies to all diffs until you turn it off again.",
+		ShowSideBySideDiff:                       "Show side-by-side diff",
+		HideSideBySideDiff:                       "Show unified diff",
+		SideBySideDiffTooltip:                    "Show the old and the new version of a file next to each other when viewing the diff of a single file, including in the staging and custom patch views.",
+		ShowSyntaxHighlighting:                   "Highlight syntax",
+		HideSyntaxHighlighting:                   "Stop highlighting syntax",
+		SyntaxHighlightingTooltip:                "Highlight keywords, strings, comments and numbers in diffs, based on the file extension. The colors can be configured in gui.theme. Not applied in the main view while changed words or moved lines are highlighted, or when using a pager.",
+		SideBySideOldTitle:                       "%s (old)",
+		SideBySideNewTitle:                       "%s (new)",
+		NextRangeDiffPair:                        "Next commit pair",
+		NextRangeDiffPairTooltip:                 "Scroll to the next pair of corresponding commits in the range-diff.",
+		PrevRangeDiffPair:                        "Previous commit pair",
+		PrevRangeDiffPairTooltip:                 "Scroll to the previous pair of corresponding commits in the range-diff.",
+		NotShowingRangeDiff:                      "Only available when showing a range-diff",
+		SparseCheckoutOptions:                    "View sparse checkout options",
+		SparseCheckoutOptionsTooltip:             "View options for the sparse checkout of the working tree (cone mode), e.g. adding or removing checked out directories.",
+		SparseCheckoutTitle:                      "Sparse checkout",
+		SparseCheckoutDirsHeader:                 "Sparse checkout (cone mode) directories:",
+		SparseCheckoutOnlyRootFiles:              "Only files in the root directory are checked out",
+		SparseCheckoutAddDir:                     "Add directory",
+		SparseCheckoutAddDirTooltip:              "Add a directory to the sparse checkout. If the working tree isn't a sparse checkout yet, this turns it into one that contains only this directory and the files in the root directory.",
+		SparseCheckoutRemoveDir:                  "Remove directory",
+		SparseCheckoutRemoveDirTooltip:           "Remove a directory from the sparse checkout, deleting its files from the working tree.",
+		SparseCheckoutReapply:                    "Reapply",
+		SparseCheckoutReapplyTooltip:             "Update the working tree to match the sparse checkout again, e.g. after a merge or rebase checked out files outside of it.",
+		SparseCheckoutDisable:                    "Disable sparse checkout",
+		SparseCheckoutDisableTooltip:             "Turn the sparse checkout back into a full checkout of all files.",
+		SparseCheckoutEnterDir:                   "Directory to add to the sparse checkout:",
+		SparseCheckoutNotEnabled:                 "The working tree is not a sparse checkout in cone mode",
+		SparseCheckoutNoDirs:                     "The sparse checkout contains no directories",
+		ToggleSparseCheckoutDir:                  "Add/remove directory from sparse checkout",
+		ToggleSparseCheckoutDirTooltip:           "Add the selected directory (or the directory containing the selected file) to the sparse checkout, or remove it if it is already part of it.",
+		SparseCheckoutRootDirAlwaysIncluded:      "Files in the root directory are always part of the sparse checkout",
+		SparseCheckoutDirInsideConeDir:           "'{{.dir}}' is part of the sparse checkout as a subdirectory of '{{.coneDir}}'; remove '{{.coneDir}}' instead",
+		UpdatingSparseCheckout:                   "Updating sparse checkout",
+		LfsFileTitle:                             "Git LFS file",
+		LfsFileSummary:                           "'{{.path}}' is tracked by Git LFS. Git only stores a pointer to its content, so the diff is not shown.",
+		LfsCommitted:                             "Committed",
+		LfsStaged:                                "Staged",
+		LfsWorkingTree:                           "Working tree",
+		LfsNotPresent:                            "not present",
+		LfsLocks:                                 "Locks:",
+		LfsLocksHint:                             "Press {{.key}} to see who has locked the file, or to lock or unlock it.",
+		LfsShowLocks:                             "Show locks",
+		LfsShowLocksTooltip:                      "Show who has locked the selected file, as reported by the Git LFS server (git lfs locks).",
+		ViewLfsLockOptions:                       "View LFS lock options",
+		ViewLfsLockOptionsTooltip:                "Lock or unlock the selected file on the Git LFS server, so that others know you are working on it.",
+		LfsLockTitle:                             "LFS lock",
+		LfsLock:                                  "Lock file",
+		LfsLockTooltip:                           "Lock the selected file on the Git LFS server (git lfs lock).",
+		LfsUnlock:                                "Unlock file",
+		LfsUnlockTooltip:                         "Remove your lock on the selected file (git lfs unlock).",
+		LfsForceUnlock:                           "Force unlock file",
+		LfsForceUnlockTooltip:                    "Remove the lock on the selected file even if it is owned by somebody else (git lfs unlock --force).",
+		LfsForceUnlockPrompt:                     "Are you sure you want to remove the lock on '{{.path}}'? It may be owned by somebody else.",
+		NotAnLfsFile:                             "The selected file is not tracked by Git LFS",
+		LockingLfsFile:                           "Locking file",
+		UnlockingLfsFile:                         "Unlocking file",
+		ForgetRerereResolution:                   "Forget recorded resolution",
+		ForgetRerereResolutionTooltip:            "Forget the resolution that rerere recorded for the selected files and restore their conflict markers, so that you can resolve the conflicts again. Use this if the recorded resolution is wrong.",
+		NoRerereResolvedFilesSelected:            "None of the selected files were resolved by rerere",
+		ReusedRerereResolutions:                  "Reused recorded resolution for: {{.paths}}",
+		ViewPatchFileOptions:                     "View patch file options",
+		ViewPatchFileOptionsTooltip:              "Export the selected commits as patch files, or apply a patch or mailbox file as new commits.",
+		PatchFilesTitle:                          "Patch files",
+		ExportPatches:                            "Export selected commits as patch files",
+		ExportPatchesWithCoverLetter:             "Export selected commits as patch files with cover letter",
+		ExportPatchesTooltip:                     "Write one patch file per selected commit into a directory, using `git format-patch`. The files can be sent by email or applied with `git am`.",
+		ExportPatchesWithCoverLetterTooltip:      "Like exporting as patch files, but also write a cover letter template that introduces the patch series.",
+		ExportPatchesDirectoryPrompt:             "Directory to write patch files to:",
+		ExportingPatches:                         "Exporting patches",
+		ExportedPatches:                          "Exported {{.count}} patch file(s) to {{.directory}}",
+		CannotExportTodoCommits:                  "Rebase todo entries can't be exported",
+		ApplyMailbox:                             "Apply patch/mailbox file",
+		ApplyMailboxTooltip:                      "Apply the patches of a patch or mailbox file as new commits on top of the current branch, using `git am`. If a patch doesn't apply cleanly, resolve the conflicts and then continue, skip, or abort from the merge/rebase options menu.",
+		ApplyMailboxPathPrompt:                   "Patch or mailbox file to apply:",
+		CannotApplyPatchesMidMergeOrRebase:       "Can't apply patches while a merge or rebase is in progress",
+		CloneRepository:                          "Clone repository",
+		CloneRepositoryTooltip:                   "Clone a remote repository into a new directory and switch to it.",
+		CloneRepositoryURLPrompt:                 "Repository URL:",
+		CloneRepositoryDirectoryPrompt:           "Clone into directory:",
+		CloningRepository:                        "Cloning repository",
+		InitRepository:                           "Create new repository",
+		InitRepositoryTooltip:                    "Create a new, empty repository using `git init` and switch to it.",
+		InitRepositoryDirectoryPrompt:            "Create repository in directory:",
+		StashSelectedPaths:                       "Stash selected files",
+		StashSelectedPathsTooltip:                "Stash only the changes to the selected files and directories, leaving the rest of the working tree untouched. Untracked files are included if they are selected.",
+		RestoreFileFromStash:                     "Restore file from stash",
+		RestoreFileFromStashTooltip:              "Replace the file in your working tree with its version from the stash entry, without applying the rest of the entry. The index is left untouched.",
+		RestoreFileFromStashPrompt:               "'{{.path}}' has unstaged changes, which will be lost. Are you sure you want to restore it from the stash?",
+		CanOnlyRestoreFromStash:                  "Files can only be restored from stash entries",
+		CannotRestoreDirectoryFromStash:          "Directories can't be restored from a stash entry; select a single file",
+		ReviewHunks:                              "Review hunks",
+		ReviewHunksTooltip:                       "Go through the unstaged hunks of all files one at a time, like `git add -p`, and stage, skip, split, or edit each of them. You can also only visit the hunks with an added or removed line matching a regex, e.g. to stage all removals of debug output at once.",
+		ReviewHunksFilterPrompt:                  "Regex to match the lines against, without their +/- prefix (leave empty for all hunks):",
+		ReviewAllHunks:                           "All hunks",
+		ReviewHunksWithAddedLine:                 "Only hunks with an added line matching a regex",
+		ReviewHunksWithRemovedLine:               "Only hunks with a removed line matching a regex",
+		ReviewHunksWithChangedLine:               "Only hunks with an added or removed line matching a regex",
+		NoHunksToReview:                          "There are no unstaged hunks to review",
+		ReviewingHunks:                           "Reviewing hunks",
+		ExitHunkReview:                           "Stop reviewing hunks",
+		HunkReviewProgress:                       "hunk %d of %d, file %d of %d",
+		HunkReviewFinished:                       "Reviewed all hunks",
+		SkipHunk:                                 "Skip hunk",
+		SkipHunkTooltip:                          "Leave the hunk unstaged and go to the next one.",
+		SplitHunk:                                "Split hunk",
+		SplitHunkTooltip:                         "Split the hunk into its blocks of consecutive changes, so that they can be staged or skipped one at a time.",
+		CannotSplitHunk:                          "The hunk can't be split any further",
+		NotReviewingHunks:                        "Only available while reviewing hunks",
+		SplitCommit:                              "Split commit",
+		SplitCommitTooltip:                       "Split the selected commit into several commits. This starts a rebase at the commit and resets it, so that its changes are in the working tree, and opens the staging view so that you can commit them in parts; the first commit message is pre-filled with the original one. Once everything is committed, the rebase continues automatically.",
+		CannotSplitFirstCommit:                   "Can't split the first commit of the repository",
+		CannotSplitMergeCommit:                   "Can't split a merge commit",
+		SplittingCommitStatus:                    "Splitting commit %s",
+		StopSplittingCommit:                      "Stop splitting commit",
+		SplitCommitHint:                          "Stage and commit the changes in as many parts as you like; the rebase continues once everything is committed",
+		FinishedSplittingCommit:                  "Finished splitting commit",
 
 		Actions: Actions{
 			// TODO: combine this with the original keybinding descriptions (those are all in lowercase atm)
@@ -2112,12 +2588,31 @@ func EnglishTranslationSet() *TranslationSet {
 			CopyPullRequestURL:               "Copy pull request URL",
 			OpenMergeTool:                    "Open merge tool",
 			OpenCommitInBrowser:              "Open commit in browser",
+			OpenIssueLink:                    "Open linked issue",
 			OpenPullRequest:                  "Open pull request in browser",
+			CreatePullRequest:                "Create pull request",
 			StartBisect:                      "Start bisect",
 			ResetBisect:                      "Reset bisect",
 			BisectSkip:                       "Bisect skip",
 			BisectMark:                       "Bisect mark",
+			BisectRun:                        "Bisect run",
 			AddWorktree:                      "Add worktree",
+			EditCommitNote:                   "Edit commit note",
+			RemoveCommitNote:                 "Remove commit note",
+			AddToSparseCheckout:              "Add to sparse checkout",
+			RemoveFromSparseCheckout:         "Remove from sparse checkout",
+			ReapplySparseCheckout:            "Reapply sparse checkout",
+			DisableSparseCheckout:            "Disable sparse checkout",
+			LockLfsFile:                      "Lock LFS file",
+			UnlockLfsFile:                    "Unlock LFS file",
+			ForgetRerereResolution:           "Forget recorded resolution",
+			ExportPatches:                    "Export patches",
+			ApplyMailbox:                     "Apply patch/mailbox file",
+			CloneRepository:                  "Clone repository",
+			InitRepository:                   "Create new repository",
+			StashSelectedPaths:               "Stash selected files",
+			RestoreFileFromStash:             "Restore file from stash",
+			SplitCommit:                      "Split commit",
 		},
 		Bisect: Bisect{
 			Mark:                        "Mark current commit (%s) as %s",
@@ -2135,6 +2630,11 @@ func EnglishTranslationSet() *TranslationSet {
 			CompletePrompt:              "Bisect complete! The following commit introduced the change:\n\n%s\n\nDo you want to reset 'git bisect' now?",
 			CompletePromptIndeterminate: "Bisect complete! Some commits were skipped, so any of the following commits may have introduced the change:\n\n%s\n\nDo you want to reset 'git bisect' now?",
 			Bisecting:                   "Bisecting",
+			RunOption:                   "Run command to find the %s commit",
+			RunTooltip:                  "Let git test the remaining commits automatically by running a shell command on each of them, using `git bisect run`. An exit code of 0 marks the commit as %s, 125 skips it, and any other code up to 127 marks it as %s.",
+			RunPrompt:                   "Command to test each commit:",
+			Running:                     "Running bisect",
+			RunRequiresMarkedCommits:    "Mark at least one %s and one %s commit before running a command",
 		},
 		Log: Log{
 			EditRebase:               "Beginning interactive rebase at '{{.ref}}'",
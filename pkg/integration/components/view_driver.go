@@ -332,6 +332,24 @@ func (self *ViewDriver) SelectedLine(matcher *TextMatcher) *ViewDriver {
 	return self
 }
 
+// asserts on the first visible line of the view, i.e. the line at the view's
+// origin. Useful for views without a selection that are scrolled to some line.
+func (self *ViewDriver) FirstVisibleLine(matcher *TextMatcher) *ViewDriver {
+	self.t.assertWithRetries(func() (bool, string) {
+		view := self.getView()
+		originY := view.OriginY()
+
+		viewLines := view.BufferLines()
+		if originY >= len(viewLines) {
+			return false, fmt.Sprintf("%s: Expected view to have at least %d lines, but it only has %d", self.context, originY+1, len(viewLines))
+		}
+
+		return matcher.context(fmt.Sprintf("%s: Unexpected first visible line.", self.context)).test(viewLines[originY])
+	})
+
+	return self
+}
+
 // asserts on the index of the selected line. 0 is the first index, representing the line at the top of the view.
 func (self *ViewDriver) SelectedLineIdx(expected int) *ViewDriver {
 	self.t.assertWithRetries(func() (bool, string) {
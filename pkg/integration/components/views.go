@@ -42,6 +42,14 @@ func (self *Views) MergeConflicts() *ViewDriver {
 	return self.regularView("mergeConflicts")
 }
 
+func (self *Views) MergeConflictsSides() *ViewDriver {
+	return self.regularView("mergeConflictsSides")
+}
+
+func (self *Views) Blame() *ViewDriver {
+	return self.regularView("blame")
+}
+
 func (self *Views) Commits() *ViewDriver {
 	return self.regularView("commits")
 }
@@ -0,0 +1,66 @@
+package bisect
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var Run = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Find a bad commit automatically by letting git bisect run a command",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupRepo: func(shell *Shell) {
+		shell.
+			NewBranch("mybranch").
+			CreateNCommits(10)
+	},
+	SetupConfig: func(cfg *config.AppConfig) {
+		cfg.GetUserConfig().Git.Log.ShowGraph = "never"
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Commits().
+			Focus().
+			SelectedLine(Contains("CI commit 10")).
+			Press(keys.Commits.ViewBisectOptions).
+			Tap(func() {
+				t.ExpectPopup().Menu().Title(Equals("Bisect")).Select(MatchesRegexp(`Mark .* as bad`)).Confirm()
+			}).
+			Press(keys.Commits.ViewBisectOptions).
+			Tap(func() {
+				// We need both a good and a bad commit before git can take over
+				t.ExpectPopup().Menu().Title(Equals("Bisect")).
+					Select(Contains("Run command to find the bad commit")).
+					Confirm()
+
+				t.ExpectToast(Equals("Disabled: Mark at least one bad and one good commit before running a command"))
+
+				t.ExpectPopup().Menu().Title(Equals("Bisect")).Cancel()
+			}).
+			NavigateToLine(Contains("CI commit 01")).
+			Press(keys.Commits.ViewBisectOptions).
+			Tap(func() {
+				t.ExpectPopup().Menu().Title(Equals("Bisect")).Select(MatchesRegexp(`Mark .* as good`)).Confirm()
+			}).
+			Press(keys.Commits.ViewBisectOptions).
+			Tap(func() {
+				t.ExpectPopup().Menu().Title(Equals("Bisect")).
+					Select(Contains("Run command to find the bad commit")).
+					Confirm()
+
+				t.ExpectPopup().Prompt().
+					Title(Equals("Command to test each commit:")).
+					Type("test ! -f file06.txt").
+					Confirm()
+
+				t.ExpectPopup().Alert().Title(Equals("Bisect complete")).
+					Content(MatchesRegexp("(?s)commit 06.*Do you want to reset")).
+					Cancel()
+			}).
+			// The commits that git tested are marked, and we end up on the
+			// first bad one
+			SelectedLine(Contains("CI commit 06")).
+			Content(Contains("CI commit 05").Contains("<-- good"))
+
+		t.Views().Information().Content(Contains("Bisecting"))
+	},
+})
@@ -50,6 +50,7 @@ var Skip = NewIntegrationTest(NewIntegrationTestArgs{
 						Contains("b Mark current commit").Contains("as bad"),
 						Contains("g Mark current commit").Contains("as good"),
 						Contains("s Skip current commit"),
+						Contains("x Run command to find the bad commit"),
 						Contains("r Reset bisect"),
 						Contains("Cancel"),
 					).
@@ -78,6 +79,7 @@ var Skip = NewIntegrationTest(NewIntegrationTestArgs{
 						Contains("g Mark current commit").Contains("as good"),
 						Contains("s Skip current commit"),
 						Contains("S Skip selected commit"),
+						Contains("x Run command to find the bad commit"),
 						Contains("r Reset bisect"),
 						Contains("Cancel"),
 					).
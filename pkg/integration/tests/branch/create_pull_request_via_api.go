@@ -0,0 +1,108 @@
+package branch
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+// We're emulating the clipboard by writing to a file called clipboard, and the
+// hosting service's API with a local server that only accepts the pull request
+// we expect to be created.
+
+var CreatePullRequestViaApi = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Create a pull request through the hosting service's API, pushing the branch first",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupConfig: func(config *config.AppConfig) {
+		config.GetUserConfig().OS.CopyToClipboardCmd = "printf '%s' {{text}} > clipboard"
+
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			var body struct {
+				Title string `json:"title"`
+				Body  string `json:"body"`
+				Head  string `json:"head"`
+				Base  string `json:"base"`
+				Draft bool   `json:"draft"`
+			}
+			_ = json.NewDecoder(r.Body).Decode(&body)
+
+			if r.Method != http.MethodPost || r.URL.Path != "/repos/owner/repo/pulls" ||
+				r.Header.Get("Authorization") != "Bearer secret" ||
+				body.Title != "Add feature" || body.Body != "Some description that is long enough to be wrapped in the description panel,\nbut not in the pull request" ||
+				body.Head != "feature" || body.Base != "master" || !body.Draft {
+				w.WriteHeader(http.StatusUnprocessableEntity)
+				_, _ = w.Write([]byte(`{"message": "Unexpected request"}`))
+				return
+			}
+
+			w.WriteHeader(http.StatusCreated)
+			_, _ = w.Write([]byte(`{
+				"number": 42,
+				"state": "open",
+				"draft": true,
+				"html_url": "https://github.com/owner/repo/pull/42",
+				"head": {"ref": "feature", "repo": {"full_name": "owner/repo"}}
+			}`))
+		}))
+
+		config.GetUserConfig().HostingServiceAPI.Tokens = map[string]string{"github.com": "secret"}
+		config.GetUserConfig().HostingServiceAPI.BaseURLs = map[string]string{"github.com": server.URL}
+	},
+	SetupRepo: func(shell *Shell) {
+		shell.EmptyCommit("one")
+		shell.CloneIntoRemote("origin")
+
+		// The hosting service is determined from origin's URL, but we want
+		// pushes to go to our local remote
+		shell.SetConfig("remote.origin.url", "https://github.com/owner/repo.git")
+		shell.SetConfig("url.../origin.pushInsteadOf", "https://github.com/owner/repo.git")
+
+		shell.NewBranch("feature")
+		shell.EmptyCommit("Add feature")
+		shell.EmptyCommit("Polish feature")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Branches().
+			Focus().
+			Lines(
+				Contains("* feature").IsSelected(),
+				Contains("master"),
+			).
+			Press(keys.Branches.CreatePullRequest)
+
+		t.ExpectPopup().Prompt().
+			Title(Equals("Target branch")).
+			InitialText(Equals("master")).
+			Confirm()
+
+		t.ExpectPopup().CommitMessagePanel().
+			Title(Equals("Pull request title")).
+			InitialText(Equals("Add feature")).
+			SwitchToDescription().
+			Title(Equals("Pull request description (optional)")).
+			Type("Some description that is long enough to be wrapped in the description panel,").
+			AddNewline().
+			Type("but not in the pull request").
+			SwitchToSummary().
+			Confirm()
+
+		t.ExpectPopup().Menu().
+			Title(Equals("Create pull request")).
+			Select(Equals("Draft")).
+			Confirm()
+
+		t.ExpectToast(Equals("Created pull request #42; URL copied to clipboard"))
+
+		t.FileSystem().FileContent("clipboard", Equals("https://github.com/owner/repo/pull/42"))
+
+		t.Views().Branches().
+			Lines(
+				Contains("feature ✓").IsSelected(),
+				Contains("master"),
+			)
+	},
+})
@@ -0,0 +1,72 @@
+package branch
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+	"github.com/jesseduffield/lazygit/pkg/integration/tests/shared"
+)
+
+var PredictMergeConflicts = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Show which files will conflict in the merge and rebase menus, and in the branches view",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	GitVersion:   AtLeast("2.38.0"),
+	SetupConfig: func(config *config.AppConfig) {
+		config.GetUserConfig().Git.LocalBranchSortOrder = "alphabetical"
+		config.GetUserConfig().Git.MainBranches = []string{"original-branch"}
+		config.GetUserConfig().Gui.ShowConflictsWithBaseBranch = true
+	},
+	SetupRepo: func(shell *Shell) {
+		shared.MergeConflictsSetup(shell)
+		shell.
+			Checkout("original-branch").
+			NewBranch("unrelated-branch").
+			CreateFileAndAdd("other-file", "content").
+			Commit("unrelated change").
+			Checkout("original-branch").
+			UpdateFileAndAdd("file", "main change\n").
+			Commit("main change").
+			Checkout("first-change-branch")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Branches().
+			Focus().
+			Lines(
+				Contains("first-change-branch").Contains("✗1").IsSelected(),
+				Contains("original-branch").DoesNotContain("✗"),
+				Contains("second-change-branch").Contains("✗1"),
+				Contains("unrelated-branch").DoesNotContain("✗"),
+			).
+			NavigateToLine(Contains("second-change-branch")).
+			Press(keys.Branches.MergeIntoCurrentBranch)
+
+		t.ExpectPopup().Menu().
+			Title(Equals("Merge")).
+			ContainsLines(
+				Contains("1 file will conflict:"),
+				Contains("file"),
+			).
+			Cancel()
+
+		t.Views().Branches().
+			Press(keys.Branches.RebaseBranch)
+
+		t.ExpectPopup().Menu().
+			Title(Equals("Rebase 'first-change-branch'")).
+			ContainsLines(
+				Contains("1 file will conflict:"),
+			).
+			Cancel()
+
+		t.Views().Branches().
+			NavigateToLine(Contains("unrelated-branch")).
+			Press(keys.Branches.MergeIntoCurrentBranch)
+
+		t.ExpectPopup().Menu().
+			Title(Equals("Merge")).
+			ContainsLines(
+				Contains("Clean: no files will conflict"),
+			).
+			Cancel()
+	},
+})
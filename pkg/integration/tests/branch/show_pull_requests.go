@@ -0,0 +1,94 @@
+package branch
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+// We're emulating the hosting service's GraphQL API with a local server that
+// knows about the pull requests of two branches
+
+var ShowPullRequests = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Show the pull requests of local branches, looked up by the names of their upstream branches",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupConfig: func(config *config.AppConfig) {
+		pullRequests := map[string]string{
+			"feature": `{"number": 4, "state": "OPEN", "isDraft": false, "url": "https://github.com/owner/repo/pull/4", "headRefName": "feature",
+				"headRepository": {"nameWithOwner": "owner/repo"},
+				"latestOpinionatedReviews": {"nodes": [{"state": "APPROVED", "author": {"login": "alice"}}]}}`,
+			"bugfix": `{"number": 3, "state": "MERGED", "isDraft": false, "url": "https://github.com/owner/repo/pull/3", "headRefName": "bugfix",
+				"headRepository": {"nameWithOwner": "owner/repo"}}`,
+		}
+
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			var body struct {
+				Variables map[string]string `json:"variables"`
+			}
+			_ = json.NewDecoder(r.Body).Decode(&body)
+
+			if r.Method != http.MethodPost || r.URL.Path != "/graphql" ||
+				r.Header.Get("Authorization") != "Bearer secret" ||
+				body.Variables["owner"] != "owner" || body.Variables["repo"] != "repo" {
+				w.WriteHeader(http.StatusNotFound)
+				_, _ = w.Write([]byte(`{"message": "Unexpected request"}`))
+				return
+			}
+
+			fields := []string{}
+			for name, branch := range body.Variables {
+				if name == "owner" || name == "repo" {
+					continue
+				}
+				nodes := ""
+				if pullRequest, ok := pullRequests[branch]; ok {
+					nodes = pullRequest
+				}
+				fields = append(fields, `"`+name+`": {"nodes": [`+nodes+`]}`)
+			}
+			_, _ = w.Write([]byte(`{"data": {"repository": {` + strings.Join(fields, ", ") + `}}}`))
+		}))
+
+		config.GetUserConfig().HostingServiceAPI.ShowPullRequests = true
+		config.GetUserConfig().HostingServiceAPI.Tokens = map[string]string{"github.com": "secret"}
+		config.GetUserConfig().HostingServiceAPI.BaseURLs = map[string]string{"github.com": server.URL}
+	},
+	SetupRepo: func(shell *Shell) {
+		shell.EmptyCommit("one")
+		shell.CloneIntoRemote("origin")
+
+		shell.NewBranch("feature")
+		shell.EmptyCommit("two")
+		shell.PushBranchAndSetUpstream("origin", "feature")
+
+		// The pull request is looked up by the name of the upstream branch
+		shell.NewBranch("fix")
+		shell.EmptyCommit("three")
+		shell.PushBranchAndSetUpstream("origin", "fix:bugfix")
+
+		shell.NewBranch("no-pull-request")
+
+		// The hosting service is determined from origin's URL
+		shell.SetConfig("remote.origin.url", "https://github.com/owner/repo.git")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Branches().
+			ContainsLines(
+				Contains("#4✓ feature"),
+			).
+			ContainsLines(
+				Contains("#3  fix"),
+			).
+			ContainsLines(
+				Contains("no-pull-request").DoesNotContain("#"),
+			).
+			ContainsLines(
+				Contains("master").DoesNotContain("#"),
+			)
+	},
+})
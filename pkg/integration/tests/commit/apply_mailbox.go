@@ -0,0 +1,66 @@
+package commit
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var ApplyMailbox = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Apply a patch file with git am, resolve its conflict and continue",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupConfig:  func(config *config.AppConfig) {},
+	SetupRepo: func(shell *Shell) {
+		shell.CreateFileAndAdd("file", "original\n").
+			Commit("initial").
+			NewBranch("feature").
+			UpdateFileAndAdd("file", "feature change\n").
+			Commit("feature change").
+			RunCommand([]string{"git", "format-patch", "-1", "--output-directory", "../patches"}).
+			Checkout("master").
+			UpdateFileAndAdd("file", "master change\n").
+			Commit("master change")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Commits().
+			Focus().
+			Press(keys.Commits.ViewPatchFileOptions)
+
+		t.ExpectPopup().Menu().
+			Title(Equals("Patch files")).
+			Select(Contains("Apply patch/mailbox file")).
+			Confirm()
+
+		t.ExpectPopup().Prompt().
+			Title(Equals("Patch or mailbox file to apply:")).
+			Type("../patches/0001-feature-change.patch").
+			Confirm()
+
+		t.Common().AcknowledgeConflicts()
+
+		t.Views().Status().
+			Content(Contains("(applying patches)"))
+
+		t.Views().Files().
+			IsFocused().
+			Lines(
+				Contains("UU file"),
+			).
+			Tap(func() {
+				t.Shell().UpdateFile("file", "resolved\n")
+			}).
+			Press(keys.Universal.Refresh)
+
+		t.Common().ContinueOnConflictsResolved("am")
+
+		t.Views().Status().
+			Content(DoesNotContain("(applying patches)"))
+
+		t.Views().Commits().
+			Lines(
+				Contains("feature change"),
+				Contains("master change"),
+				Contains("initial"),
+			)
+	},
+})
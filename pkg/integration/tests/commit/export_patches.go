@@ -0,0 +1,65 @@
+package commit
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var ExportPatches = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Export a range of commits as patch files with a cover letter, and then a single commit that isn't the head commit",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupConfig:  func(config *config.AppConfig) {},
+	SetupRepo: func(shell *Shell) {
+		shell.CreateNCommits(3)
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Commits().
+			Focus().
+			Lines(
+				Contains("commit 03").IsSelected(),
+				Contains("commit 02"),
+				Contains("commit 01"),
+			).
+			Press(keys.Universal.RangeSelectDown).
+			Press(keys.Commits.ViewPatchFileOptions)
+
+		t.ExpectPopup().Menu().
+			Title(Equals("Patch files")).
+			Select(Contains("Export selected commits as patch files with cover letter")).
+			Confirm()
+
+		t.ExpectPopup().Prompt().
+			Title(Equals("Directory to write patch files to:")).
+			InitialText(Equals("patches")).
+			Confirm()
+
+		t.ExpectToast(Equals("Exported 3 patch file(s) to patches"))
+
+		t.FileSystem().PathPresent("patches/0000-cover-letter.patch")
+		t.FileSystem().FileContent("patches/0001-commit-02.patch", Contains("Subject: [PATCH 1/2] commit 02"))
+		t.FileSystem().FileContent("patches/0002-commit-03.patch", Contains("Subject: [PATCH 2/2] commit 03"))
+
+		t.Views().Commits().
+			// Cancel the range selection
+			PressEscape().
+			NavigateToLine(Contains("commit 02")).
+			Press(keys.Commits.ViewPatchFileOptions)
+
+		t.ExpectPopup().Menu().
+			Title(Equals("Patch files")).
+			Select(Contains("Export selected commits as patch files").DoesNotContain("cover letter")).
+			Confirm()
+
+		t.ExpectPopup().Prompt().
+			Title(Equals("Directory to write patch files to:")).
+			Clear().
+			Type("single").
+			Confirm()
+
+		t.ExpectToast(Equals("Exported 1 patch file(s) to single"))
+
+		t.FileSystem().FileContent("single/0001-commit-02.patch", Contains("Subject: [PATCH] commit 02"))
+		t.FileSystem().PathNotPresent("single/0002-commit-03.patch")
+	},
+})
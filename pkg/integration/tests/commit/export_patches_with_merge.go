@@ -0,0 +1,56 @@
+package commit
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var ExportPatchesWithMerge = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Export a range of commits that includes a merge; only the selected non-merge commits are exported",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupConfig:  func(config *config.AppConfig) {},
+	SetupRepo: func(shell *Shell) {
+		shell.CreateFileAndAdd("base", "base\n").
+			Commit("base").
+			NewBranch("side").
+			CreateFileAndAdd("side", "side\n").
+			Commit("side commit").
+			Checkout("master").
+			CreateFileAndAdd("master", "master\n").
+			Commit("master commit").
+			Merge("side").
+			CreateFileAndAdd("after", "after\n").
+			Commit("after merge")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Commits().
+			Focus().
+			Lines(
+				Contains("after merge").IsSelected(),
+				Contains("Merge branch 'side'"),
+				Contains("side commit"),
+				Contains("master commit"),
+				Contains("base"),
+			).
+			Press(keys.Universal.RangeSelectDown).
+			Press(keys.Commits.ViewPatchFileOptions)
+
+		t.ExpectPopup().Menu().
+			Title(Equals("Patch files")).
+			Select(Contains("Export selected commits as patch files").DoesNotContain("cover letter")).
+			Confirm()
+
+		t.ExpectPopup().Prompt().
+			Title(Equals("Directory to write patch files to:")).
+			InitialText(Equals("patches")).
+			Confirm()
+
+		// Neither the merge commit itself nor the commits that it brought in
+		// are exported
+		t.ExpectToast(Equals("Exported 1 patch file(s) to patches"))
+
+		t.FileSystem().FileContent("patches/0001-after-merge.patch", Contains("Subject: [PATCH] after merge"))
+		t.FileSystem().PathNotPresent("patches/0002-side-commit.patch")
+	},
+})
@@ -0,0 +1,89 @@
+package commit
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var Notes = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Add, edit, and remove git notes of commits in a custom notes ref",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupConfig: func(config *config.AppConfig) {
+		config.GetUserConfig().Git.NotesRef = "refs/notes/review"
+	},
+	SetupRepo: func(shell *Shell) {
+		shell.EmptyCommit("commit 1").
+			EmptyCommit("commit 2").
+			RunCommand([]string{"git", "notes", "--ref=refs/notes/review", "add", "-m", "Reviewed-by: Alice", "HEAD~1"})
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Commits().
+			Focus().
+			Lines(
+				Contains("commit 2").DoesNotContain("✎").IsSelected(),
+				Contains("✎").Contains("commit 1"),
+			).
+			Press(keys.Commits.ViewNoteOptions).
+			Tap(func() {
+				t.ExpectPopup().Menu().
+					Title(Equals("Commit note")).
+					Select(Contains("Remove note")).
+					Confirm()
+
+				t.ExpectToast(Equals("Disabled: The selected commit has no note"))
+
+				t.ExpectPopup().Menu().
+					Title(Equals("Commit note")).
+					Select(Contains("Add note")).
+					Confirm()
+
+				t.ExpectPopup().CommitMessagePanel().
+					Title(Equals("Add note")).
+					InitialText(Equals("")).
+					Type("Tested-by: Bob").
+					Confirm()
+			}).
+			Lines(
+				Contains("✎").Contains("commit 2").IsSelected(),
+				Contains("✎").Contains("commit 1"),
+			).
+			Tap(func() {
+				t.Views().Main().Content(Contains("Notes (review):\n    Tested-by: Bob"))
+			}).
+			NavigateToLine(Contains("commit 1")).
+			Tap(func() {
+				t.Views().Main().Content(Contains("Notes (review):\n    Reviewed-by: Alice"))
+			}).
+			Press(keys.Commits.ViewNoteOptions).
+			Tap(func() {
+				t.ExpectPopup().Menu().
+					Title(Equals("Commit note")).
+					Select(Contains("Edit note")).
+					Confirm()
+
+				t.ExpectPopup().CommitMessagePanel().
+					Title(Equals("Edit note")).
+					InitialText(Equals("Reviewed-by: Alice")).
+					Clear().
+					Type("Reviewed-by: Carol").
+					Confirm()
+
+				t.Views().Main().Content(Contains("Notes (review):\n    Reviewed-by: Carol"))
+			}).
+			NavigateToLine(Contains("commit 2")).
+			Press(keys.Commits.ViewNoteOptions).
+			Tap(func() {
+				t.ExpectPopup().Menu().
+					Title(Equals("Commit note")).
+					Select(Contains("Remove note")).
+					Confirm()
+			}).
+			Lines(
+				Contains("commit 2").DoesNotContain("✎").IsSelected(),
+				Contains("✎").Contains("commit 1"),
+			)
+
+		t.Views().Main().Content(DoesNotContain("Tested-by: Bob"))
+	},
+})
@@ -0,0 +1,69 @@
+package commit
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+// We're emulating the browser by writing the opened link to a file
+
+var OpenIssueLink = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Open the issues referenced by branch names and commit messages",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupConfig: func(cfg *config.AppConfig) {
+		cfg.GetUserConfig().OS.OpenLink = "printf '%s' {{link}} > openlink"
+		cfg.GetUserConfig().IssueLinks = []config.IssueLinkConfig{
+			{Pattern: `\bPROJ-\d+\b`, URLTemplate: "https://jira.example.com/browse/{{.match}}"},
+			{Pattern: `#(?P<number>\d+)`, URLTemplate: "https://github.com/owner/repo/issues/{{.number}}"},
+		}
+	},
+	SetupRepo: func(shell *Shell) {
+		shell.EmptyCommit("one")
+		shell.NewBranch("feature/PROJ-3-thing")
+		shell.EmptyCommitWithBody("PROJ-1: two", "See also #7, and PROJ-1 again")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Branches().
+			Focus().
+			Lines(
+				Contains("feature/PROJ-3-thing").IsSelected(),
+				Contains("master"),
+			).
+			Press(keys.Branches.OpenIssueLink).
+			Tap(func() {
+				t.FileSystem().FileContent("openlink", Equals("https://jira.example.com/browse/PROJ-3"))
+			}).
+			NavigateToLine(Contains("master")).
+			Press(keys.Branches.OpenIssueLink).
+			Tap(func() {
+				t.ExpectToast(Equals("Disabled: No references to issues found"))
+			})
+
+		t.Views().Commits().
+			Focus().
+			Lines(
+				Contains("PROJ-1: two").IsSelected(),
+				Contains("one"),
+			).
+			Press(keys.Commits.OpenIssueLink).
+			Tap(func() {
+				t.ExpectPopup().Menu().
+					Title(Equals("Linked issues")).
+					Lines(
+						Equals("PROJ-1").IsSelected(),
+						Equals("#7"),
+						Contains("Cancel"),
+					).
+					Select(Equals("#7")).
+					Confirm()
+
+				t.FileSystem().FileContent("openlink", Equals("https://github.com/owner/repo/issues/7"))
+			}).
+			NavigateToLine(Contains("one")).
+			Press(keys.Commits.OpenIssueLink).
+			Tap(func() {
+				t.ExpectPopup().Alert().Title(Equals("Error")).Content(Equals("No references to issues found")).Confirm()
+			})
+	},
+})
@@ -0,0 +1,85 @@
+package commit
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+// We're emulating the hosting service's API with a local server that reports
+// the same checks for every commit, and the browser by writing the opened
+// link to a file
+
+var ViewCheckStatus = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Show the CI check status of pushed commits and branches, and view the individual checks",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupConfig: func(config *config.AppConfig) {
+		config.GetUserConfig().OS.OpenLink = "printf '%s' {{link}} > openlink"
+
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			switch {
+			case strings.HasSuffix(r.URL.Path, "/status"):
+				_, _ = w.Write([]byte(`{"statuses": []}`))
+			case strings.HasSuffix(r.URL.Path, "/check-runs"):
+				_, _ = w.Write([]byte(`{"check_runs": [
+					{"name": "build", "status": "completed", "conclusion": "success", "html_url": "https://github.com/owner/repo/runs/1"},
+					{"name": "test", "status": "completed", "conclusion": "failure", "html_url": "https://github.com/owner/repo/runs/2"}
+				]}`))
+			default:
+				w.WriteHeader(http.StatusNotFound)
+			}
+		}))
+
+		config.GetUserConfig().HostingServiceAPI.ShowCheckStatus = true
+		config.GetUserConfig().HostingServiceAPI.BaseURLs = map[string]string{"github.com": server.URL}
+	},
+	SetupRepo: func(shell *Shell) {
+		shell.EmptyCommit("one")
+		shell.EmptyCommit("two")
+		shell.CloneIntoRemote("origin")
+		shell.SetBranchUpstream("master", "origin/master")
+		shell.SetConfig("remote.origin.url", "https://github.com/owner/repo.git")
+
+		shell.NewBranch("pushed")
+		shell.SetBranchUpstream("pushed", "origin/master")
+		shell.Checkout("master")
+		shell.EmptyCommit("three")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Branches().
+			Lines(
+				Contains("master").DoesNotContain("✗"),
+				Contains("✗").Contains("pushed"),
+			)
+
+		t.Views().Commits().
+			Focus().
+			Lines(
+				Contains("three").DoesNotContain("✗").IsSelected(),
+				Contains("✗").Contains("two"),
+				Contains("✗").Contains("one"),
+			).
+			Press(keys.Commits.ViewCheckStatus).
+			Tap(func() {
+				t.ExpectToast(Equals("Disabled: CI checks are only available for commits that have been pushed to origin"))
+			}).
+			NavigateToLine(Contains("two")).
+			Press(keys.Commits.ViewCheckStatus)
+
+		t.ExpectPopup().Menu().
+			Title(Equals("CI checks")).
+			Lines(
+				Equals("✓ build").IsSelected(),
+				Equals("✗ test"),
+				Contains("Cancel"),
+			).
+			Select(Contains("test")).
+			Confirm()
+
+		t.FileSystem().FileContent("openlink", Equals("https://github.com/owner/repo/runs/2"))
+	},
+})
@@ -44,6 +44,8 @@ customCommands:
 		t.ExpectPopup().Menu().Title(Equals("Recent repositories")).
 			Lines(
 				Contains("other").IsSelected(),
+				Contains("Clone repository"),
+				Contains("Create new repository"),
 				Contains("Cancel"),
 			).Confirm()
 		t.Views().Status().Content(Contains("other → master"))
@@ -0,0 +1,66 @@
+package conflicts
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+	"github.com/jesseduffield/lazygit/pkg/integration/tests/shared"
+)
+
+var ResolveInSplitView = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Show ours, base and theirs next to each other, pick both sides in order, and edit a conflict's resolution",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupConfig: func(config *config.AppConfig) {
+		config.GetUserConfig().OS.EditAtLineAndWait = "printf 'Edited Change\\n' > {{filename}}"
+	},
+	SetupRepo: func(shell *Shell) {
+		shell.SetConfig("merge.conflictStyle", "diff3")
+		shared.CreateMergeConflictFiles(shell)
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Files().
+			IsFocused().
+			Lines(
+				Equals("▼ /").IsSelected(),
+				Equals("  UU file1"),
+				Equals("  UU file2"),
+			).
+			SelectNextItem().
+			PressEnter()
+
+		t.Views().MergeConflicts().
+			IsFocused().
+			Press(keys.Main.ToggleConflictSplitView).
+			Title(Equals("Result"))
+
+		t.Views().MergeConflictsSides().
+			IsVisible().
+			Title(Equals("Conflict")).
+			ContainsLines(
+				Contains("HEAD").Contains("second-change-branch"),
+			).
+			ContainsLines(
+				Contains("First Change").Contains("Original").Contains("Second Change"),
+			)
+
+		t.Views().MergeConflicts().
+			Press(keys.Main.PickBothHunksInOrder)
+
+		t.FileSystem().FileContent("file1", Equals("\nThis\nIs\nThe\nFirst Change\nSecond Change\nFile\n"))
+
+		t.Views().Files().
+			IsFocused().
+			Lines(
+				Equals("UU file2").IsSelected(),
+			).
+			PressEnter()
+
+		t.Views().MergeConflicts().
+			IsFocused().
+			Press(keys.Main.EditSelectHunk)
+
+		t.FileSystem().FileContent("file2", Equals("\nThis\nIs\nThe\nEdited Change\nFile\n"))
+
+		t.Common().ContinueOnConflictsResolved("merge")
+	},
+})
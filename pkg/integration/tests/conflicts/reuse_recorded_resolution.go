@@ -0,0 +1,67 @@
+package conflicts
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+	"github.com/jesseduffield/lazygit/pkg/integration/tests/shared"
+)
+
+var ReuseRecordedResolution = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Shows files that rerere resolved using a recorded resolution, and forgets the resolution",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupConfig:  func(config *config.AppConfig) {},
+	SetupRepo: func(shell *Shell) {
+		shell.SetConfig("rerere.enabled", "true")
+
+		// Resolve the conflict once so that rerere records the resolution, then
+		// undo the merge
+		shared.CreateMergeCommit(shell)
+		shell.HardReset("HEAD^")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Branches().
+			Focus().
+			NavigateToLine(Contains("second-change-branch")).
+			Press(keys.Branches.MergeIntoCurrentBranch)
+
+		t.ExpectPopup().Menu().
+			Title(Equals("Merge")).
+			Select(Contains("Regular merge (with merge commit)")).
+			Confirm()
+
+		t.ExpectToast(Equals("Reused recorded resolution for: file"))
+
+		t.Common().AcknowledgeConflicts()
+
+		t.Views().Files().
+			IsFocused().
+			Lines(
+				Equals("UU file (resolved by rerere)").IsSelected(),
+			).
+			Press(keys.Files.OpenMergeOptions)
+
+		t.ExpectPopup().Menu().
+			Title(Equals("Resolve merge conflicts")).
+			Select(Contains("Forget recorded resolution")).
+			Confirm()
+
+		t.Views().Files().
+			IsFocused().
+			Lines(
+				Equals("UU file").IsSelected(),
+			)
+
+		t.FileSystem().FileContent("file", Contains("<<<<<<< ours"))
+
+		t.Views().Files().
+			Press(keys.Files.OpenMergeOptions)
+
+		t.ExpectPopup().Menu().
+			Title(Equals("Resolve merge conflicts")).
+			Select(Contains("Forget recorded resolution")).
+			Confirm()
+
+		t.ExpectToast(Equals("Disabled: None of the selected files were resolved by rerere"))
+	},
+})
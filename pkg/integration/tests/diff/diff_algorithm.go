@@ -0,0 +1,76 @@
+package diff
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var DiffAlgorithm = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Change the diff algorithm from the diff options menu",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupConfig: func(config *config.AppConfig) {
+		config.GetUserConfig().Git.DiffAlgorithm = "myers"
+	},
+	SetupRepo: func(shell *Shell) {
+		shell.CreateFileAndAdd("myfile", "alpha\n\nbeta\n")
+		shell.Commit("initial commit")
+		shell.UpdateFile("myfile", "beta\nbeta\ngamma\nalpha\n")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		// myers keeps the first 'beta' line
+		t.Views().Main().ContainsLines(
+			Contains(`-alpha`),
+			Contains(`-`),
+			Contains(` beta`),
+			Contains(`+beta`),
+			Contains(`+gamma`),
+			Contains(`+alpha`),
+		)
+
+		t.Views().Files().
+			IsFocused().
+			Press(keys.Universal.DiffOptionsMenu)
+
+		t.ExpectPopup().Menu().
+			Title(Equals("Diff options")).
+			Select(Contains("Patience diff algorithm")).
+			Confirm()
+
+		// patience keeps the 'alpha' line because it's the only unique one
+		t.Views().Main().ContainsLines(
+			Contains(`+beta`),
+			Contains(`+beta`),
+			Contains(`+gamma`),
+			Contains(` alpha`),
+			Contains(`-`),
+			Contains(`-beta`),
+		)
+
+		t.Views().Files().
+			Press(keys.Universal.DiffOptionsMenu)
+
+		t.ExpectPopup().Menu().
+			Title(Equals("Diff options")).
+			Lines(
+				Contains("( ) Default diff algorithm"),
+				Contains("( ) Myers diff algorithm"),
+				Contains("( ) Minimal diff algorithm"),
+				Contains("(•) Patience diff algorithm"),
+				Contains("( ) Histogram diff algorithm"),
+				Contains("[ ] Detect copied files"),
+				Contains("Cancel"),
+			).
+			Select(Contains("Myers diff algorithm")).
+			Confirm()
+
+		t.Views().Main().ContainsLines(
+			Contains(`-alpha`),
+			Contains(`-`),
+			Contains(` beta`),
+			Contains(`+beta`),
+			Contains(`+gamma`),
+			Contains(`+alpha`),
+		)
+	},
+})
@@ -0,0 +1,61 @@
+package diff
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var FindCopies = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Toggle copy detection from the diff options menu",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupConfig:  func(config *config.AppConfig) {},
+	SetupRepo: func(shell *Shell) {
+		shell.CreateFileAndAdd("original", "one\ntwo\nthree\nfour\nfive\n")
+		shell.Commit("add original")
+		// git only considers files that were modified in the same commit as
+		// sources of copies
+		shell.CopyFile("original", "copy")
+		shell.UpdateFile("original", "one\ntwo\nthree\nfour\nfive\nsix\n")
+		shell.GitAddAll()
+		shell.Commit("copy original")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Commits().
+			Focus().
+			Lines(
+				Contains("copy original").IsSelected(),
+				Contains("add original"),
+			).
+			PressEnter()
+
+		t.Views().CommitFiles().
+			IsFocused().
+			Lines(
+				Equals("▼ /").IsSelected(),
+				Equals("  A copy"),
+				Equals("  M original"),
+			).
+			Press(keys.Universal.DiffOptionsMenu)
+
+		t.ExpectPopup().Menu().
+			Title(Equals("Diff options")).
+			Select(Contains("Detect copied files")).
+			Confirm()
+
+		t.Views().CommitFiles().
+			IsFocused().
+			Lines(
+				Equals("▼ /").IsSelected(),
+				Equals("  C copy"),
+				Equals("  M original"),
+			).
+			PressEscape()
+
+		t.Views().Commits().
+			IsFocused()
+
+		t.Views().Main().
+			Content(Contains("copy from original").Contains("copy to copy"))
+	},
+})
@@ -0,0 +1,72 @@
+package diff
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var RangeDiff = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Show a range-diff between the old and new version of a rewritten branch, using the reflog",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupConfig:  func(config *config.AppConfig) {},
+	SetupRepo: func(shell *Shell) {
+		shell.CreateFileAndAdd("file1", "one\n").
+			Commit("one").
+			CreateFileAndAdd("file2", "a\nb\nc\nd\ne\nf\ng\nh\ni\nj\n").
+			Commit("two").
+			UpdateFileAndAdd("file2", "a\nb\nc\nd\ne\nf\ng\nh\ni\nJ\n").
+			RunCommand([]string{"git", "commit", "--amend", "-m", "two amended"}).
+			CreateFileAndAdd("file3", "three\n").
+			Commit("three").
+			// So that there's enough below the second pair to scroll it to the top
+			CreateFileAndAdd("file4", "four\n").
+			Commit("four")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().ReflogCommits().
+			Focus().
+			NavigateToLine(Contains("commit: two")).
+			Press(keys.Universal.DiffingMenu)
+
+		t.ExpectPopup().Menu().Title(Equals("Diffing")).Select(Contains("Range-diff against")).Confirm()
+
+		t.Views().Information().Content(Contains("Showing output for: git range-diff"))
+
+		t.Views().Branches().
+			Focus().
+			SelectedLine(Contains("master"))
+
+		t.Views().Information().Content(MatchesRegexp(`git range-diff [0-9a-f]+\.\.\.master`))
+
+		t.Views().Main().
+			Title(Equals("Range-diff")).
+			Content(MatchesRegexp(`1:  [0-9a-f]+ ! 1:  [0-9a-f]+ two\n`)).
+			Content(MatchesRegexp(`-:  -+ > 2:  [0-9a-f]+ three`)).
+			Content(Contains("-    two").Contains("+    two amended")).
+			Content(Contains("-+j").Contains("++J"))
+
+		t.Views().Branches().
+			Press(keys.Universal.FocusMainView)
+
+		t.Views().Main().
+			IsFocused().
+			FirstVisibleLine(Contains("Showing output for: git range-diff")).
+			Press(keys.Main.NextRangeDiffPair).
+			FirstVisibleLine(MatchesRegexp(`1:  [0-9a-f]+ ! 1:  [0-9a-f]+ two$`)).
+			Press(keys.Main.NextRangeDiffPair).
+			FirstVisibleLine(MatchesRegexp(`-:  -+ > 2:  [0-9a-f]+ three$`)).
+			Press(keys.Main.PrevRangeDiffPair).
+			FirstVisibleLine(MatchesRegexp(`1:  [0-9a-f]+ ! 1:  [0-9a-f]+ two$`)).
+			PressEscape()
+
+		t.Views().Branches().
+			IsFocused().
+			Press(keys.Universal.DiffingMenu)
+
+		t.ExpectPopup().Menu().Title(Equals("Diffing")).Select(Contains("Show as regular diff")).Confirm()
+
+		t.Views().Information().Content(MatchesRegexp(`git diff --stat -p [0-9a-f]+ master`))
+		t.Views().Main().Content(Contains("+J"))
+	},
+})
@@ -0,0 +1,93 @@
+package diff
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var SideBySide = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Toggle the side-by-side diff from the diffing menu, and stage a row of aligned lines from the side-by-side staging view",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupConfig: func(config *config.AppConfig) {
+		config.GetUserConfig().Gui.UseHunkModeInStagingView = false
+	},
+	SetupRepo: func(shell *Shell) {
+		shell.CreateFileAndAdd("myfile", "first line\nhello world\nthird line\n")
+		shell.Commit("initial commit")
+		shell.UpdateFile("myfile", "first line\nhello earth\nthird line\nfourth line\n")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Files().
+			IsFocused().
+			Press(keys.Universal.DiffingMenu)
+
+		t.ExpectPopup().Menu().
+			Title(Equals("Diffing")).
+			Select(Contains("Show side-by-side diff")).
+			Confirm()
+
+		// the replaced line is shown next to the line replacing it
+		t.Views().Main().
+			Title(Equals("Unstaged changes (old)")).
+			ContainsLines(
+				Equals(" first line"),
+				Equals("-hello world"),
+				Equals(" third line"),
+			)
+
+		t.Views().Secondary().
+			Title(Equals("Unstaged changes (new)")).
+			ContainsLines(
+				Equals(" first line"),
+				Equals("+hello earth"),
+				Equals(" third line"),
+				Equals("+fourth line"),
+			)
+
+		// the staging view aligns the lines in the same way; selecting a row
+		// selects the lines on both sides of it
+		t.Views().Files().
+			PressEnter()
+
+		t.Views().Staging().
+			IsFocused().
+			ContainsLines(
+				Contains(" first line").Contains("│ first line"),
+				Contains("-hello world").Contains("│+hello earth"),
+				Contains(" third line").Contains("│ third line"),
+				Contains("│+fourth line"),
+			).
+			SelectedLine(Contains("-hello world").Contains("│+hello earth")).
+			PressPrimaryAction().
+			ContainsLines(
+				Contains(" hello earth").Contains("│ hello earth"),
+				Contains(" third line").Contains("│ third line"),
+				Contains("│+fourth line"),
+			).
+			Content(DoesNotContain("hello world"))
+
+		t.Views().StagingSecondary().
+			ContainsLines(
+				Contains(" first line").Contains("│ first line"),
+				Contains("-hello world").Contains("│+hello earth"),
+				Contains(" third line").Contains("│ third line"),
+			)
+
+		t.Views().Staging().
+			Press(keys.Universal.DiffingMenu)
+
+		t.ExpectPopup().Menu().
+			Title(Equals("Diffing")).
+			Select(Contains("Show unified diff")).
+			Confirm()
+
+		t.Views().Staging().
+			IsFocused().
+			ContainsLines(
+				Equals(" hello earth"),
+				Equals(" third line"),
+				Equals("+fourth line"),
+			)
+	},
+})
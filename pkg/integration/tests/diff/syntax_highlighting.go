@@ -0,0 +1,61 @@
+package diff
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var SyntaxHighlighting = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Toggle syntax highlighting from the diffing menu, in the main view and in the staging view",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupConfig: func(config *config.AppConfig) {
+		config.GetUserConfig().Gui.Theme.SyntaxKeywordColor = []string{"#ff00ff"}
+	},
+	SetupRepo: func(shell *Shell) {
+		shell.CreateFileAndAdd("main.go", "package main\n\nfunc main() {\n}\n")
+		shell.Commit("initial commit")
+		shell.UpdateFile("main.go", "package main\n\nfunc main() {\n\treturn\n}\n")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		keywordColor := "#ff00ff"
+
+		t.Views().Main().
+			ContainsLines(
+				Contains("+").Contains("return"),
+			).
+			DoesNotContainColoredText(keywordColor, "return")
+
+		t.Views().Files().
+			IsFocused().
+			Press(keys.Universal.DiffingMenu)
+
+		t.ExpectPopup().Menu().
+			Title(Equals("Diffing")).
+			Select(Contains("Highlight syntax")).
+			Confirm()
+
+		t.Views().Main().
+			ContainsColoredText(keywordColor, "return").
+			ContainsColoredText(keywordColor, "func")
+
+		t.Views().Files().
+			PressEnter()
+
+		t.Views().Staging().
+			IsFocused().
+			ContainsLines(
+				Contains("+").Contains("return"),
+			).
+			ContainsColoredText(keywordColor, "return").
+			Press(keys.Universal.DiffingMenu)
+
+		t.ExpectPopup().Menu().
+			Title(Equals("Diffing")).
+			Select(Contains("Stop highlighting syntax")).
+			Confirm()
+
+		t.Views().Staging().
+			DoesNotContainColoredText(keywordColor, "return")
+	},
+})
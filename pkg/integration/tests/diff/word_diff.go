@@ -0,0 +1,85 @@
+package diff
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var WordDiff = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Toggle word diff from the diffing menu, in the main view and in the staging view",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupConfig: func(config *config.AppConfig) {
+		config.GetUserConfig().Gui.UseHunkModeInStagingView = false
+	},
+	SetupRepo: func(shell *Shell) {
+		shell.CreateFileAndAdd("myfile", "first line\nhello world\nthird line\n")
+		shell.Commit("initial commit")
+		shell.UpdateFile("myfile", "first line\nhello earth\nthird line\n")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Main().ContainsLines(
+			Contains(`-hello world`),
+			Contains(`+hello earth`),
+		)
+
+		t.Views().Files().
+			IsFocused().
+			Press(keys.Universal.DiffingMenu)
+
+		t.ExpectPopup().Menu().
+			Title(Equals("Diffing")).
+			Select(Contains("Highlight changed words")).
+			Confirm()
+
+		// git shows the old and the new words next to each other on one line
+		t.Views().Main().ContainsLines(
+			Contains(`first line`),
+			Contains(`hello worldearth`),
+			Contains(`third line`),
+		)
+
+		// the staging view highlights the words itself, so the lines stay
+		// selectable one by one
+		t.Views().Files().
+			PressEnter()
+
+		t.Views().Staging().
+			IsFocused().
+			ContainsLines(
+				Equals("-hello world"),
+				Equals("+hello earth"),
+			).
+			SelectedLine(Equals("-hello world")).
+			PressPrimaryAction().
+			ContainsLines(
+				Equals("+hello earth"),
+			)
+
+		t.Views().StagingSecondary().
+			ContainsLines(
+				Equals("-hello world"),
+			)
+
+		t.Views().Staging().
+			Press(keys.Universal.DiffingMenu)
+
+		t.ExpectPopup().Menu().
+			Title(Equals("Diffing")).
+			Select(Contains("Stop highlighting changed words")).
+			Confirm()
+
+		t.Views().Staging().
+			IsFocused().
+			PressEscape()
+
+		t.Views().Files().
+			IsFocused().
+			Press(keys.Universal.DiffingMenu)
+
+		t.ExpectPopup().Menu().
+			Title(Equals("Diffing")).
+			Select(Contains("Highlight changed words")).
+			Cancel()
+	},
+})
@@ -0,0 +1,82 @@
+package file
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var Blame = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Blame a file, re-blame it at a parent commit, and jump to the commit that changed a line",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupConfig:  func(config *config.AppConfig) {},
+	SetupRepo: func(shell *Shell) {
+		shell.CreateFileAndAdd("file", "one\ntwo\nthree\n").
+			Commit("first commit").
+			UpdateFileAndAdd("file", "one\nTWO\nthree\n").
+			Commit("second commit").
+			EmptyCommit("unrelated commit").
+			UpdateFile("file", "one\nTWO\nthree\nfour\n")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Files().
+			IsFocused().
+			Lines(
+				Contains("file").IsSelected(),
+			).
+			Press(keys.Files.OpenBlame)
+
+		t.Views().Blame().
+			IsFocused().
+			Title(Equals("Blame (file)")).
+			Lines(
+				Contains("1 one").IsSelected(),
+				Contains("2 TWO"),
+				Contains("3 three"),
+				Contains("Not committed yet").Contains("4 four"),
+			).
+			NavigateToLine(Contains("TWO")).
+			Press(keys.Main.BlameAtParent).
+			Title(Contains("Blame (file @ ")).
+			Lines(
+				Contains("1 one"),
+				Contains("2 two").IsSelected(),
+				Contains("3 three"),
+			).
+			Tap(func() {
+				// The second line was introduced by the root commit
+				t.Views().Blame().
+					Press(keys.Main.BlameAtParent)
+
+				t.ExpectToast(Contains("Disabled: The selected line was introduced by a root commit"))
+			}).
+			PressEscape().
+			Title(Equals("Blame (file)")).
+			Lines(
+				Contains("1 one"),
+				Contains("2 TWO").IsSelected(),
+				Contains("3 three"),
+				Contains("4 four"),
+			).
+			PressEnter()
+
+		t.Views().Commits().
+			IsFocused().
+			Lines(
+				Contains("unrelated commit"),
+				Contains("second commit").IsSelected(),
+				Contains("first commit"),
+			)
+
+		t.Views().Files().
+			Focus().
+			Press(keys.Files.OpenBlame)
+
+		t.Views().Blame().
+			IsFocused().
+			PressEscape()
+
+		t.Views().Files().
+			IsFocused()
+	},
+})
@@ -0,0 +1,43 @@
+package filter_by_content
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var Message = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Filter commits by a pattern in their commit message",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupConfig:  func(config *config.AppConfig) {},
+	SetupRepo: func(shell *Shell) {
+		shell.EmptyCommit("feat: one").
+			EmptyCommit("fix: two").
+			EmptyCommit("feat: three").
+			EmptyCommit("fix: four")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Status().
+			Focus().
+			Press(keys.Universal.FilteringMenu)
+
+		t.ExpectPopup().Menu().
+			Title(Equals("Filtering")).
+			Select(Contains("Enter pattern to search for in commit messages (--grep)")).
+			Confirm()
+
+		t.ExpectPopup().Prompt().
+			Title(Equals("Enter pattern:")).
+			Type("^fix").
+			Confirm()
+
+		t.Views().Commits().
+			IsFocused().
+			Lines(
+				Contains("fix: four").IsSelected(),
+				Contains("fix: two"),
+			)
+
+		t.Views().Information().Content(Contains("Filtering by message '^fix'"))
+	},
+})
@@ -0,0 +1,56 @@
+package filter_by_content
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var MessageWhileRebasing = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Filter commits by a pattern in their commit message while rebasing, which hides the rebase todos",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupConfig:  func(config *config.AppConfig) {},
+	SetupRepo: func(shell *Shell) {
+		shell.EmptyCommit("feat: one").
+			EmptyCommit("fix: two").
+			EmptyCommit("feat: three").
+			EmptyCommit("fix: four")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Commits().
+			Focus().
+			NavigateToLine(Contains("fix: two")).
+			Press(keys.Universal.Edit).
+			Lines(
+				Contains("--- Pending rebase todos ---"),
+				Contains("fix: four"),
+				Contains("feat: three"),
+				Contains("--- Commits ---"),
+				Contains("fix: two").IsSelected(),
+				Contains("feat: one"),
+			).
+			Press(keys.Universal.FilteringMenu)
+
+		t.ExpectPopup().Menu().
+			Title(Equals("Filtering")).
+			Select(Contains("Enter pattern to search for in commit messages (--grep)")).
+			Confirm()
+
+		t.ExpectPopup().Prompt().
+			Title(Equals("Enter pattern:")).
+			Type("^fix").
+			Confirm()
+
+		// The todos are not filtered by git log, so they are left out
+		// altogether, along with their section header; "fix: four" is not
+		// shown even though it matches
+		t.Views().Commits().
+			IsFocused().
+			Lines(
+				Contains("--- Commits ---"),
+				Contains("fix: two").IsSelected(),
+			)
+
+		t.Views().Information().Content(Contains("Filtering by message '^fix'"))
+	},
+})
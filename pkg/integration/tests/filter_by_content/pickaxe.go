@@ -0,0 +1,100 @@
+package filter_by_content
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var Pickaxe = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Filter commits by a string or regex occurring in their diff, and keep the filter across refreshes",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupConfig:  func(config *config.AppConfig) {},
+	SetupRepo: func(shell *Shell) {
+		shell.CreateFileAndAdd("file", "foo()\n").
+			Commit("add foo").
+			UpdateFileAndAdd("file", "foo()\nbar()\n").
+			Commit("add bar").
+			UpdateFileAndAdd("file", "foo()\nbar()\nbaz()\n").
+			Commit("add baz").
+			UpdateFileAndAdd("file", "bar()\nbaz()\n").
+			Commit("remove foo")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Status().
+			Focus().
+			Press(keys.Universal.FilteringMenu)
+
+		t.ExpectPopup().Menu().
+			Title(Equals("Filtering")).
+			Select(Contains("Enter string to search for in diffs (-S)")).
+			Confirm()
+
+		t.ExpectPopup().Prompt().
+			Title(Equals("Enter string:")).
+			Type("foo()").
+			Confirm()
+
+		t.Views().Commits().
+			IsFocused().
+			Lines(
+				Contains("remove foo").IsSelected(),
+				Contains("add foo"),
+			)
+
+		t.Views().Information().Content(Contains("Filtering by content 'foo()'"))
+
+		t.Views().Files().
+			Focus().
+			Press(keys.Universal.Refresh)
+
+		t.Views().Commits().
+			Focus().
+			Lines(
+				Contains("remove foo").IsSelected(),
+				Contains("add foo"),
+			)
+
+		t.Views().Information().Content(Contains("Filtering by content 'foo()'"))
+
+		t.Views().Status().
+			Focus().
+			Press(keys.Universal.FilteringMenu)
+
+		t.ExpectPopup().Menu().
+			Title(Equals("Filtering")).
+			Select(Contains("Enter regex to search for in diffs (-G)")).
+			Confirm()
+
+		t.ExpectPopup().Prompt().
+			Title(Equals("Enter regex:")).
+			Type("ba[rz]").
+			Confirm()
+
+		t.Views().Commits().
+			IsFocused().
+			Lines(
+				Contains("add baz").IsSelected(),
+				Contains("add bar"),
+			)
+
+		t.Views().Information().Content(Contains("Filtering by content regex 'ba[rz]'"))
+
+		t.Views().Status().
+			Focus().
+			Press(keys.Universal.FilteringMenu)
+
+		t.ExpectPopup().Menu().
+			Title(Equals("Filtering")).
+			Select(Contains("Stop filtering")).
+			Confirm()
+
+		t.Views().Commits().
+			Lines(
+				Contains("remove foo"),
+				Contains("add baz"),
+				Contains("add bar"),
+				Contains("add foo"),
+			)
+	},
+})
@@ -0,0 +1,89 @@
+package interactive_rebase
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var SplitCommit = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Split a commit into two commits, continuing the rebase automatically once all changes are committed",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupConfig:  func(config *config.AppConfig) {},
+	SetupRepo: func(shell *Shell) {
+		shell.CreateFileAndAdd("file1", "one\n")
+		shell.Commit("first commit")
+		shell.UpdateFileAndAdd("file1", "one\ntwo\n")
+		shell.CreateFileAndAdd("file2", "file2 content\n")
+		shell.Commit("commit to split")
+		shell.CreateFileAndAdd("file3", "file3 content\n")
+		shell.Commit("last commit")
+		// Files that the commit didn't touch don't keep us from continuing
+		shell.CreateFile("untracked", "untracked content\n")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Commits().
+			Focus().
+			Lines(
+				Contains("last commit").IsSelected(),
+				Contains("commit to split"),
+				Contains("first commit"),
+			).
+			NavigateToLine(Contains("commit to split")).
+			Press(keys.Commits.SplitCommit)
+
+		t.ExpectToast(Contains("Stage and commit the changes"))
+
+		t.Views().Staging().
+			IsFocused().
+			SelectedLines(
+				Contains("+two"),
+			).
+			PressPrimaryAction()
+
+		t.Views().StagingSecondary().
+			IsFocused().
+			Press(keys.Files.CommitChanges)
+
+		t.ExpectPopup().CommitMessagePanel().
+			InitialText(Equals("commit to split")).
+			Clear().
+			Type("add two").
+			Confirm()
+
+		t.Views().Information().Content(Contains("Splitting commit"))
+
+		t.Views().Staging().
+			IsFocused().
+			SelectedLines(
+				Contains("+file2 content"),
+			).
+			PressPrimaryAction()
+
+		t.Views().StagingSecondary().
+			IsFocused().
+			Press(keys.Files.CommitChanges)
+
+		t.ExpectPopup().CommitMessagePanel().
+			InitialText(Equals("")).
+			Type("add file2").
+			Confirm()
+
+		t.ExpectToast(Equals("Finished splitting commit"))
+
+		t.Views().Information().Content(DoesNotContain("Splitting commit"))
+
+		t.Views().Commits().
+			Lines(
+				Contains("last commit"),
+				Contains("add file2"),
+				Contains("add two"),
+				Contains("first commit"),
+			)
+
+		t.Views().Files().
+			Lines(
+				Contains("?? untracked"),
+			)
+	},
+})
@@ -0,0 +1,88 @@
+package lfs
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+const pointer = `version https://git-lfs.github.com/spec/v1
+oid sha256:4d7a214614ab2935c943f9e0ff69d22eadbb8f32b1258daaa5e2ca24d17e2393
+size 12345
+`
+
+var ShowLfsFile = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Show a summary instead of the pointer diff for files tracked by LFS, and offer lock options for them, including showing their locks on demand",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupConfig:  func(config *config.AppConfig) {},
+	SetupRepo: func(shell *Shell) {
+		// We don't rely on git-lfs being installed, so we commit the pointer
+		// file ourselves
+		shell.CreateFileAndAdd(".gitattributes", "*.bin filter=lfs diff=lfs merge=lfs -text\n").
+			CreateFileAndAdd("image.bin", pointer).
+			CreateFileAndAdd("notes.txt", "notes\n").
+			// LFS paths can also be configured in nested .gitattributes files
+			CreateFileAndAdd("assets/.gitattributes", "*.dat filter=lfs diff=lfs merge=lfs -text\n").
+			CreateFileAndAdd("assets/model.dat", pointer).
+			Commit("initial commit").
+			UpdateFile("image.bin", "new content\n").
+			UpdateFile("notes.txt", "more notes\n").
+			UpdateFile("assets/model.dat", "new model\n")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Files().
+			IsFocused().
+			Lines(
+				Equals("▼ /").IsSelected(),
+				Equals("  ▼ assets"),
+				Equals("     M model.dat (LFS)"),
+				Equals("   M image.bin (LFS)"),
+				Equals("   M notes.txt"),
+			).
+			NavigateToLine(Contains("image.bin"))
+
+		t.Views().Main().
+			Title(Equals("Git LFS file")).
+			Content(
+				Contains("'image.bin' is tracked by Git LFS").
+					Contains("Committed    sha256:4d7a214614ab2935c943f9e0ff69d22eadbb8f32b1258daaa5e2ca24d17e2393 (12.1 KiB)").
+					Contains("Working tree 12 B").
+					// Asking the LFS server for the locks is only done on demand
+					Contains("to see who has locked the file").
+					DoesNotContain("Locks:").
+					DoesNotContain("+new content"),
+			)
+
+		t.Views().Files().
+			Press(keys.Files.ViewLfsLockOptions)
+
+		t.ExpectPopup().Menu().
+			Title(Equals("LFS lock")).
+			Lines(
+				Contains("Show locks"),
+				Contains("Lock file"),
+				Contains("Unlock file"),
+				Contains("Force unlock file"),
+				Contains("Cancel"),
+			).
+			Select(Contains("Show locks")).
+			Confirm()
+
+		t.Views().Main().
+			Content(
+				Contains("'image.bin' is tracked by Git LFS").
+					Contains("Locks:"),
+			)
+
+		t.Views().Files().
+			NavigateToLine(Contains("notes.txt"))
+
+		t.Views().Main().
+			Content(Contains("+more notes"))
+
+		t.Views().Files().
+			Press(keys.Files.ViewLfsLockOptions)
+
+		t.ExpectToast(Equals("Disabled: The selected file is not tracked by Git LFS"))
+	},
+})
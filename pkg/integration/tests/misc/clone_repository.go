@@ -0,0 +1,46 @@
+package misc
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var CloneRepository = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Clone a repository from the recent repos menu and switch to it",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupConfig:  func(config *config.AppConfig) {},
+	SetupRepo: func(shell *Shell) {
+		shell.CreateFileAndAdd("file.txt", "content")
+		shell.Commit("initial commit")
+		shell.Clone("origin")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.GlobalPress(keys.Universal.OpenRecentRepos)
+
+		t.ExpectPopup().Menu().
+			Title(Equals("Recent repositories")).
+			Select(Contains("Clone repository")).
+			Confirm()
+
+		t.ExpectPopup().Prompt().
+			Title(Equals("Repository URL:")).
+			Type("../origin").
+			Confirm()
+
+		t.ExpectPopup().Prompt().
+			Title(Equals("Clone into directory:")).
+			Clear().
+			Type("../cloned").
+			Confirm()
+
+		t.Views().Status().Content(Contains("cloned → master"))
+
+		t.Views().Commits().
+			Lines(
+				Contains("initial commit"),
+			)
+
+		t.FileSystem().PathPresent("../cloned/file.txt")
+	},
+})
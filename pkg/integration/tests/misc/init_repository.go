@@ -0,0 +1,34 @@
+package misc
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var InitRepository = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Create a new repository from the recent repos menu and switch to it",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupConfig:  func(config *config.AppConfig) {},
+	SetupRepo: func(shell *Shell) {
+		shell.EmptyCommit("initial commit")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.GlobalPress(keys.Universal.OpenRecentRepos)
+
+		t.ExpectPopup().Menu().
+			Title(Equals("Recent repositories")).
+			Select(Contains("Create new repository")).
+			Confirm()
+
+		t.ExpectPopup().Prompt().
+			Title(Equals("Create repository in directory:")).
+			Clear().
+			Type("../new-repo").
+			Confirm()
+
+		t.Views().Status().Content(Contains("new-repo → master"))
+
+		t.Views().Commits().IsEmpty()
+	},
+})
@@ -0,0 +1,121 @@
+package sparse_checkout
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var SparseCheckout = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Manage the directories of a cone mode sparse checkout from the status and files panels",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	GitVersion:   AtLeast("2.35.0"),
+	SetupConfig:  func(config *config.AppConfig) {},
+	SetupRepo: func(shell *Shell) {
+		shell.CreateFileAndAdd("root-file", "root").
+			CreateFileAndAdd("dir1/file", "one").
+			CreateFileAndAdd("dir2/file", "two").
+			CreateFileAndAdd("dir3/file", "three").
+			Commit("initial commit").
+			RunCommand([]string{"git", "sparse-checkout", "set", "--cone", "dir1"}).
+			CreateFile("dir1/sub/untracked", "untracked").
+			CreateFile("dir3/untracked", "untracked")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Files().
+			IsFocused().
+			Lines(
+				Equals("▼ /"),
+				Equals("  ▼ dir1/sub"),
+				Equals("    ?? untracked"),
+				Equals("  ▼ dir3 (outside sparse checkout)"),
+				Equals("    ?? untracked (outside sparse checkout)"),
+			).
+			NavigateToLine(Contains("dir1/sub")).
+			Press(keys.Files.ToggleSparseCheckoutDir)
+
+		t.ExpectToast(Equals("Disabled: 'dir1/sub' is part of the sparse checkout as a subdirectory of 'dir1'; remove 'dir1' instead"))
+
+		t.Views().Status().
+			Focus()
+
+		t.Views().Main().
+			Content(Contains("Sparse checkout (cone mode) directories:\n  dir1"))
+
+		t.Views().Status().
+			Press(keys.Status.SparseCheckoutOptions)
+
+		t.ExpectPopup().Menu().
+			Title(Equals("Sparse checkout")).
+			Select(Contains("Add directory")).
+			Confirm()
+
+		t.ExpectPopup().Prompt().
+			Title(Equals("Directory to add to the sparse checkout:")).
+			Type("dir2").
+			Confirm()
+
+		t.Views().Main().
+			Content(Contains("  dir1\n  dir2"))
+
+		t.FileSystem().PathPresent("dir2/file")
+
+		t.Views().Files().
+			Focus().
+			NavigateToLine(Contains("untracked (outside sparse checkout)")).
+			Press(keys.Files.ToggleSparseCheckoutDir).
+			Lines(
+				Equals("▼ /"),
+				Equals("  ▼ dir1/sub"),
+				Equals("    ?? untracked"),
+				Equals("  ▼ dir3"),
+				Equals("    ?? untracked").IsSelected(),
+			)
+
+		t.FileSystem().PathPresent("dir3/file")
+
+		t.Views().Status().
+			Focus().
+			Press(keys.Status.SparseCheckoutOptions)
+
+		t.ExpectPopup().Menu().
+			Title(Equals("Sparse checkout")).
+			Select(Contains("Remove directory")).
+			Confirm()
+
+		t.ExpectPopup().Menu().
+			Title(Equals("Remove directory")).
+			Lines(
+				Contains("dir1"),
+				Contains("dir2"),
+				Contains("dir3"),
+				Contains("Cancel"),
+			).
+			Select(Contains("dir1")).
+			Confirm()
+
+		t.Views().Main().
+			Content(Contains("  dir2\n  dir3").DoesNotContain("dir1"))
+
+		t.FileSystem().PathNotPresent("dir1/file")
+
+		t.Views().Status().
+			Press(keys.Status.SparseCheckoutOptions)
+
+		t.ExpectPopup().Menu().
+			Title(Equals("Sparse checkout")).
+			Select(Contains("Disable sparse checkout")).
+			Confirm()
+
+		t.Views().Main().
+			Content(DoesNotContain("Sparse checkout"))
+
+		t.FileSystem().PathPresent("dir1/file")
+
+		t.Views().Files().
+			Focus().
+			Press(keys.Files.ToggleSparseCheckoutDir)
+
+		t.ExpectToast(Equals("Disabled: The working tree is not a sparse checkout in cone mode"))
+	},
+})
@@ -0,0 +1,140 @@
+package staging
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var ReviewHunks = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Go through the unstaged hunks of all files one at a time, staging, skipping, and splitting them, and only visiting the hunks with an added or removed line matching a filter",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupConfig:  func(config *config.AppConfig) {},
+	SetupRepo: func(shell *Shell) {
+		shell.CreateFileAndAdd("file1", "1a\n2a\n3a\n4a\n5a\n6a\n7a\n8a\n")
+		shell.CreateFileAndAdd("file2", "x1\nprint(debug)\nx3\nx4\nx5\nx6\nx7\nx8\nx9\nx10\nx11\nx12\nx13\nx14\n")
+		shell.CreateFileAndAdd("file3", "foo\n")
+		shell.Commit("one")
+
+		// file1 has one hunk with two blocks of changes, file2 has two hunks
+		shell.UpdateFile("file1", "1a\n2a\n3b\n4a\n5a\n6b\n7a\n8a\n")
+		shell.UpdateFile("file2", "x1\nx3\nx4\nx5\nx6\nx7\nx8\nx9\nx10\nx11\nx12\ny13\nx14\n")
+		shell.UpdateFile("file3", "bar\n")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Files().
+			IsFocused().
+			Press(keys.Files.ReviewHunks)
+
+		t.ExpectPopup().Menu().
+			Title(Equals("Review hunks")).
+			Select(Contains("All hunks")).
+			Confirm()
+
+		t.Views().Staging().
+			IsFocused().
+			Title(Contains("hunk 1 of 4, file 1 of 3")).
+			SelectedLines(
+				Contains("-3a"),
+				Contains("+3b"),
+				Contains("4a"),
+				Contains("5a"),
+				Contains("-6a"),
+				Contains("+6b"),
+			).
+			Press(keys.Main.SplitHunk).
+			Title(Contains("hunk 1 of 5, file 1 of 3")).
+			SelectedLines(
+				Contains("-3a"),
+				Contains("+3b"),
+			).
+			PressPrimaryAction().
+			Title(Contains("hunk 2 of 5, file 1 of 3")).
+			SelectedLines(
+				Contains("-6a"),
+				Contains("+6b"),
+			).
+			Press(keys.Main.SkipHunk).
+			Title(Contains("hunk 3 of 5, file 2 of 3")).
+			SelectedLines(
+				Contains("-print(debug)"),
+			).
+			Tap(func() {
+				t.Views().Files().
+					SelectedLines(Contains("file2"))
+			}).
+			Press(keys.Main.SkipHunk).
+			Title(Contains("hunk 4 of 5, file 2 of 3")).
+			SelectedLines(
+				Contains("-x13"),
+				Contains("+y13"),
+			).
+			PressPrimaryAction().
+			Title(Contains("hunk 5 of 5, file 3 of 3")).
+			SelectedLines(
+				Contains("-foo"),
+				Contains("+bar"),
+			).
+			Press(keys.Universal.Return)
+
+		t.Views().Files().
+			IsFocused().
+			Lines(
+				Equals("▼ /"),
+				Equals("  MM file1"),
+				Equals("  MM file2"),
+				Equals("   M file3").IsSelected(),
+			).
+			Press(keys.Files.ReviewHunks)
+
+		// The change prefix isn't part of what's matched, so the pattern can
+		// be anchored; which kind of line it applies to is chosen separately
+		t.ExpectPopup().Menu().
+			Title(Equals("Review hunks")).
+			Select(Contains("Only hunks with an added line matching a regex")).
+			Confirm()
+
+		t.ExpectPopup().Prompt().
+			Title(Contains("Regex to match the lines against")).
+			Type(`^print\(`).
+			Confirm()
+
+		t.ExpectPopup().Alert().
+			Title(Equals("Error")).
+			Content(Equals("There are no unstaged hunks to review")).
+			Confirm()
+
+		t.Views().Files().
+			IsFocused().
+			Press(keys.Files.ReviewHunks)
+
+		t.ExpectPopup().Menu().
+			Title(Equals("Review hunks")).
+			Select(Contains("Only hunks with a removed line matching a regex")).
+			Confirm()
+
+		t.ExpectPopup().Prompt().
+			Title(Contains("Regex to match the lines against")).
+			Type(`^print\(`).
+			Confirm()
+
+		t.Views().Staging().
+			IsFocused().
+			Title(Contains("hunk 1 of 1, file 1 of 1")).
+			SelectedLines(
+				Contains("-print(debug)"),
+			).
+			PressPrimaryAction()
+
+		t.ExpectToast(Equals("Reviewed all hunks"))
+
+		t.Views().Files().
+			IsFocused().
+			Lines(
+				Equals("▼ /"),
+				Equals("  MM file1"),
+				Equals("  M  file2").IsSelected(),
+				Equals("   M file3"),
+			)
+	},
+})
@@ -0,0 +1,83 @@
+package staging
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var ViewLineHistory = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Filter the commits by the selected lines, from the staging view and from the patch building view",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupConfig: func(config *config.AppConfig) {
+		config.GetUserConfig().Gui.UseHunkModeInStagingView = false
+	},
+	SetupRepo: func(shell *Shell) {
+		shell.CreateFileAndAdd("file", "one\ntwo\nthree\nfour\nfive\nsix\n").
+			Commit("add file").
+			UpdateFileAndAdd("file", "one\nTWO\nthree\nfour\nfive\nsix\n").
+			Commit("change line two").
+			UpdateFileAndAdd("file", "one\nTWO\nthree\nfour\nFIVE\nsix\n").
+			Commit("change line five").
+			UpdateFileAndAdd("file", "zero\none\nTWO\nthree\nfour\nFIVE\nsix\n").
+			Commit("add line zero").
+			// A staged change shifts the lines of the index relative to HEAD
+			UpdateFileAndAdd("file", "staged\nzero\none\nTWO\nthree\nfour\nFIVE\nsix\n").
+			UpdateFile("file", "staged\nzero\none\n2\nthree\nfour\nFIVE\nsix\n")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Files().
+			IsFocused().
+			Lines(
+				Contains("file").IsSelected(),
+			).
+			PressEnter()
+
+		t.Views().Staging().
+			IsFocused().
+			SelectedLines(
+				Contains("-TWO"),
+			).
+			Press(keys.Main.ViewLineHistory)
+
+		t.Views().Information().Content(Contains("Filtering by 'file:3-3'"))
+
+		t.Views().Commits().
+			IsFocused().
+			Lines(
+				Contains("change line two").IsSelected(),
+				Contains("add file"),
+			).
+			PressEscape()
+
+		t.Views().Information().Content(DoesNotContain("Filtering by"))
+
+		t.Views().Commits().
+			IsFocused().
+			NavigateToLine(Contains("change line five")).
+			PressEnter()
+
+		t.Views().CommitFiles().
+			IsFocused().
+			Lines(
+				Contains("file").IsSelected(),
+			).
+			PressEnter()
+
+		t.Views().PatchBuilding().
+			IsFocused().
+			SelectedLines(
+				Contains("-five"),
+			).
+			Press(keys.Main.ViewLineHistory)
+
+		t.Views().Information().Content(Contains("Filtering by 'file:6-6'"))
+
+		t.Views().Commits().
+			IsFocused().
+			Lines(
+				Contains("change line five").IsSelected(),
+				Contains("add file"),
+			)
+	},
+})
@@ -0,0 +1,54 @@
+package staging
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var ViewLineHistoryOfRenamedFile = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Filter the commits by the selected lines of a commit's patch, for a file that has been renamed since",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupConfig: func(config *config.AppConfig) {
+		config.GetUserConfig().Gui.UseHunkModeInStagingView = false
+	},
+	SetupRepo: func(shell *Shell) {
+		shell.CreateFileAndAdd("old-name", "one\ntwo\nthree\nfour\nfive\nsix\n").
+			Commit("add file").
+			UpdateFileAndAdd("old-name", "one\ntwo\nthree\nfour\nFIVE\nsix\n").
+			Commit("change line five").
+			RenameFileInGit("old-name", "new-name").
+			Commit("rename file").
+			UpdateFileAndAdd("new-name", "zero\none\ntwo\nthree\nfour\nFIVE\nsix\n").
+			Commit("add line zero")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Commits().
+			Focus().
+			NavigateToLine(Contains("change line five")).
+			PressEnter()
+
+		t.Views().CommitFiles().
+			IsFocused().
+			Lines(
+				Contains("old-name").IsSelected(),
+			).
+			PressEnter()
+
+		t.Views().PatchBuilding().
+			IsFocused().
+			SelectedLines(
+				Contains("-five"),
+			).
+			Press(keys.Main.ViewLineHistory)
+
+		t.Views().Information().Content(Contains("Filtering by 'new-name:6-6'"))
+
+		t.Views().Commits().
+			IsFocused().
+			Lines(
+				Contains("change line five").IsSelected(),
+				Contains("add file"),
+			)
+	},
+})
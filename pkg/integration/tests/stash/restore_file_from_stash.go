@@ -0,0 +1,72 @@
+package stash
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var RestoreFileFromStash = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Restore a single file from a stash entry into the working tree",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupConfig:  func(config *config.AppConfig) {},
+	SetupRepo: func(shell *Shell) {
+		shell.CreateFileAndAdd("file-a", "a")
+		shell.CreateFileAndAdd("file-b", "b")
+		shell.Commit("initial commit")
+		shell.UpdateFile("file-a", "stashed a")
+		shell.UpdateFile("file-b", "stashed b")
+		shell.Stash("stash one")
+		shell.UpdateFile("file-a", "local a")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Files().
+			Lines(
+				Contains("M file-a"),
+			)
+
+		t.Views().Stash().
+			Focus().
+			Lines(
+				Contains("stash one").IsSelected(),
+			).
+			PressEnter()
+
+		t.Views().CommitFiles().
+			IsFocused().
+			Lines(
+				Equals("▼ /").IsSelected(),
+				Equals("  M file-a"),
+				Equals("  M file-b"),
+			).
+			NavigateToLine(Contains("file-b")).
+			Press(keys.CommitFiles.RestoreFileFromStash)
+
+		t.Views().Files().
+			Lines(
+				Equals("▼ /"),
+				Equals("   M file-a"),
+				Equals("   M file-b"),
+			)
+
+		t.FileSystem().FileContent("file-a", Equals("local a"))
+		t.FileSystem().FileContent("file-b", Equals("stashed b"))
+
+		// file-a has unstaged changes, so restoring it asks for confirmation
+		t.Views().CommitFiles().
+			NavigateToLine(Contains("file-a")).
+			Press(keys.CommitFiles.RestoreFileFromStash)
+
+		t.ExpectPopup().Confirmation().
+			Title(Equals("Restore file from stash")).
+			Content(Contains("'file-a' has unstaged changes, which will be lost.")).
+			Confirm()
+
+		t.FileSystem().FileContent("file-a", Equals("stashed a"))
+
+		t.Views().Stash().
+			Lines(
+				Contains("stash one"),
+			)
+	},
+})
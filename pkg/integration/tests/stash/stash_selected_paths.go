@@ -0,0 +1,79 @@
+package stash
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var StashSelectedPaths = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Stash only the selected files and directories, including an untracked file",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupConfig:  func(config *config.AppConfig) {},
+	SetupRepo: func(shell *Shell) {
+		shell.CreateFileAndAdd("dir/file-a", "a")
+		shell.CreateFileAndAdd("file-b", "b")
+		shell.CreateFileAndAdd("file-c", "c")
+		shell.Commit("initial commit")
+		shell.UpdateFile("dir/file-a", "new a")
+		shell.UpdateFile("file-b", "new b")
+		shell.UpdateFile("file-c", "new c")
+		shell.CreateFile("file-d", "d")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Stash().
+			IsEmpty()
+
+		t.Views().Files().
+			Focus().
+			Lines(
+				Equals("▼ /").IsSelected(),
+				Equals("  ▼ dir"),
+				Equals("     M file-a"),
+				Equals("   M file-b"),
+				Equals("   M file-c"),
+				Equals("  ?? file-d"),
+			).
+			NavigateToLine(Contains("dir")).
+			Press(keys.Universal.ToggleRangeSelect).
+			NavigateToLine(Contains("file-b")).
+			Press(keys.Files.ViewStashOptions)
+
+		t.ExpectPopup().Menu().Title(Equals("Stash options")).Select(Contains("Stash selected files")).Confirm()
+
+		t.ExpectPopup().Prompt().Title(Equals("Stash changes")).Type("selected paths").Confirm()
+
+		t.Views().Stash().
+			Lines(
+				Contains("selected paths"),
+			)
+
+		t.Views().Files().
+			Lines(
+				Equals("▼ /"),
+				Equals("   M file-c"),
+				Equals("  ?? file-d"),
+			).
+			NavigateToLine(Contains("file-d")).
+			Press(keys.Files.ViewStashOptions)
+
+		t.ExpectPopup().Menu().Title(Equals("Stash options")).Select(Contains("Stash selected files")).Confirm()
+
+		t.ExpectPopup().Prompt().Title(Equals("Stash changes")).Type("untracked file").Confirm()
+
+		t.Views().Stash().
+			Lines(
+				Contains("untracked file"),
+				Contains("selected paths"),
+			)
+
+		t.Views().Files().
+			Lines(
+				Equals(" M file-c"),
+			)
+
+		t.FileSystem().PathNotPresent("file-d")
+		t.FileSystem().FileContent("dir/file-a", Equals("a"))
+		t.FileSystem().FileContent("file-b", Equals("b"))
+	},
+})
@@ -16,12 +16,15 @@ import (
 	"github.com/jesseduffield/lazygit/pkg/integration/tests/file"
 	"github.com/jesseduffield/lazygit/pkg/integration/tests/filter_and_search"
 	"github.com/jesseduffield/lazygit/pkg/integration/tests/filter_by_author"
+	"github.com/jesseduffield/lazygit/pkg/integration/tests/filter_by_content"
 	"github.com/jesseduffield/lazygit/pkg/integration/tests/filter_by_path"
 	"github.com/jesseduffield/lazygit/pkg/integration/tests/interactive_rebase"
+	"github.com/jesseduffield/lazygit/pkg/integration/tests/lfs"
 	"github.com/jesseduffield/lazygit/pkg/integration/tests/misc"
 	"github.com/jesseduffield/lazygit/pkg/integration/tests/patch_building"
 	"github.com/jesseduffield/lazygit/pkg/integration/tests/reflog"
 	"github.com/jesseduffield/lazygit/pkg/integration/tests/shell_commands"
+	"github.com/jesseduffield/lazygit/pkg/integration/tests/sparse_checkout"
 	"github.com/jesseduffield/lazygit/pkg/integration/tests/staging"
 	"github.com/jesseduffield/lazygit/pkg/integration/tests/stash"
 	"github.com/jesseduffield/lazygit/pkg/integration/tests/status"
@@ -37,10 +40,12 @@ var tests = []*components.IntegrationTest{
 	bisect.Basic,
 	bisect.ChooseTerms,
 	bisect.FromOtherBranch,
+	bisect.Run,
 	bisect.Skip,
 	branch.CheckoutAutostash,
 	branch.CheckoutByName,
 	branch.CheckoutPreviousBranch,
+	branch.CreatePullRequestViaApi,
 	branch.CreateTag,
 	branch.Delete,
 	branch.DeleteMultiple,
@@ -62,6 +67,7 @@ var tests = []*components.IntegrationTest{
 	branch.OpenPullRequestNoUpstream,
 	branch.OpenPullRequestSelectRemoteAndTargetBranch,
 	branch.OpenWithCliArg,
+	branch.PredictMergeConflicts,
 	branch.Rebase,
 	branch.RebaseAbortOnConflict,
 	branch.RebaseAndDrop,
@@ -82,6 +88,7 @@ var tests = []*components.IntegrationTest{
 	branch.ShowDivergenceFromBaseBranch,
 	branch.ShowDivergenceFromUpstream,
 	branch.ShowDivergenceFromUpstreamNoDivergence,
+	branch.ShowPullRequests,
 	branch.SortLocalBranches,
 	branch.SortRemoteBranches,
 	branch.SquashMerge,
@@ -101,6 +108,7 @@ var tests = []*components.IntegrationTest{
 	commit.AmendWhenThereAreConflictsAndAmend,
 	commit.AmendWhenThereAreConflictsAndCancel,
 	commit.AmendWhenThereAreConflictsAndContinue,
+	commit.ApplyMailbox,
 	commit.AutoWrapMessage,
 	commit.Checkout,
 	commit.CheckoutFileFromCommit,
@@ -125,6 +133,8 @@ var tests = []*components.IntegrationTest{
 	commit.DiscardOldFileChanges,
 	commit.DiscardSubmoduleChanges,
 	commit.DoNotShowBranchMarkerForHeadCommit,
+	commit.ExportPatches,
+	commit.ExportPatchesWithMerge,
 	commit.FailHooksThenCommitNoHooks,
 	commit.FindBaseCommitForFixup,
 	commit.FindBaseCommitForFixupDisregardMainBranch,
@@ -134,6 +144,8 @@ var tests = []*components.IntegrationTest{
 	commit.History,
 	commit.HistoryComplex,
 	commit.NewBranch,
+	commit.Notes,
+	commit.OpenIssueLink,
 	commit.PasteCommitMessage,
 	commit.PasteCommitMessageOverExisting,
 	commit.PreserveCommitMessage,
@@ -151,6 +163,7 @@ var tests = []*components.IntegrationTest{
 	commit.Staged,
 	commit.StagedWithoutHooks,
 	commit.Unstaged,
+	commit.ViewCheckStatus,
 	config.CustomCommandsInPerRepoConfig,
 	config.NegativeRefspec,
 	config.RemoteNamedStar,
@@ -159,10 +172,12 @@ var tests = []*components.IntegrationTest{
 	conflicts.MergeFileCurrent,
 	conflicts.MergeFileIncoming,
 	conflicts.ResolveExternally,
+	conflicts.ResolveInSplitView,
 	conflicts.ResolveMultipleFiles,
 	conflicts.ResolveNoAutoStage,
 	conflicts.ResolveNonTextualConflicts,
 	conflicts.ResolveWithoutTrailingLf,
+	conflicts.ReuseRecordedResolution,
 	conflicts.UndoChooseHunk,
 	custom_commands.AccessCommitProperties,
 	custom_commands.BasicCommand,
@@ -198,11 +213,18 @@ var tests = []*components.IntegrationTest{
 	demo.WorktreeCreateFromBranches,
 	diff.CopyToClipboard,
 	diff.Diff,
+	diff.DiffAlgorithm,
 	diff.DiffAndApplyPatch,
 	diff.DiffCommits,
 	diff.DiffNonStickyRange,
+	diff.FindCopies,
 	diff.IgnoreWhitespace,
+	diff.RangeDiff,
 	diff.RenameSimilarityThresholdChange,
+	diff.SideBySide,
+	diff.SyntaxHighlighting,
+	diff.WordDiff,
+	file.Blame,
 	file.CollapseExpand,
 	file.CopyMenu,
 	file.DirWithUntrackedFile,
@@ -242,6 +264,9 @@ var tests = []*components.IntegrationTest{
 	filter_and_search.StagingFolderStagesOnlyTrackedFilesInTrackedOnlyFilter,
 	filter_by_author.SelectAuthor,
 	filter_by_author.TypeAuthor,
+	filter_by_content.Message,
+	filter_by_content.MessageWhileRebasing,
+	filter_by_content.Pickaxe,
 	filter_by_path.CliArg,
 	filter_by_path.DropCommitInFilteringMode,
 	filter_by_path.KeepSameCommitSelectedOnExit,
@@ -299,6 +324,7 @@ var tests = []*components.IntegrationTest{
 	interactive_rebase.RewordYouAreHereCommit,
 	interactive_rebase.RewordYouAreHereCommitWithEditor,
 	interactive_rebase.ShowExecTodos,
+	interactive_rebase.SplitCommit,
 	interactive_rebase.SquashDownFirstCommit,
 	interactive_rebase.SquashDownSecondCommit,
 	interactive_rebase.SquashFixupsAbove,
@@ -308,10 +334,13 @@ var tests = []*components.IntegrationTest{
 	interactive_rebase.SwapInRebaseWithConflictAndEdit,
 	interactive_rebase.SwapWithConflict,
 	interactive_rebase.ViewFilesOfTodoEntries,
+	lfs.ShowLfsFile,
+	misc.CloneRepository,
 	misc.ConfirmOnQuit,
 	misc.CopyConfirmationMessageToClipboard,
 	misc.CopyToClipboard,
 	misc.DisabledKeybindings,
+	misc.InitRepository,
 	misc.InitialOpen,
 	misc.RecentReposOnLaunch,
 	patch_building.Apply,
@@ -356,13 +385,17 @@ var tests = []*components.IntegrationTest{
 	shell_commands.EditHistory,
 	shell_commands.History,
 	shell_commands.OmitFromHistory,
+	sparse_checkout.SparseCheckout,
 	staging.DiffChangeScreenMode,
 	staging.DiffContextChange,
 	staging.DiscardAllChanges,
+	staging.ReviewHunks,
 	staging.Search,
 	staging.StageHunks,
 	staging.StageLines,
 	staging.StageRanges,
+	staging.ViewLineHistory,
+	staging.ViewLineHistoryOfRenamedFile,
 	stash.Apply,
 	stash.ApplyPatch,
 	stash.CreateBranch,
@@ -373,11 +406,13 @@ var tests = []*components.IntegrationTest{
 	stash.Pop,
 	stash.PreventDiscardingFileChanges,
 	stash.Rename,
+	stash.RestoreFileFromStash,
 	stash.ShowWithBranchNamedStash,
 	stash.Stash,
 	stash.StashAll,
 	stash.StashAndKeepIndex,
 	stash.StashIncludingUntrackedFiles,
+	stash.StashSelectedPaths,
 	stash.StashStaged,
 	stash.StashStagedPartialFile,
 	stash.StashUnstaged,
@@ -432,6 +467,8 @@ var tests = []*components.IntegrationTest{
 	tag.Reset,
 	tag.ResetToDuplicateNamedBranch,
 	ui.Accordion,
+	ui.CommandPalette,
+	ui.CommandPaletteMenuItems,
 	ui.DisableSwitchTabWithPanelJumpKeys,
 	ui.EmptyMenu,
 	ui.KeybindingSuggestionsWhenSwitchingRepos,
@@ -0,0 +1,64 @@
+package ui
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var CommandPalette = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Fuzzy-search the command palette for an action of another panel and run it there, and try to run a disabled one",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupConfig:  func(config *config.AppConfig) {},
+	SetupRepo: func(shell *Shell) {
+		shell.EmptyCommit("one")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Files().
+			IsFocused().
+			Press(keys.Universal.OpenCommandPalette)
+
+		// Fuzzy search is used even though the filter mode is 'substring'
+		t.ExpectSearch().
+			Type("rnmbrnch").
+			Confirm()
+
+		t.ExpectPopup().Menu().
+			Title(Equals("Command palette")).
+			TopLines(
+				Contains("Rename branch").Contains("Local branches").IsSelected(),
+			).
+			Confirm()
+
+		t.ExpectPopup().Prompt().
+			Title(Contains("Enter new branch name")).
+			InitialText(Equals("master")).
+			Clear().
+			Type("main").
+			Confirm()
+
+		t.Views().Branches().
+			IsFocused().
+			Lines(
+				Contains("main").IsSelected(),
+			).
+			Press(keys.Universal.OpenCommandPalette)
+
+		t.ExpectSearch().
+			Type("merge/rebase options").
+			Confirm()
+
+		t.ExpectPopup().Menu().
+			Title(Equals("Command palette")).
+			TopLines(
+				Contains("View merge/rebase options").Contains("Global").IsSelected(),
+			).
+			Tooltip(Contains("Disabled: You are currently neither rebasing nor merging")).
+			Confirm()
+
+		t.ExpectToast(Equals("Disabled: You are currently neither rebasing nor merging"))
+
+		t.ExpectPopup().Menu().
+			Title(Equals("Command palette"))
+	},
+})
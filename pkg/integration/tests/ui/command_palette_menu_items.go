@@ -0,0 +1,67 @@
+package ui
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var CommandPaletteMenuItems = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Run an item of another panel's menu from the command palette, and try to run a disabled one",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupConfig:  func(config *config.AppConfig) {},
+	SetupRepo: func(shell *Shell) {
+		shell.
+			EmptyCommit("master 1").
+			EmptyCommit("master 2").
+			NewBranchFrom("feature", "master^").
+			EmptyCommit("feature 1")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Files().
+			IsFocused().
+			Press(keys.Universal.OpenCommandPalette)
+
+		t.ExpectSearch().
+			Type("rebase onto base branch").
+			Confirm()
+
+		t.ExpectPopup().Menu().
+			Title(Equals("Command palette")).
+			TopLines(
+				Contains("Rebase: Rebase onto base branch (master)").Contains("Local branches").IsSelected(),
+			).
+			Confirm()
+
+		t.Views().Branches().
+			IsFocused().
+			Lines(
+				Contains("feature").IsSelected(),
+				Contains("master"),
+			).
+			Press(keys.Universal.OpenCommandPalette)
+
+		t.Views().Commits().Lines(
+			Contains("feature 1"),
+			Contains("master 2"),
+			Contains("master 1"),
+		)
+
+		t.ExpectSearch().
+			Type("simple rebase").
+			Confirm()
+
+		t.ExpectPopup().Menu().
+			Title(Equals("Command palette")).
+			TopLines(
+				Contains("Rebase: Simple rebase onto 'feature'").Contains("Local branches").IsSelected(),
+			).
+			Tooltip(Contains("Disabled: You cannot rebase a branch onto itself")).
+			Confirm()
+
+		t.ExpectToast(Equals("Disabled: You cannot rebase a branch onto itself"))
+
+		t.ExpectPopup().Menu().
+			Title(Equals("Command palette"))
+	},
+})
@@ -24,6 +24,8 @@ var KeybindingSuggestionsWhenSwitchingRepos = NewIntegrationTest(NewIntegrationT
 			t.ExpectPopup().Menu().Title(Equals("Recent repositories")).
 				Lines(
 					Contains(repo).IsSelected(),
+					Contains("Clone repository"),
+					Contains("Create new repository"),
 					Contains("Cancel"),
 				).Confirm()
 			t.Views().Status().Content(Contains(repo + " → master"))
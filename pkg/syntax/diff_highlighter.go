@@ -0,0 +1,94 @@
+package syntax
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/jesseduffield/lazygit/pkg/gui/style"
+	"github.com/jesseduffield/lazygit/pkg/theme"
+	"github.com/jesseduffield/lazygit/pkg/utils"
+)
+
+// DiffHighlighter syntax highlights the output of commands like git diff or git
+// show one line at a time, so that it can be applied while the output is being
+// read into a view. Lines that aren't part of a file's diff (commit messages,
+// diff headers, combined diffs of merge commits, etc.) are passed through as
+// they are.
+type DiffHighlighter struct {
+	// nil if we're not in the diff of a file that we can highlight
+	language *Language
+	// whether we're in the body of a hunk
+	inHunk   bool
+	oldState State
+	newState State
+}
+
+func NewDiffHighlighter() *DiffHighlighter {
+	return &DiffHighlighter{}
+}
+
+func (self *DiffHighlighter) HighlightLine(line []byte) []byte {
+	plain := utils.Decolorise(string(line))
+
+	if self.inHunk {
+		if len(plain) > 0 && (plain[0] == '+' || plain[0] == '-' || plain[0] == ' ') {
+			return []byte(self.highlightBodyLine(plain))
+		}
+		if !strings.HasPrefix(plain, "\\") {
+			self.inHunk = false
+		}
+	}
+
+	switch {
+	case strings.HasPrefix(plain, "diff "):
+		// Combined diffs have a column per parent, which we don't support
+		self.language = nil
+		self.inHunk = false
+	case strings.HasPrefix(plain, "--- "), strings.HasPrefix(plain, "+++ "):
+		if path := diffHeaderPath(plain[4:]); path != "" && !self.inHunk {
+			self.language = LanguageForPath(path)
+		}
+	case strings.HasPrefix(plain, "@@ "):
+		self.inHunk = self.language != nil
+		self.oldState = State{}
+		self.newState = State{}
+	}
+
+	return line
+}
+
+func (self *DiffHighlighter) highlightBodyLine(line string) string {
+	content := line[1:]
+	var tokens []Token
+	var textStyle style.TextStyle
+	switch line[0] {
+	case '+':
+		tokens = self.language.Tokenize(content, &self.newState)
+		textStyle = style.FgGreen
+	case '-':
+		tokens = self.language.Tokenize(content, &self.oldState)
+		textStyle = style.FgRed
+	default:
+		// Context lines are on both sides, so both states need to see them
+		self.language.Tokenize(content, &self.oldState)
+		tokens = self.language.Tokenize(content, &self.newState)
+		textStyle = theme.DefaultTextColor
+	}
+
+	return textStyle.Sprint(line[:1]) + Render(content, tokens, textStyle)
+}
+
+// Returns the path from a "--- a/file" or "+++ b/file" line (without the
+// leading markers), or "" if it's /dev/null
+func diffHeaderPath(str string) string {
+	str = strings.TrimRight(str, "\t")
+	if strings.HasPrefix(str, `"`) {
+		if unquoted, err := strconv.Unquote(str); err == nil {
+			str = unquoted
+		}
+	}
+	if str == "/dev/null" {
+		return ""
+	}
+	return str
+}
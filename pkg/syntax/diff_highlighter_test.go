@@ -0,0 +1,135 @@
+package syntax
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/gookit/color"
+	"github.com/jesseduffield/lazygit/pkg/gui/style"
+	"github.com/jesseduffield/lazygit/pkg/theme"
+	"github.com/jesseduffield/lazygit/pkg/utils"
+	"github.com/stretchr/testify/assert"
+	"github.com/xo/terminfo"
+)
+
+func TestDiffHighlighter(t *testing.T) {
+	oldColorLevel := color.ForceSetColorLevel(terminfo.ColorLevelMillions)
+	defer color.ForceSetColorLevel(oldColorLevel)
+
+	keyword := theme.SyntaxKeywordColor.Sprint
+	str := theme.SyntaxStringColor.Sprint
+	green := style.FgGreen.Sprint
+	red := style.FgRed.Sprint
+	plain := theme.DefaultTextColor.Sprint
+
+	scenarios := []struct {
+		testName string
+		input    []string
+		expected []string
+	}{
+		{
+			testName: "highlights the body lines of a file we know",
+			input: []string{
+				"commit 1234",
+				"",
+				"    return early",
+				"",
+				"diff --git a/main.go b/main.go",
+				"--- a/main.go",
+				"+++ b/main.go",
+				"@@ -1,2 +1,2 @@ func main() {",
+				" 	return",
+				"-	x := \"a\"",
+				"+	var x = \"b\"",
+				`\ No newline at end of file`,
+			},
+			expected: []string{
+				"commit 1234",
+				"",
+				"    return early",
+				"",
+				"diff --git a/main.go b/main.go",
+				"--- a/main.go",
+				"+++ b/main.go",
+				"@@ -1,2 +1,2 @@ func main() {",
+				plain(" ") + plain("\t") + keyword("return"),
+				red("-") + red("\tx := ") + str("\"a\""),
+				green("+") + green("\t") + keyword("var") + green(" x = ") + str("\"b\""),
+				`\ No newline at end of file`,
+			},
+		},
+		{
+			testName: "leaves files alone whose language we don't know",
+			input: []string{
+				"diff --git a/main.go b/main.go",
+				"--- a/main.go",
+				"+++ b/main.go",
+				"@@ -1 +1 @@",
+				"+return",
+				"diff --git a/README.md b/README.md",
+				"--- a/README.md",
+				"+++ b/README.md",
+				"@@ -1 +1 @@",
+				"+return",
+			},
+			expected: []string{
+				"diff --git a/main.go b/main.go",
+				"--- a/main.go",
+				"+++ b/main.go",
+				"@@ -1 +1 @@",
+				green("+") + keyword("return"),
+				"diff --git a/README.md b/README.md",
+				"--- a/README.md",
+				"+++ b/README.md",
+				"@@ -1 +1 @@",
+				"+return",
+			},
+		},
+		{
+			testName: "uses the old path for deleted files, and ignores existing colors",
+			input: []string{
+				"diff --git a/main.go b/main.go",
+				"--- a/main.go",
+				"+++ /dev/null",
+				"@@ -1 +0,0 @@",
+				red("-return"),
+			},
+			expected: []string{
+				"diff --git a/main.go b/main.go",
+				"--- a/main.go",
+				"+++ /dev/null",
+				"@@ -1 +0,0 @@",
+				red("-") + keyword("return"),
+			},
+		},
+		{
+			testName: "doesn't highlight combined diffs",
+			input: []string{
+				"diff --cc main.go",
+				"--- a/main.go",
+				"+++ b/main.go",
+				"@@@ -1,1 -1,1 +1,1 @@@",
+				"++return",
+			},
+			expected: []string{
+				"diff --cc main.go",
+				"--- a/main.go",
+				"+++ b/main.go",
+				"@@@ -1,1 -1,1 +1,1 @@@",
+				"++return",
+			},
+		},
+	}
+
+	for _, s := range scenarios {
+		t.Run(s.testName, func(t *testing.T) {
+			highlighter := NewDiffHighlighter()
+			actual := []string{}
+			for _, line := range s.input {
+				actual = append(actual, string(highlighter.HighlightLine([]byte(line))))
+			}
+			assert.Equal(t, strings.Join(s.expected, "\n"), strings.Join(actual, "\n"))
+			assert.Equal(t, utils.Decolorise(strings.Join(s.input, "\n")), utils.Decolorise(strings.Join(actual, "\n")))
+		})
+	}
+}
@@ -0,0 +1,39 @@
+package syntax
+
+import (
+	"strings"
+
+	"github.com/jesseduffield/lazygit/pkg/gui/style"
+	"github.com/jesseduffield/lazygit/pkg/theme"
+)
+
+func (self TokenKind) Style() style.TextStyle {
+	switch self {
+	case Keyword:
+		return theme.SyntaxKeywordColor
+	case String:
+		return theme.SyntaxStringColor
+	case Comment:
+		return theme.SyntaxCommentColor
+	default:
+		return theme.SyntaxNumberColor
+	}
+}
+
+// Renders the line with its tokens in the theme's syntax colors and the rest
+// in the given style
+func Render(line string, tokens []Token, textStyle style.TextStyle) string {
+	result := &strings.Builder{}
+	pos := 0
+	for _, token := range tokens {
+		if token.Start > pos {
+			result.WriteString(textStyle.Sprint(line[pos:token.Start]))
+		}
+		result.WriteString(token.Kind.Style().Sprint(line[token.Start:token.End]))
+		pos = token.End
+	}
+	if pos < len(line) {
+		result.WriteString(textStyle.Sprint(line[pos:]))
+	}
+	return result.String()
+}
@@ -0,0 +1,181 @@
+package syntax
+
+import (
+	"path/filepath"
+	"strings"
+
+	"github.com/jesseduffield/generics/set"
+)
+
+// A Language describes just enough of a programming language's lexical
+// structure to pick out its keywords, strings, comments and numbers. It's
+// nowhere near a real lexer, but it's cheap, and good enough for reading diffs.
+type Language struct {
+	keywords *set.Set[string]
+	// checked in order, so longer delimiters that share a prefix with shorter
+	// ones must come first (e.g. `"""` before `"`)
+	delimiters []delimiter
+}
+
+type delimiter struct {
+	open string
+	// empty if the token extends to the end of the line
+	close string
+	kind  TokenKind
+	// whether a backslash escapes the next character
+	escapes bool
+	// whether the token can span several lines
+	multiline bool
+}
+
+var (
+	lineComment  = func(open string) delimiter { return delimiter{open: open, kind: Comment} }
+	blockComment = func(open string, close string) delimiter {
+		return delimiter{open: open, close: close, kind: Comment, multiline: true}
+	}
+	quotedString = func(quote string) delimiter {
+		return delimiter{open: quote, close: quote, kind: String, escapes: true}
+	}
+	rawString = func(quote string) delimiter {
+		return delimiter{open: quote, close: quote, kind: String}
+	}
+	multilineString = func(quote string, escapes bool) delimiter {
+		return delimiter{open: quote, close: quote, kind: String, escapes: escapes, multiline: true}
+	}
+)
+
+func newLanguage(keywords string, delimiters ...delimiter) *Language {
+	return &Language{
+		keywords:   set.NewFromSlice(strings.Fields(keywords)),
+		delimiters: delimiters,
+	}
+}
+
+var (
+	golang = newLanguage(
+		`break case chan const continue default defer else fallthrough for func go goto if import interface
+		map package range return select struct switch type var
+		true false nil iota any bool byte rune string error int int8 int16 int32 int64 uint uint8 uint16
+		uint32 uint64 uintptr float32 float64 complex64 complex128`,
+		lineComment("//"), blockComment("/*", "*/"),
+		quotedString(`"`), quotedString(`'`), multilineString("`", false),
+	)
+
+	cFamily = newLanguage(
+		`auto break case char const continue default do double else enum extern float for goto if inline int
+		long register return short signed sizeof static struct switch typedef union unsigned void volatile while
+		bool true false nullptr NULL class namespace template typename public private protected virtual override
+		new delete this throw try catch using const_cast static_cast dynamic_cast reinterpret_cast constexpr
+		operator friend explicit noexcept`,
+		lineComment("//"), blockComment("/*", "*/"),
+		quotedString(`"`), quotedString(`'`),
+	)
+
+	java = newLanguage(
+		`abstract assert boolean break byte case catch char class const continue default do double else enum
+		extends final finally float for goto if implements import instanceof int interface long native new
+		package private protected public return short static strictfp super switch synchronized this throw
+		throws transient try void volatile while var record yield true false null`,
+		lineComment("//"), blockComment("/*", "*/"),
+		multilineString(`"""`, true), quotedString(`"`), quotedString(`'`),
+	)
+
+	csharp = newLanguage(
+		`abstract as base bool break byte case catch char checked class const continue decimal default delegate
+		do double else enum event explicit extern false finally fixed float for foreach goto if implicit in int
+		interface internal is lock long namespace new null object operator out override params private protected
+		public readonly ref return sbyte sealed short sizeof stackalloc static string struct switch this throw
+		true try typeof uint ulong unchecked unsafe ushort using var virtual void volatile while async await`,
+		lineComment("//"), blockComment("/*", "*/"),
+		quotedString(`"`), quotedString(`'`),
+	)
+
+	javascript = newLanguage(
+		`break case catch class const continue debugger default delete do else export extends finally for
+		function if import in instanceof let new return super switch this throw try typeof var void while with
+		yield async await of static get set true false null undefined
+		interface type enum implements namespace declare readonly abstract private protected public as keyof`,
+		lineComment("//"), blockComment("/*", "*/"),
+		quotedString(`"`), quotedString(`'`), multilineString("`", true),
+	)
+
+	rust = newLanguage(
+		`as async await break const continue crate dyn else enum extern false fn for if impl in let loop match
+		mod move mut pub ref return self Self static struct super trait true type unsafe use where while
+		bool char str u8 u16 u32 u64 u128 usize i8 i16 i32 i64 i128 isize f32 f64 Some None Ok Err`,
+		lineComment("//"), blockComment("/*", "*/"),
+		quotedString(`"`),
+	)
+
+	python = newLanguage(
+		`False None True and as assert async await break class continue def del elif else except finally for
+		from global if import in is lambda nonlocal not or pass raise return try while with yield self`,
+		lineComment("#"),
+		multilineString(`"""`, true), multilineString(`'''`, true), quotedString(`"`), quotedString(`'`),
+	)
+
+	ruby = newLanguage(
+		`BEGIN END alias and begin break case class def do else elsif end ensure false for if in module
+		next nil not or redo rescue retry return self super then true undef unless until when while yield
+		require attr_reader attr_writer attr_accessor private protected public`,
+		lineComment("#"),
+		quotedString(`"`), quotedString(`'`),
+	)
+
+	shell = newLanguage(
+		`if then else elif fi case esac for select while until do done in function time return exit break
+		continue local export readonly declare set unset shift source echo true false`,
+		lineComment("#"),
+		quotedString(`"`), rawString(`'`),
+	)
+
+	lua = newLanguage(
+		`and break do else elseif end false for function goto if in local nil not or repeat return then true
+		until while`,
+		blockComment("--[[", "]]"), lineComment("--"),
+		quotedString(`"`), quotedString(`'`),
+	)
+
+	yaml = newLanguage(
+		`true false null yes no on off`,
+		lineComment("#"),
+		quotedString(`"`), rawString(`'`),
+	)
+)
+
+var languagesByExtension = map[string]*Language{
+	".go":    golang,
+	".c":     cFamily,
+	".h":     cFamily,
+	".cc":    cFamily,
+	".cpp":   cFamily,
+	".cxx":   cFamily,
+	".hpp":   cFamily,
+	".m":     cFamily,
+	".java":  java,
+	".cs":    csharp,
+	".js":    javascript,
+	".jsx":   javascript,
+	".mjs":   javascript,
+	".cjs":   javascript,
+	".ts":    javascript,
+	".tsx":   javascript,
+	".rs":    rust,
+	".py":    python,
+	".rb":    ruby,
+	".sh":    shell,
+	".bash":  shell,
+	".zsh":   shell,
+	".lua":   lua,
+	".yml":   yaml,
+	".yaml":  yaml,
+	".toml":  yaml,
+	".json":  yaml,
+	".proto": cFamily,
+}
+
+// Returns the language of the file at the given path, judging by its
+// extension, or nil if we don't know how to highlight it.
+func LanguageForPath(path string) *Language {
+	return languagesByExtension[strings.ToLower(filepath.Ext(path))]
+}
@@ -0,0 +1,139 @@
+package syntax
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// Lines longer than this are left alone; they're usually minified or
+// generated code, where highlighting doesn't help and would only cost time.
+const MaxHighlightedLineLength = 1000
+
+type TokenKind int
+
+const (
+	Keyword TokenKind = iota
+	String
+	Comment
+	Number
+)
+
+// A Token is a highlighted part of a line, given as a range of byte offsets
+type Token struct {
+	Kind  TokenKind
+	Start int
+	End   int // exclusive
+}
+
+// State carries a comment or string that is still open at the end of a line
+// over to the next line. The zero value means nothing is open.
+type State struct {
+	open *delimiter
+}
+
+// Returns the highlighted parts of the given line (which must not contain a
+// newline), updating the state for the next line.
+func (self *Language) Tokenize(line string, state *State) []Token {
+	if len(line) > MaxHighlightedLineLength {
+		*state = State{}
+		return nil
+	}
+
+	tokens := []Token{}
+	i := 0
+
+	if state.open != nil {
+		end, closed := findClose(line, 0, state.open)
+		tokens = append(tokens, Token{Kind: state.open.kind, Start: 0, End: end})
+		if !closed {
+			return tokens
+		}
+		*state = State{}
+		i = end
+	}
+
+outer:
+	for i < len(line) {
+		for idx := range self.delimiters {
+			delim := &self.delimiters[idx]
+			if !strings.HasPrefix(line[i:], delim.open) {
+				continue
+			}
+
+			start := i
+			if delim.close == "" {
+				tokens = append(tokens, Token{Kind: delim.kind, Start: start, End: len(line)})
+				break outer
+			}
+
+			end, closed := findClose(line, i+len(delim.open), delim)
+			tokens = append(tokens, Token{Kind: delim.kind, Start: start, End: end})
+			if !closed && delim.multiline {
+				state.open = delim
+			}
+			i = end
+			continue outer
+		}
+
+		r, size := utf8.DecodeRuneInString(line[i:])
+		switch {
+		case unicode.IsDigit(r) && !endsWithWordRune(line[:i]):
+			start := i
+			for i < len(line) && (isWordByte(line[i]) || line[i] == '.') {
+				i++
+			}
+			tokens = append(tokens, Token{Kind: Number, Start: start, End: i})
+		case isWordRune(r):
+			start := i
+			for i < len(line) {
+				r, size := utf8.DecodeRuneInString(line[i:])
+				if !isWordRune(r) {
+					break
+				}
+				i += size
+			}
+			if self.keywords.Includes(line[start:i]) && !followsMemberAccess(line[:start]) {
+				tokens = append(tokens, Token{Kind: Keyword, Start: start, End: i})
+			}
+		default:
+			i += size
+		}
+	}
+
+	return tokens
+}
+
+// Returns the offset just past the delimiter's closing sequence, searching
+// from the given offset, and whether it was found at all. If it wasn't, the
+// returned offset is the end of the line.
+func findClose(line string, from int, delim *delimiter) (int, bool) {
+	for i := from; i < len(line); i++ {
+		if delim.escapes && line[i] == '\\' {
+			i++
+			continue
+		}
+		if strings.HasPrefix(line[i:], delim.close) {
+			return i + len(delim.close), true
+		}
+	}
+	return len(line), false
+}
+
+func isWordRune(r rune) bool {
+	return r == '_' || unicode.IsLetter(r) || unicode.IsDigit(r)
+}
+
+func isWordByte(b byte) bool {
+	return b == '_' || b >= '0' && b <= '9' || b >= 'a' && b <= 'z' || b >= 'A' && b <= 'Z'
+}
+
+func endsWithWordRune(str string) bool {
+	r, _ := utf8.DecodeLastRuneInString(str)
+	return str != "" && isWordRune(r)
+}
+
+// A keyword used as a field or method name (e.g. `foo.type`) isn't a keyword
+func followsMemberAccess(str string) bool {
+	return strings.HasSuffix(str, ".")
+}
@@ -0,0 +1,106 @@
+package syntax
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTokenize(t *testing.T) {
+	kindNames := map[TokenKind]string{Keyword: "keyword", String: "string", Comment: "comment", Number: "number"}
+
+	scenarios := []struct {
+		testName string
+		path     string
+		lines    []string
+		// one entry per line, each a list of "kind:text" tokens
+		expected [][]string
+	}{
+		{
+			testName: "go keywords, strings, and numbers",
+			path:     "main.go",
+			lines:    []string{`func foo() string { return "x" + strconv.Itoa(42) }`},
+			expected: [][]string{{"keyword:func", "keyword:string", "keyword:return", `string:"x"`, "number:42"}},
+		},
+		{
+			testName: "keywords used as field names and numbers within words are ignored",
+			path:     "main.go",
+			lines:    []string{`x.type = v2 + 0x1f`},
+			expected: [][]string{{"number:0x1f"}},
+		},
+		{
+			testName: "escaped quotes don't end a string",
+			path:     "main.go",
+			lines:    []string{`s := "a \"quoted\" word" // done`},
+			expected: [][]string{{`string:"a \"quoted\" word"`, "comment:// done"}},
+		},
+		{
+			testName: "comment markers inside strings are ignored",
+			path:     "script.py",
+			lines:    []string{`url = "http://x#y"  # comment`},
+			expected: [][]string{{`string:"http://x#y"`, "comment:# comment"}},
+		},
+		{
+			testName: "block comments span lines",
+			path:     "main.c",
+			lines:    []string{`int x; /* start`, `still a comment`, `end */ return x;`},
+			expected: [][]string{
+				{"keyword:int", "comment:/* start"},
+				{"comment:still a comment"},
+				{"comment:end */", "keyword:return"},
+			},
+		},
+		{
+			testName: "multiline strings span lines",
+			path:     "script.py",
+			lines:    []string{`def f():`, `    """Docs`, `    more docs"""`, `    pass`},
+			expected: [][]string{
+				{"keyword:def"},
+				{`string:"""Docs`},
+				{`string:    more docs"""`},
+				{"keyword:pass"},
+			},
+		},
+		{
+			testName: "unterminated single-line strings end with the line",
+			path:     "main.js",
+			lines:    []string{`let s = "oops`, `const t = 1`},
+			expected: [][]string{
+				{"keyword:let", `string:"oops`},
+				{"keyword:const", "number:1"},
+			},
+		},
+		{
+			testName: "overly long lines aren't highlighted",
+			path:     "main.go",
+			lines:    []string{"return " + strings.Repeat("x", MaxHighlightedLineLength)},
+			expected: [][]string{{}},
+		},
+	}
+
+	for _, s := range scenarios {
+		t.Run(s.testName, func(t *testing.T) {
+			language := LanguageForPath(s.path)
+			assert.NotNil(t, language)
+
+			state := State{}
+			for i, line := range s.lines {
+				tokens := language.Tokenize(line, &state)
+				actual := lo.Map(tokens, func(token Token, _ int) string {
+					return fmt.Sprintf("%s:%s", kindNames[token.Kind], line[token.Start:token.End])
+				})
+				assert.Equal(t, s.expected[i], actual, "line %d", i)
+			}
+		})
+	}
+}
+
+func TestLanguageForPath(t *testing.T) {
+	assert.NotNil(t, LanguageForPath("pkg/foo/Bar.GO"))
+	assert.NotNil(t, LanguageForPath("b/src/index.tsx"))
+	assert.Nil(t, LanguageForPath("README.md"))
+	assert.Nil(t, LanguageForPath("Makefile"))
+}
@@ -117,7 +117,11 @@ func (self *ViewBufferManager) ReadToEnd(then func()) {
 	}
 }
 
-func (self *ViewBufferManager) NewCmdTask(start func() (*exec.Cmd, io.Reader), prefix string, linesToRead LinesToRead, onDoneFn func()) func(TaskOpts) error {
+// transformLine, if not nil, is applied to each line of the command's output
+// right before it is written to the view. Lines are only read as far as the
+// view needs them, so this costs nothing for the parts of a long output that
+// are never scrolled to.
+func (self *ViewBufferManager) NewCmdTask(start func() (*exec.Cmd, io.Reader), prefix string, linesToRead LinesToRead, transformLine func([]byte) []byte, onDoneFn func()) func(TaskOpts) error {
 	return func(opts TaskOpts) error {
 		var onDoneOnce sync.Once
 		var onFirstPageShownOnce sync.Once
@@ -283,6 +287,9 @@ func (self *ViewBufferManager) NewCmdTask(start func() (*exec.Cmd, io.Reader), p
 							callThen()
 							break outer
 						}
+						if transformLine != nil {
+							line = transformLine(line)
+						}
 						writeToView(append(line, '\n'))
 						lineWrittenChan <- struct{}{}
 
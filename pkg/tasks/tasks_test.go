@@ -52,7 +52,7 @@ func TestNewCmdTaskInstantStop(t *testing.T) {
 		return cmd, reader
 	}
 
-	fn := manager.NewCmdTask(start, "prefix\n", LinesToRead{20, -1, nil}, onDone)
+	fn := manager.NewCmdTask(start, "prefix\n", LinesToRead{20, -1, nil}, nil, onDone)
 
 	_ = fn(TaskOpts{Stop: stop, InitialContentLoaded: func() { task.Done() }})
 
@@ -115,7 +115,7 @@ func TestNewCmdTask(t *testing.T) {
 		return cmd, reader
 	}
 
-	fn := manager.NewCmdTask(start, "prefix\n", LinesToRead{20, -1, nil}, onDone)
+	fn := manager.NewCmdTask(start, "prefix\n", LinesToRead{20, -1, nil}, nil, onDone)
 	wg := sync.WaitGroup{}
 	wg.Add(1)
 	go func() {
@@ -250,7 +250,7 @@ func TestNewCmdTaskRefresh(t *testing.T) {
 			return cmd, &reader
 		}
 
-		fn := manager.NewCmdTask(start, "", s.linesToRead, func() {})
+		fn := manager.NewCmdTask(start, "", s.linesToRead, nil, func() {})
 		wg := sync.WaitGroup{}
 		wg.Add(1)
 		go func() {
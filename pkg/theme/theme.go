@@ -45,6 +45,12 @@ var (
 	DiffTerminalColor = style.FgMagenta
 
 	UnstagedChangesColor = style.New()
+
+	// The colors used for syntax highlighting in diffs
+	SyntaxKeywordColor = style.FgMagenta
+	SyntaxStringColor  = style.FgYellow
+	SyntaxCommentColor = style.FgBlue
+	SyntaxNumberColor  = style.FgCyan
 )
 
 // UpdateTheme updates all theme variables
@@ -71,6 +77,11 @@ func UpdateTheme(themeConfig config.ThemeConfig) {
 	OptionsColor = GetGocuiStyle(themeConfig.OptionsTextColor)
 	OptionsFgColor = GetTextStyle(themeConfig.OptionsTextColor, false)
 
+	SyntaxKeywordColor = GetTextStyle(themeConfig.SyntaxKeywordColor, false)
+	SyntaxStringColor = GetTextStyle(themeConfig.SyntaxStringColor, false)
+	SyntaxCommentColor = GetTextStyle(themeConfig.SyntaxCommentColor, false)
+	SyntaxNumberColor = GetTextStyle(themeConfig.SyntaxNumberColor, false)
+
 	DefaultTextColor = GetTextStyle(themeConfig.DefaultFgColor, false)
 	GocuiDefaultTextColor = GetGocuiStyle(themeConfig.DefaultFgColor)
 }
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"strings"
 	"unicode"
+	"unicode/utf8"
 
 	"github.com/mattn/go-runewidth"
 	"github.com/samber/lo"
@@ -183,6 +184,55 @@ func TruncateWithEllipsis(str string, limit int) string {
 	return runewidth.Truncate(str, limit, "…")
 }
 
+// Fits a string that may contain ANSI colour codes into a column of the given
+// width: tabs are expanded to spaces, anything beyond the width is cut off, and
+// shorter strings are padded with spaces.
+func FitColoredStringToWidth(str string, width int, tabWidth int) string {
+	result := &strings.Builder{}
+	currentWidth := 0
+	truncated := false
+	for i := 0; i < len(str); {
+		if str[i] == '\x1b' {
+			// copy escape sequences as they are, they don't take up any space
+			end := i + 2
+			if end <= len(str) && str[i+1] == '[' {
+				for end < len(str) && (str[end] < 0x40 || str[end] > 0x7e) {
+					end++
+				}
+				end++
+			}
+			end = min(end, len(str))
+			result.WriteString(str[i:end])
+			i = end
+			continue
+		}
+
+		r, size := utf8.DecodeRuneInString(str[i:])
+		if r == '\t' {
+			spaces := min(tabWidth-currentWidth%tabWidth, width-currentWidth)
+			result.WriteString(strings.Repeat(" ", spaces))
+			currentWidth += spaces
+		} else {
+			runeWidth := runewidth.RuneWidth(r)
+			if currentWidth+runeWidth > width {
+				truncated = true
+				break
+			}
+			result.WriteRune(r)
+			currentWidth += runeWidth
+		}
+		i += size
+	}
+
+	if truncated {
+		// the escape sequence resetting the colour may have been cut off
+		result.WriteString("\x1b[0m")
+	}
+
+	result.WriteString(strings.Repeat(" ", width-currentWidth))
+	return result.String()
+}
+
 func SafeTruncate(str string, limit int) string {
 	if len(str) > limit {
 		return str[0:limit]
@@ -207,3 +257,19 @@ func FormatPaths(paths []string) string {
 	}
 	return fmt.Sprintf("%s, %s, %s, [...%d more]", paths[0], paths[1], paths[2], len(paths)-3)
 }
+
+// Returns a human-readable size, e.g. "1.5 MiB"
+func FormatBytes(size int64) string {
+	const unit = 1024
+	if size < unit {
+		return fmt.Sprintf("%d B", size)
+	}
+
+	div, exp := int64(unit), 0
+	for n := size / unit; n >= unit; n /= unit {
+		div *= unit
+		exp++
+	}
+
+	return fmt.Sprintf("%.1f %ciB", float64(size)/float64(div), "KMGTPE"[exp])
+}
@@ -162,6 +162,82 @@ func TestTruncateWithEllipsis(t *testing.T) {
 	}
 }
 
+func TestFitColoredStringToWidth(t *testing.T) {
+	scenarios := []struct {
+		testName string
+		str      string
+		width    int
+		expected string
+	}{
+		{
+			testName: "short string is padded",
+			str:      "abc",
+			width:    5,
+			expected: "abc  ",
+		},
+		{
+			testName: "long string is truncated",
+			str:      "abcdef",
+			width:    4,
+			expected: "abcd\x1b[0m",
+		},
+		{
+			testName: "colour codes don't count towards the width",
+			str:      "\x1b[31mab\x1b[0m",
+			width:    3,
+			expected: "\x1b[31mab\x1b[0m ",
+		},
+		{
+			testName: "colour codes are reset when truncating",
+			str:      "\x1b[31mabcdef\x1b[0m",
+			width:    2,
+			expected: "\x1b[31mab\x1b[0m",
+		},
+		{
+			testName: "tabs are expanded",
+			str:      "a\tb",
+			width:    6,
+			expected: "a   b ",
+		},
+		{
+			testName: "tabs are cut off at the width",
+			str:      "ab\tc",
+			width:    3,
+			expected: "ab \x1b[0m",
+		},
+		{
+			testName: "wide characters",
+			str:      "大大大",
+			width:    5,
+			expected: "大大\x1b[0m ",
+		},
+	}
+
+	for _, s := range scenarios {
+		t.Run(s.testName, func(t *testing.T) {
+			assert.Equal(t, s.expected, FitColoredStringToWidth(s.str, s.width, 4))
+		})
+	}
+}
+
+func TestFormatBytes(t *testing.T) {
+	scenarios := []struct {
+		size     int64
+		expected string
+	}{
+		{0, "0 B"},
+		{1023, "1023 B"},
+		{1024, "1.0 KiB"},
+		{1536, "1.5 KiB"},
+		{5 * 1024 * 1024, "5.0 MiB"},
+		{3 * 1024 * 1024 * 1024, "3.0 GiB"},
+	}
+
+	for _, s := range scenarios {
+		assert.EqualValues(t, s.expected, FormatBytes(s.size))
+	}
+}
+
 func TestRenderDisplayStrings(t *testing.T) {
 	type scenario struct {
 		input                   [][]string